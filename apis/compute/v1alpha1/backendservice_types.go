@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BackendServiceParameters define the desired state of a Google Compute
+// Engine global BackendService. Most fields map directly to a BackendService:
+// https://cloud.google.com/compute/docs/reference/rest/v1/backendServices
+type BackendServiceParameters struct {
+	// AffinityCookieTTLSec: Lifetime of cookies in seconds. Only
+	// applicable if the loadBalancingScheme is EXTERNAL,
+	// INTERNAL_SELF_MANAGED, or INTERNAL_MANAGED, the protocol is HTTP or
+	// HTTPS, and the sessionAffinity is GENERATED_COOKIE, or HTTP_COOKIE.
+	// +optional
+	AffinityCookieTTLSec *int64 `json:"affinityCookieTtlSec,omitempty"`
+
+	// Backends: The list of backends that serve this BackendService.
+	// +optional
+	Backends []*Backend `json:"backends,omitempty"`
+
+	// ConnectionDraining: Connection draining configuration.
+	// +optional
+	ConnectionDraining *ConnectionDraining `json:"connectionDraining,omitempty"`
+
+	// CustomRequestHeaders: Headers that the load balancer adds to proxied
+	// requests.
+	// +optional
+	CustomRequestHeaders []string `json:"customRequestHeaders,omitempty"`
+
+	// CustomResponseHeaders: Headers that the load balancer adds to
+	// proxied responses.
+	// +optional
+	CustomResponseHeaders []string `json:"customResponseHeaders,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// EnableCDN: If true, enables Cloud CDN for the backend service of an
+	// external HTTP(S) load balancer.
+	// +optional
+	EnableCDN *bool `json:"enableCdn,omitempty"`
+
+	// HealthChecks: The list of URLs to the health checks used for
+	// health checking this BackendService.
+	// +optional
+	HealthChecks []string `json:"healthChecks,omitempty"`
+
+	// HealthCheckRefs references HealthChecks to retrieve their URLs.
+	// +optional
+	HealthCheckRefs []xpv1.Reference `json:"healthCheckRefs,omitempty"`
+
+	// HealthCheckSelector selects references to HealthChecks.
+	// +optional
+	HealthCheckSelector *xpv1.Selector `json:"healthCheckSelector,omitempty"`
+
+	// LoadBalancingScheme: Specifies the load balancer type. A backend
+	// service created for one type of load balancer cannot be used with
+	// another.
+	//
+	// Possible values:
+	//   "EXTERNAL"
+	//   "INTERNAL_SELF_MANAGED"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL_SELF_MANAGED
+	LoadBalancingScheme *string `json:"loadBalancingScheme,omitempty"`
+
+	// LogConfig: This field denotes the logging options for the load
+	// balancer traffic served by this backend service.
+	// +optional
+	LogConfig *BackendServiceLogConfig `json:"logConfig,omitempty"`
+
+	// PortName: A named port on a backend instance group representing the
+	// port for communication to the backend VMs in that group.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// Protocol: The protocol this BackendService uses to communicate with
+	// backends.
+	//
+	// Possible values:
+	//   "GRPC"
+	//   "HTTP"
+	//   "HTTP2"
+	//   "HTTPS"
+	//   "SSL"
+	//   "TCP"
+	// +optional
+	// +kubebuilder:validation:Enum=GRPC;HTTP;HTTP2;HTTPS;SSL;TCP
+	Protocol *string `json:"protocol,omitempty"`
+
+	// SecurityPolicy: The URL of the Cloud Armor SecurityPolicy attached to
+	// this BackendService. Any attached policy is detached if this is
+	// omitted.
+	// +optional
+	SecurityPolicy *string `json:"securityPolicy,omitempty"`
+
+	// SecurityPolicyRef references a SecurityPolicy to retrieve its URL.
+	// +optional
+	SecurityPolicyRef *xpv1.Reference `json:"securityPolicyRef,omitempty"`
+
+	// SecurityPolicySelector selects a reference to a SecurityPolicy.
+	// +optional
+	SecurityPolicySelector *xpv1.Selector `json:"securityPolicySelector,omitempty"`
+
+	// SessionAffinity: Type of session affinity to use.
+	//
+	// Possible values:
+	//   "CLIENT_IP"
+	//   "CLIENT_IP_PORT_PROTO"
+	//   "CLIENT_IP_PROTO"
+	//   "GENERATED_COOKIE"
+	//   "HEADER_FIELD"
+	//   "HTTP_COOKIE"
+	//   "NONE"
+	// +optional
+	// +kubebuilder:validation:Enum=CLIENT_IP;CLIENT_IP_PORT_PROTO;CLIENT_IP_PROTO;GENERATED_COOKIE;HEADER_FIELD;HTTP_COOKIE;NONE
+	SessionAffinity *string `json:"sessionAffinity,omitempty"`
+
+	// TimeoutSec: The backend service timeout has a different meaning
+	// depending on the type of load balancer. For external HTTP(S) load
+	// balancers it is the time to wait for a backend to respond.
+	// +optional
+	TimeoutSec *int64 `json:"timeoutSec,omitempty"`
+}
+
+// A Backend of a BackendService.
+type Backend struct {
+	// BalancingMode: Specifies how to determine whether the backend of a
+	// load balancer can handle additional traffic or is fully loaded.
+	//
+	// Possible values:
+	//   "CONNECTION"
+	//   "RATE"
+	//   "UTILIZATION"
+	// +optional
+	// +kubebuilder:validation:Enum=CONNECTION;RATE;UTILIZATION
+	BalancingMode *string `json:"balancingMode,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Group: The fully-qualified URL of an instance group or network
+	// endpoint group (NEG) resource. Instance groups and NEGs are not
+	// managed by this provider; they are typically created by a managed
+	// instance group or by GKE container-native load balancing.
+	Group string `json:"group"`
+
+	// MaxConnections: The max number of simultaneous connections for the
+	// group. Can be used with either CONNECTION or UTILIZATION balancing
+	// modes.
+	// +optional
+	MaxConnections *int64 `json:"maxConnections,omitempty"`
+
+	// MaxConnectionsPerEndpoint: The max number of simultaneous
+	// connections that a single backend network endpoint can handle.
+	// +optional
+	MaxConnectionsPerEndpoint *int64 `json:"maxConnectionsPerEndpoint,omitempty"`
+
+	// MaxConnectionsPerInstance: The max number of simultaneous
+	// connections that a single backend instance can handle.
+	// +optional
+	MaxConnectionsPerInstance *int64 `json:"maxConnectionsPerInstance,omitempty"`
+
+	// MaxRate: The max requests per second (RPS) of the group.
+	// +optional
+	MaxRate *int64 `json:"maxRate,omitempty"`
+}
+
+// ConnectionDraining configuration for a BackendService.
+type ConnectionDraining struct {
+	// DrainingTimeoutSec: The amount of time in seconds to allow existing
+	// connections to persist while on unhealthy backend instances.
+	DrainingTimeoutSec int64 `json:"drainingTimeoutSec"`
+}
+
+// BackendServiceLogConfig configures logging on a BackendService.
+type BackendServiceLogConfig struct {
+	// Enable: This field denotes whether to enable logging for the load
+	// balancer traffic served by this backend service.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+}
+
+// A BackendServiceObservation reflects the observed state of a BackendService
+// on GCP.
+type BackendServiceObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint of this resource. A hash of the contents stored in this
+	// object. This field is used in optimistic locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A BackendServiceSpec defines the desired state of a BackendService.
+type BackendServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BackendServiceParameters `json:"forProvider"`
+}
+
+// A BackendServiceStatus represents the observed state of a BackendService.
+type BackendServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BackendServiceObservation `json:"atProvider,omitempty"`
+}
+
+// A BackendService is a managed resource that represents a Google Compute
+// Engine global backend service.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type BackendService struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BackendServiceSpec   `json:"spec"`
+	Status BackendServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BackendServiceList contains a list of BackendService.
+type BackendServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BackendService `json:"items"`
+}
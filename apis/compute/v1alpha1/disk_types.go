@@ -0,0 +1,273 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Disk statuses.
+const (
+	DiskStatusCreating  = "CREATING"
+	DiskStatusDeleting  = "DELETING"
+	DiskStatusFailed    = "FAILED"
+	DiskStatusReady     = "READY"
+	DiskStatusRestoring = "RESTORING"
+)
+
+// Conditions of a Disk.
+const (
+	// TypeResizeRefused indicates whether the resize of the disk to the
+	// desired size is refused.
+	TypeResizeRefused xpv1.ConditionType = "ResizeRefused"
+
+	ReasonShrinkRequested   xpv1.ConditionReason = "ShrinkRequested"
+	ReasonNoShrinkRequested xpv1.ConditionReason = "NoShrinkRequested"
+)
+
+// ResizeRefused returns a condition that indicates the disk is not resized to
+// the desired size for the supplied reason.
+func ResizeRefused(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeResizeRefused,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonShrinkRequested,
+		Message:            msg,
+	}
+}
+
+// NoResizeRefused returns a condition that indicates the disk has or may be
+// resized to the desired size.
+func NoResizeRefused() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeResizeRefused,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoShrinkRequested,
+	}
+}
+
+// DiskParameters define the desired state of a Google Compute Engine
+// persistent disk. Exactly one of Zone or Region must be specified. Most
+// fields map directly to a Disk:
+// https://cloud.google.com/compute/docs/reference/rest/v1/disks
+type DiskParameters struct {
+	// Zone: The zone of a zonal disk.
+	// +optional
+	// +immutable
+	Zone *string `json:"zone,omitempty"`
+
+	// Region: The region of a regional disk. Regional disks are replicated
+	// between the zones listed in ReplicaZones.
+	// +optional
+	// +immutable
+	Region *string `json:"region,omitempty"`
+
+	// ReplicaZones: URLs of the two zones in which a regional disk is
+	// replicated, for example projects/example/zones/us-central1-a.
+	// Required for regional disks.
+	// +optional
+	// +immutable
+	ReplicaZones []string `json:"replicaZones,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// DiskEncryptionKey: Encrypts the disk using a customer-managed
+	// encryption key. If omitted the disk is encrypted using a key managed
+	// by Google.
+	// +optional
+	// +immutable
+	DiskEncryptionKey *CustomerEncryptionKey `json:"diskEncryptionKey,omitempty"`
+
+	// Labels to apply to this disk.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// PhysicalBlockSizeBytes: Physical block size of the persistent disk,
+	// in bytes. Currently supported sizes are 4096 and 16384.
+	// +optional
+	// +immutable
+	PhysicalBlockSizeBytes *int64 `json:"physicalBlockSizeBytes,omitempty"`
+
+	// ResourcePolicies: Partially qualified URLs of the resource policies,
+	// such as snapshot schedules, that are attached to this disk. Resource
+	// policies must be in the same region as the disk.
+	// +optional
+	ResourcePolicies []string `json:"resourcePolicies,omitempty"`
+
+	// ResourcePolicyRefs references ResourcePolicies in order to set
+	// ResourcePolicies.
+	// +optional
+	ResourcePolicyRefs []xpv1.Reference `json:"resourcePolicyRefs,omitempty"`
+
+	// ResourcePolicySelector selects references to ResourcePolicies in
+	// order to set ResourcePolicies.
+	// +optional
+	ResourcePolicySelector *xpv1.Selector `json:"resourcePolicySelector,omitempty"`
+
+	// SizeGB: Size of the persistent disk, specified in GB. The size may
+	// be increased in place but a disk can never be shrunk. A smaller size
+	// is ignored and reported by the ResizeRefused condition.
+	// +optional
+	SizeGB *int64 `json:"sizeGb,omitempty"`
+
+	// SourceImage: The source image used to create this disk, for example
+	// projects/debian-cloud/global/images/family/debian-10.
+	// +optional
+	// +immutable
+	SourceImage *string `json:"sourceImage,omitempty"`
+
+	// SourceSnapshot: The partially qualified URL of the snapshot used to
+	// create this disk.
+	// +optional
+	// +immutable
+	SourceSnapshot *string `json:"sourceSnapshot,omitempty"`
+
+	// SourceSnapshotRef references a Snapshot in order to set
+	// SourceSnapshot.
+	// +optional
+	SourceSnapshotRef *xpv1.Reference `json:"sourceSnapshotRef,omitempty"`
+
+	// SourceSnapshotSelector selects a reference to a Snapshot in order to
+	// set SourceSnapshot.
+	// +optional
+	SourceSnapshotSelector *xpv1.Selector `json:"sourceSnapshotSelector,omitempty"`
+
+	// Type: The name of the disk type, for example pd-standard, pd-balanced
+	// or pd-ssd. Defaults to pd-standard.
+	// +optional
+	// +immutable
+	Type *string `json:"type,omitempty"`
+}
+
+// A CustomerEncryptionKey specifies a customer-managed Cloud KMS key used to
+// encrypt a disk or snapshot.
+type CustomerEncryptionKey struct {
+	// KMSKeyName: The name of the Cloud KMS CryptoKey, for example
+	// projects/example/locations/us-central1/keyRings/eg/cryptoKeys/eg.
+	// +optional
+	KMSKeyName *string `json:"kmsKeyName,omitempty"`
+
+	// KMSKeyNameRef references a CryptoKey in order to set KMSKeyName.
+	// +optional
+	KMSKeyNameRef *xpv1.Reference `json:"kmsKeyNameRef,omitempty"`
+
+	// KMSKeyNameSelector selects a reference to a CryptoKey in order to set
+	// KMSKeyName.
+	// +optional
+	KMSKeyNameSelector *xpv1.Selector `json:"kmsKeyNameSelector,omitempty"`
+
+	// KMSKeyServiceAccount: The service account used for the encryption
+	// request for the given KMS key. If absent, the Compute Engine default
+	// service account is used.
+	// +optional
+	KMSKeyServiceAccount *string `json:"kmsKeyServiceAccount,omitempty"`
+}
+
+// A DiskObservation reflects the observed state of a Disk on GCP.
+type DiskObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// KMSKeyVersion: The version of the Cloud KMS CryptoKey that protects
+	// this disk, if any.
+	KMSKeyVersion string `json:"kmsKeyVersion,omitempty"`
+
+	// LabelFingerprint: A fingerprint for the labels being applied to this
+	// disk, used for optimistic locking.
+	LabelFingerprint string `json:"labelFingerprint,omitempty"`
+
+	// LastAttachTimestamp: Last attach timestamp in RFC3339 text format.
+	LastAttachTimestamp string `json:"lastAttachTimestamp,omitempty"`
+
+	// LastDetachTimestamp: Last detach timestamp in RFC3339 text format.
+	LastDetachTimestamp string `json:"lastDetachTimestamp,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SizeGB: The current size of the persistent disk, specified in GB.
+	SizeGB int64 `json:"sizeGb,omitempty"`
+
+	// SourceImageID: The ID value of the image used to create this disk.
+	SourceImageID string `json:"sourceImageId,omitempty"`
+
+	// SourceSnapshotID: The unique ID of the snapshot used to create this
+	// disk.
+	SourceSnapshotID string `json:"sourceSnapshotId,omitempty"`
+
+	// Status: The status of disk creation.
+	//
+	// Possible values:
+	//   "CREATING"
+	//   "DELETING"
+	//   "FAILED"
+	//   "READY"
+	//   "RESTORING"
+	Status string `json:"status,omitempty"`
+
+	// Users: Links to the users of the disk (attached instances).
+	Users []string `json:"users,omitempty"`
+}
+
+// A DiskSpec defines the desired state of a Disk.
+type DiskSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DiskParameters `json:"forProvider"`
+}
+
+// A DiskStatus represents the observed state of a Disk.
+type DiskStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DiskObservation `json:"atProvider,omitempty"`
+}
+
+// A Disk is a managed resource that represents a Google Compute Engine zonal
+// or regional persistent disk.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SIZE",type="integer",JSONPath=".status.atProvider.sizeGb"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Disk struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DiskSpec   `json:"spec"`
+	Status DiskStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DiskList contains a list of Disk.
+type DiskList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Disk `json:"items"`
+}
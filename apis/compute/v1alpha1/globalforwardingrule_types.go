@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// GlobalForwardingRuleParameters define the desired state of a Google Compute
+// Engine global forwarding rule. Most fields map directly to a
+// ForwardingRule:
+// https://cloud.google.com/compute/docs/reference/rest/v1/globalForwardingRules
+type GlobalForwardingRuleParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// IPAddress: The IP address that this forwarding rule serves. It can
+	// be specified either as a literal IP address or as a URL reference to
+	// an existing GlobalAddress. If omitted, an ephemeral IP address is
+	// assigned.
+	// +optional
+	// +immutable
+	IPAddress *string `json:"ipAddress,omitempty"`
+
+	// IPAddressRef references a GlobalAddress to retrieve its URL.
+	// +optional
+	// +immutable
+	IPAddressRef *xpv1.Reference `json:"ipAddressRef,omitempty"`
+
+	// IPAddressSelector selects a reference to a GlobalAddress.
+	// +optional
+	// +immutable
+	IPAddressSelector *xpv1.Selector `json:"ipAddressSelector,omitempty"`
+
+	// IPProtocol: The IP protocol to which this rule applies. For external
+	// HTTP(S) load balancing only TCP is valid.
+	//
+	// Possible values:
+	//   "AH"
+	//   "ESP"
+	//   "ICMP"
+	//   "SCTP"
+	//   "TCP"
+	//   "UDP"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=AH;ESP;ICMP;SCTP;TCP;UDP
+	IPProtocol *string `json:"ipProtocol,omitempty"`
+
+	// IPVersion: The IP Version that will be used by this forwarding rule.
+	//
+	// Possible values:
+	//   "IPV4"
+	//   "IPV6"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=IPV4;IPV6
+	IPVersion *string `json:"ipVersion,omitempty"`
+
+	// Labels to apply to this forwarding rule.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// LoadBalancingScheme: Specifies the forwarding rule type.
+	//
+	// Possible values:
+	//   "EXTERNAL"
+	//   "INTERNAL_SELF_MANAGED"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=EXTERNAL;INTERNAL_SELF_MANAGED
+	LoadBalancingScheme *string `json:"loadBalancingScheme,omitempty"`
+
+	// NetworkTier: This signifies the networking tier used for configuring
+	// this load balancer. Global forwarding rules must use PREMIUM.
+	//
+	// Possible values:
+	//   "PREMIUM"
+	//   "STANDARD"
+	// +optional
+	// +immutable
+	// +kubebuilder:validation:Enum=PREMIUM;STANDARD
+	NetworkTier *string `json:"networkTier,omitempty"`
+
+	// PortRange: Packets addressed to ports in the specified range will be
+	// forwarded to target. For external HTTPS load balancing only port 443
+	// is valid.
+	// +optional
+	// +immutable
+	PortRange *string `json:"portRange,omitempty"`
+
+	// Target: The URL of the target resource to receive the matched
+	// traffic. For external HTTP(S) load balancing this is a
+	// TargetHttpsProxy.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// TargetRef references a TargetHTTPSProxy to retrieve its URL.
+	// +optional
+	TargetRef *xpv1.Reference `json:"targetRef,omitempty"`
+
+	// TargetSelector selects a reference to a TargetHTTPSProxy.
+	// +optional
+	TargetSelector *xpv1.Selector `json:"targetSelector,omitempty"`
+}
+
+// A GlobalForwardingRuleObservation reflects the observed state of a
+// GlobalForwardingRule on GCP.
+type GlobalForwardingRuleObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// IPAddress the forwarding rule serves.
+	IPAddress string `json:"ipAddress,omitempty"`
+
+	// LabelFingerprint: A fingerprint for the labels being applied to
+	// this resource, used for optimistic locking.
+	LabelFingerprint string `json:"labelFingerprint,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A GlobalForwardingRuleSpec defines the desired state of a
+// GlobalForwardingRule.
+type GlobalForwardingRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GlobalForwardingRuleParameters `json:"forProvider"`
+}
+
+// A GlobalForwardingRuleStatus represents the observed state of a
+// GlobalForwardingRule.
+type GlobalForwardingRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          GlobalForwardingRuleObservation `json:"atProvider,omitempty"`
+}
+
+// A GlobalForwardingRule is a managed resource that represents a Google
+// Compute Engine global forwarding rule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IP",type="string",JSONPath=".status.atProvider.ipAddress"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type GlobalForwardingRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GlobalForwardingRuleSpec   `json:"spec"`
+	Status GlobalForwardingRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GlobalForwardingRuleList contains a list of GlobalForwardingRule.
+type GlobalForwardingRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GlobalForwardingRule `json:"items"`
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// HealthCheckParameters define the desired state of a Google Compute Engine
+// global HealthCheck. Most fields map directly to a HealthCheck:
+// https://cloud.google.com/compute/docs/reference/rest/v1/healthChecks
+type HealthCheckParameters struct {
+	// CheckIntervalSec: How often (in seconds) to send a health check. The
+	// default value is 5 seconds.
+	// +optional
+	CheckIntervalSec *int64 `json:"checkIntervalSec,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// HealthyThreshold: A so-far unhealthy instance will be marked healthy
+	// after this many consecutive successes. The default value is 2.
+	// +optional
+	HealthyThreshold *int64 `json:"healthyThreshold,omitempty"`
+
+	// TimeoutSec: How long (in seconds) to wait before claiming failure.
+	// The default value is 5 seconds. It is invalid for timeoutSec to have
+	// greater value than checkIntervalSec.
+	// +optional
+	TimeoutSec *int64 `json:"timeoutSec,omitempty"`
+
+	// UnhealthyThreshold: A so-far healthy instance will be marked
+	// unhealthy after this many consecutive failures. The default value is
+	// 2.
+	// +optional
+	UnhealthyThreshold *int64 `json:"unhealthyThreshold,omitempty"`
+
+	// Type: Specifies the type of the healthCheck. Exactly one of the
+	// protocol specific health checks below must be specified, and it must
+	// match this type.
+	//
+	// Possible values:
+	//   "GRPC"
+	//   "HTTP"
+	//   "HTTP2"
+	//   "HTTPS"
+	//   "SSL"
+	//   "TCP"
+	// +kubebuilder:validation:Enum=GRPC;HTTP;HTTP2;HTTPS;SSL;TCP
+	Type string `json:"type"`
+
+	// GRPCHealthCheck configures a gRPC health check.
+	// +optional
+	GRPCHealthCheck *GRPCHealthCheck `json:"grpcHealthCheck,omitempty"`
+
+	// HTTPHealthCheck configures an HTTP health check.
+	// +optional
+	HTTPHealthCheck *HTTPHealthCheck `json:"httpHealthCheck,omitempty"`
+
+	// HTTPSHealthCheck configures an HTTPS health check.
+	// +optional
+	HTTPSHealthCheck *HTTPHealthCheck `json:"httpsHealthCheck,omitempty"`
+
+	// HTTP2HealthCheck configures an HTTP/2 health check.
+	// +optional
+	HTTP2HealthCheck *HTTPHealthCheck `json:"http2HealthCheck,omitempty"`
+
+	// SSLHealthCheck configures an SSL health check.
+	// +optional
+	SSLHealthCheck *TCPHealthCheck `json:"sslHealthCheck,omitempty"`
+
+	// TCPHealthCheck configures a TCP health check.
+	// +optional
+	TCPHealthCheck *TCPHealthCheck `json:"tcpHealthCheck,omitempty"`
+
+	// LogConfig: Configure logging on this health check.
+	// +optional
+	LogConfig *HealthCheckLogConfig `json:"logConfig,omitempty"`
+}
+
+// HTTPHealthCheck is the configuration of an HTTP, HTTPS or HTTP/2 health
+// check.
+type HTTPHealthCheck struct {
+	// Host: The value of the host header in the health check request. If
+	// left empty (default value), the IP on behalf of which this health
+	// check is performed will be used.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
+	// Port: The TCP port number for the health check request.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// PortName: Port name as defined in InstanceGroup#NamedPort#name. If
+	// both port and portName are defined, port takes precedence.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// PortSpecification: Specifies how port is selected for health
+	// checking.
+	//
+	// Possible values:
+	//   "USE_FIXED_PORT"
+	//   "USE_NAMED_PORT"
+	//   "USE_SERVING_PORT"
+	// +optional
+	// +kubebuilder:validation:Enum=USE_FIXED_PORT;USE_NAMED_PORT;USE_SERVING_PORT
+	PortSpecification *string `json:"portSpecification,omitempty"`
+
+	// ProxyHeader: Specifies the type of proxy header to append before
+	// sending data to the backend.
+	//
+	// Possible values:
+	//   "NONE"
+	//   "PROXY_V1"
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+
+	// RequestPath: The request path of the health check request. The
+	// default value is /.
+	// +optional
+	RequestPath *string `json:"requestPath,omitempty"`
+
+	// Response: The string to match anywhere in the first 1024 bytes of
+	// the response body. If left empty (the default value), the status
+	// code determines health.
+	// +optional
+	Response *string `json:"response,omitempty"`
+}
+
+// TCPHealthCheck is the configuration of a TCP or SSL health check.
+type TCPHealthCheck struct {
+	// Port: The TCP port number for the health check request.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// PortName: Port name as defined in InstanceGroup#NamedPort#name. If
+	// both port and portName are defined, port takes precedence.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// PortSpecification: Specifies how port is selected for health
+	// checking.
+	//
+	// Possible values:
+	//   "USE_FIXED_PORT"
+	//   "USE_NAMED_PORT"
+	//   "USE_SERVING_PORT"
+	// +optional
+	// +kubebuilder:validation:Enum=USE_FIXED_PORT;USE_NAMED_PORT;USE_SERVING_PORT
+	PortSpecification *string `json:"portSpecification,omitempty"`
+
+	// ProxyHeader: Specifies the type of proxy header to append before
+	// sending data to the backend.
+	//
+	// Possible values:
+	//   "NONE"
+	//   "PROXY_V1"
+	// +optional
+	// +kubebuilder:validation:Enum=NONE;PROXY_V1
+	ProxyHeader *string `json:"proxyHeader,omitempty"`
+
+	// Request: The application data to send once the connection has been
+	// established. The default value is empty.
+	// +optional
+	Request *string `json:"request,omitempty"`
+
+	// Response: The bytes to match against the beginning of the response
+	// data. If left empty (the default value), any response will indicate
+	// health.
+	// +optional
+	Response *string `json:"response,omitempty"`
+}
+
+// GRPCHealthCheck is the configuration of a gRPC health check.
+type GRPCHealthCheck struct {
+	// GRPCServiceName: The gRPC service name for the health check. The
+	// value of grpcServiceName has the following meanings by convention:
+	// - Empty serviceName means the overall status of all services at the
+	// backend.
+	// - Non-empty serviceName means the health of that gRPC service, as
+	// defined by the owner of the service.
+	// +optional
+	GRPCServiceName *string `json:"grpcServiceName,omitempty"`
+
+	// Port: The port number for the health check request.
+	// +optional
+	Port *int64 `json:"port,omitempty"`
+
+	// PortName: Port name as defined in InstanceGroup#NamedPort#name. If
+	// both port and portName are defined, port takes precedence.
+	// +optional
+	PortName *string `json:"portName,omitempty"`
+
+	// PortSpecification: Specifies how port is selected for health
+	// checking.
+	//
+	// Possible values:
+	//   "USE_FIXED_PORT"
+	//   "USE_NAMED_PORT"
+	//   "USE_SERVING_PORT"
+	// +optional
+	// +kubebuilder:validation:Enum=USE_FIXED_PORT;USE_NAMED_PORT;USE_SERVING_PORT
+	PortSpecification *string `json:"portSpecification,omitempty"`
+}
+
+// HealthCheckLogConfig configures logging on a health check.
+type HealthCheckLogConfig struct {
+	// Enable: Indicates whether or not to export logs. This is false by
+	// default, which means no health check logging will be done.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+}
+
+// A HealthCheckObservation reflects the observed state of a HealthCheck on
+// GCP.
+type HealthCheckObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A HealthCheckSpec defines the desired state of a HealthCheck.
+type HealthCheckSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HealthCheckParameters `json:"forProvider"`
+}
+
+// A HealthCheckStatus represents the observed state of a HealthCheck.
+type HealthCheckStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HealthCheckObservation `json:"atProvider,omitempty"`
+}
+
+// A HealthCheck is a managed resource that represents a Google Compute Engine
+// global health check.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type HealthCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HealthCheckSpec   `json:"spec"`
+	Status HealthCheckStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HealthCheckList contains a list of HealthCheck.
+type HealthCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HealthCheck `json:"items"`
+}
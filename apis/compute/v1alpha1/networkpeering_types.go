@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known NetworkPeering states.
+const (
+	NetworkPeeringStateActive   = "ACTIVE"
+	NetworkPeeringStateInactive = "INACTIVE"
+)
+
+// NetworkPeeringParameters define the desired state of one side of a Google
+// Compute Engine VPC Network Peering. A peering only becomes active once a
+// matching peering is also configured from the peer network, which may be
+// managed by another NetworkPeering that uses a ProviderConfig for the peer
+// network's project. Most fields map directly to a NetworkPeering:
+// https://cloud.google.com/compute/docs/reference/rest/v1/networks/addPeering
+type NetworkPeeringParameters struct {
+	// Network: The name or partially qualified URL of the local network,
+	// in the project of this resource's ProviderConfig.
+	// +optional
+	// +immutable
+	Network *string `json:"network,omitempty"`
+
+	// NetworkRef references a Network in order to set Network.
+	// +optional
+	NetworkRef *xpv1.Reference `json:"networkRef,omitempty"`
+
+	// NetworkSelector selects a reference to a Network in order to set
+	// Network.
+	// +optional
+	NetworkSelector *xpv1.Selector `json:"networkSelector,omitempty"`
+
+	// PeerNetwork: The partially qualified URL of the peer network, for
+	// example projects/example/global/networks/eg. The peer network may
+	// belong to a different project.
+	// +optional
+	// +immutable
+	PeerNetwork *string `json:"peerNetwork,omitempty"`
+
+	// PeerNetworkRef references a Network in order to set PeerNetwork.
+	// +optional
+	PeerNetworkRef *xpv1.Reference `json:"peerNetworkRef,omitempty"`
+
+	// PeerNetworkSelector selects a reference to a Network in order to set
+	// PeerNetwork.
+	// +optional
+	PeerNetworkSelector *xpv1.Selector `json:"peerNetworkSelector,omitempty"`
+
+	// ExportCustomRoutes: Whether to export the custom routes to the peer
+	// network.
+	// +optional
+	ExportCustomRoutes *bool `json:"exportCustomRoutes,omitempty"`
+
+	// ImportCustomRoutes: Whether to import the custom routes from the peer
+	// network.
+	// +optional
+	ImportCustomRoutes *bool `json:"importCustomRoutes,omitempty"`
+
+	// ExportSubnetRoutesWithPublicIP: Whether subnet routes with public IP
+	// range are exported. IPv4 special-use ranges are always exported to
+	// peers and are not controlled by this field.
+	// +optional
+	ExportSubnetRoutesWithPublicIP *bool `json:"exportSubnetRoutesWithPublicIp,omitempty"`
+
+	// ImportSubnetRoutesWithPublicIP: Whether subnet routes with public IP
+	// range are imported. IPv4 special-use ranges are always imported from
+	// peers and are not controlled by this field.
+	// +optional
+	ImportSubnetRoutesWithPublicIP *bool `json:"importSubnetRoutesWithPublicIp,omitempty"`
+}
+
+// A NetworkPeeringObservation reflects the observed state of a
+// NetworkPeering on GCP.
+type NetworkPeeringObservation struct {
+	// PeerMTU: Maximum Transmission Unit in bytes of the peer network.
+	PeerMTU int64 `json:"peerMtu,omitempty"`
+
+	// State: State for the peering, either ACTIVE or INACTIVE. The peering
+	// is ACTIVE when there's a matching configuration in the peer network.
+	//
+	// Possible values:
+	//   "ACTIVE"
+	//   "INACTIVE"
+	State string `json:"state,omitempty"`
+
+	// StateDetails: Details about the current state of the peering.
+	StateDetails string `json:"stateDetails,omitempty"`
+}
+
+// A NetworkPeeringSpec defines the desired state of a NetworkPeering.
+type NetworkPeeringSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       NetworkPeeringParameters `json:"forProvider"`
+}
+
+// A NetworkPeeringStatus represents the observed state of a NetworkPeering.
+type NetworkPeeringStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          NetworkPeeringObservation `json:"atProvider,omitempty"`
+}
+
+// A NetworkPeering is a managed resource that represents one side of a
+// Google Compute Engine VPC Network Peering.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type NetworkPeering struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkPeeringSpec   `json:"spec"`
+	Status NetworkPeeringStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkPeeringList contains a list of NetworkPeering.
+type NetworkPeeringList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkPeering `json:"items"`
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
+)
+
+// HealthCheckURL extracts the partially qualified URL of a HealthCheck.
+func HealthCheckURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		hc, ok := mg.(*HealthCheck)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(hc.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// BackendServiceURL extracts the partially qualified URL of a BackendService.
+func BackendServiceURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		bs, ok := mg.(*BackendService)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(bs.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// URLMapURL extracts the partially qualified URL of a URLMap.
+func URLMapURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		um, ok := mg.(*URLMap)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(um.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// SSLCertificateURL extracts the partially qualified URL of an
+// SSLCertificate.
+func SSLCertificateURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*SSLCertificate)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(c.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// TargetHTTPSProxyURL extracts the partially qualified URL of a
+// TargetHTTPSProxy.
+func TargetHTTPSProxyURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		p, ok := mg.(*TargetHTTPSProxy)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(p.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// SecurityPolicyURL extracts the partially qualified URL of a
+// SecurityPolicy.
+func SecurityPolicyURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		sp, ok := mg.(*SecurityPolicy)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(sp.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// DiskURL extracts the partially qualified URL of a Disk.
+func DiskURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		d, ok := mg.(*Disk)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(d.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// SnapshotURL extracts the partially qualified URL of a Snapshot.
+func SnapshotURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		s, ok := mg.(*Snapshot)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(s.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResourcePolicyURL extracts the partially qualified URL of a ResourcePolicy.
+func ResourcePolicyURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		rp, ok := mg.(*ResourcePolicy)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(rp.Status.AtProvider.SelfLink, v1beta1.ComputeURIPrefix)
+	}
+}
+
+// ResolveReferences of this BackendService
+func (mg *BackendService) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.healthChecks
+	rsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.HealthChecks,
+		References:    mg.Spec.ForProvider.HealthCheckRefs,
+		Selector:      mg.Spec.ForProvider.HealthCheckSelector,
+		To:            reference.To{Managed: &HealthCheck{}, List: &HealthCheckList{}},
+		Extract:       HealthCheckURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.healthChecks")
+	}
+	mg.Spec.ForProvider.HealthChecks = rsp.ResolvedValues
+	mg.Spec.ForProvider.HealthCheckRefs = rsp.ResolvedReferences
+
+	// Resolve spec.forProvider.securityPolicy
+	sp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SecurityPolicy),
+		Reference:    mg.Spec.ForProvider.SecurityPolicyRef,
+		Selector:     mg.Spec.ForProvider.SecurityPolicySelector,
+		To:           reference.To{Managed: &SecurityPolicy{}, List: &SecurityPolicyList{}},
+		Extract:      SecurityPolicyURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.securityPolicy")
+	}
+	mg.Spec.ForProvider.SecurityPolicy = reference.ToPtrValue(sp.ResolvedValue)
+	mg.Spec.ForProvider.SecurityPolicyRef = sp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this URLMap
+func (mg *URLMap) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.defaultService
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DefaultService),
+		Reference:    mg.Spec.ForProvider.DefaultServiceRef,
+		Selector:     mg.Spec.ForProvider.DefaultServiceSelector,
+		To:           reference.To{Managed: &BackendService{}, List: &BackendServiceList{}},
+		Extract:      BackendServiceURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.defaultService")
+	}
+	mg.Spec.ForProvider.DefaultService = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.DefaultServiceRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.PathMatchers {
+		pm := &mg.Spec.ForProvider.PathMatchers[i]
+
+		// Resolve spec.forProvider.pathMatchers[*].defaultService
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(pm.DefaultService),
+			Reference:    pm.DefaultServiceRef,
+			Selector:     pm.DefaultServiceSelector,
+			To:           reference.To{Managed: &BackendService{}, List: &BackendServiceList{}},
+			Extract:      BackendServiceURL(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.pathMatchers[%d].defaultService", i)
+		}
+		pm.DefaultService = reference.ToPtrValue(rsp.ResolvedValue)
+		pm.DefaultServiceRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.pathMatchers[*].pathRules[*].service
+		for j := range pm.PathRules {
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(pm.PathRules[j].Service),
+				Reference:    pm.PathRules[j].ServiceRef,
+				Selector:     pm.PathRules[j].ServiceSelector,
+				To:           reference.To{Managed: &BackendService{}, List: &BackendServiceList{}},
+				Extract:      BackendServiceURL(),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "spec.forProvider.pathMatchers[%d].pathRules[%d].service", i, j)
+			}
+			pm.PathRules[j].Service = reference.ToPtrValue(rsp.ResolvedValue)
+			pm.PathRules[j].ServiceRef = rsp.ResolvedReference
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this TargetHTTPSProxy
+func (mg *TargetHTTPSProxy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.urlMap
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.URLMap),
+		Reference:    mg.Spec.ForProvider.URLMapRef,
+		Selector:     mg.Spec.ForProvider.URLMapSelector,
+		To:           reference.To{Managed: &URLMap{}, List: &URLMapList{}},
+		Extract:      URLMapURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.urlMap")
+	}
+	mg.Spec.ForProvider.URLMap = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.URLMapRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.sslCertificates
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SSLCertificates,
+		References:    mg.Spec.ForProvider.SSLCertificateRefs,
+		Selector:      mg.Spec.ForProvider.SSLCertificateSelector,
+		To:            reference.To{Managed: &SSLCertificate{}, List: &SSLCertificateList{}},
+		Extract:       SSLCertificateURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sslCertificates")
+	}
+	mg.Spec.ForProvider.SSLCertificates = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SSLCertificateRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this GlobalForwardingRule
+func (mg *GlobalForwardingRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.ipAddress
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IPAddress),
+		Reference:    mg.Spec.ForProvider.IPAddressRef,
+		Selector:     mg.Spec.ForProvider.IPAddressSelector,
+		To:           reference.To{Managed: &v1beta1.GlobalAddress{}, List: &v1beta1.GlobalAddressList{}},
+		Extract:      v1beta1.GlobalAddressURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ipAddress")
+	}
+	mg.Spec.ForProvider.IPAddress = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IPAddressRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.target
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Target),
+		Reference:    mg.Spec.ForProvider.TargetRef,
+		Selector:     mg.Spec.ForProvider.TargetSelector,
+		To:           reference.To{Managed: &TargetHTTPSProxy{}, List: &TargetHTTPSProxyList{}},
+		Extract:      TargetHTTPSProxyURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.target")
+	}
+	mg.Spec.ForProvider.Target = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.TargetRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Disk
+func (mg *Disk) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.sourceSnapshot
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceSnapshot),
+		Reference:    mg.Spec.ForProvider.SourceSnapshotRef,
+		Selector:     mg.Spec.ForProvider.SourceSnapshotSelector,
+		To:           reference.To{Managed: &Snapshot{}, List: &SnapshotList{}},
+		Extract:      SnapshotURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceSnapshot")
+	}
+	mg.Spec.ForProvider.SourceSnapshot = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceSnapshotRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.resourcePolicies
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.ResourcePolicies,
+		References:    mg.Spec.ForProvider.ResourcePolicyRefs,
+		Selector:      mg.Spec.ForProvider.ResourcePolicySelector,
+		To:            reference.To{Managed: &ResourcePolicy{}, List: &ResourcePolicyList{}},
+		Extract:       ResourcePolicyURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.resourcePolicies")
+	}
+	mg.Spec.ForProvider.ResourcePolicies = mrsp.ResolvedValues
+	mg.Spec.ForProvider.ResourcePolicyRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.diskEncryptionKey.kmsKeyName
+	if k := mg.Spec.ForProvider.DiskEncryptionKey; k != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(k.KMSKeyName),
+			Reference:    k.KMSKeyNameRef,
+			Selector:     k.KMSKeyNameSelector,
+			To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+			Extract:      kmsv1alpha1.CryptoKeyRRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.diskEncryptionKey.kmsKeyName")
+		}
+		k.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+		k.KMSKeyNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this Snapshot
+func (mg *Snapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.sourceDisk
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.SourceDisk),
+		Reference:    mg.Spec.ForProvider.SourceDiskRef,
+		Selector:     mg.Spec.ForProvider.SourceDiskSelector,
+		To:           reference.To{Managed: &Disk{}, List: &DiskList{}},
+		Extract:      DiskURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.sourceDisk")
+	}
+	mg.Spec.ForProvider.SourceDisk = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SourceDiskRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.snapshotEncryptionKey.kmsKeyName
+	if k := mg.Spec.ForProvider.SnapshotEncryptionKey; k != nil {
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(k.KMSKeyName),
+			Reference:    k.KMSKeyNameRef,
+			Selector:     k.KMSKeyNameSelector,
+			To:           reference.To{Managed: &kmsv1alpha1.CryptoKey{}, List: &kmsv1alpha1.CryptoKeyList{}},
+			Extract:      kmsv1alpha1.CryptoKeyRRN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.snapshotEncryptionKey.kmsKeyName")
+		}
+		k.KMSKeyName = reference.ToPtrValue(rsp.ResolvedValue)
+		k.KMSKeyNameRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
+
+// ResolveReferences of this NetworkPeering
+func (mg *NetworkPeering) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.network
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Network),
+		Reference:    mg.Spec.ForProvider.NetworkRef,
+		Selector:     mg.Spec.ForProvider.NetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.network")
+	}
+	mg.Spec.ForProvider.Network = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.peerNetwork
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.PeerNetwork),
+		Reference:    mg.Spec.ForProvider.PeerNetworkRef,
+		Selector:     mg.Spec.ForProvider.PeerNetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Network{}, List: &v1beta1.NetworkList{}},
+		Extract:      v1beta1.NetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.peerNetwork")
+	}
+	mg.Spec.ForProvider.PeerNetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.PeerNetworkRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this SubnetworkIAMMember
+func (mg *SubnetworkIAMMember) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.subnetwork
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Subnetwork),
+		Reference:    mg.Spec.ForProvider.SubnetworkRef,
+		Selector:     mg.Spec.ForProvider.SubnetworkSelector,
+		To:           reference.To{Managed: &v1beta1.Subnetwork{}, List: &v1beta1.SubnetworkList{}},
+		Extract:      v1beta1.SubnetworkURL(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetwork")
+	}
+	mg.Spec.ForProvider.Subnetwork = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.SubnetworkRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.member
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Member),
+		Reference:    mg.Spec.ForProvider.ServiceAccountMemberRef,
+		Selector:     mg.Spec.ForProvider.ServiceAccountMemberSelector,
+		To:           reference.To{Managed: &iamv1alpha1.ServiceAccount{}, List: &iamv1alpha1.ServiceAccountList{}},
+		Extract:      iamv1alpha1.ServiceAccountMemberName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.member")
+	}
+	mg.Spec.ForProvider.Member = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ServiceAccountMemberRef = rsp.ResolvedReference
+
+	return nil
+}
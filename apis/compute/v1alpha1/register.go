@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "compute.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// HealthCheck type metadata.
+var (
+	HealthCheckKind             = reflect.TypeOf(HealthCheck{}).Name()
+	HealthCheckGroupKind        = schema.GroupKind{Group: Group, Kind: HealthCheckKind}.String()
+	HealthCheckKindAPIVersion   = HealthCheckKind + "." + SchemeGroupVersion.String()
+	HealthCheckGroupVersionKind = SchemeGroupVersion.WithKind(HealthCheckKind)
+)
+
+// BackendService type metadata.
+var (
+	BackendServiceKind             = reflect.TypeOf(BackendService{}).Name()
+	BackendServiceGroupKind        = schema.GroupKind{Group: Group, Kind: BackendServiceKind}.String()
+	BackendServiceKindAPIVersion   = BackendServiceKind + "." + SchemeGroupVersion.String()
+	BackendServiceGroupVersionKind = SchemeGroupVersion.WithKind(BackendServiceKind)
+)
+
+// URLMap type metadata.
+var (
+	URLMapKind             = reflect.TypeOf(URLMap{}).Name()
+	URLMapGroupKind        = schema.GroupKind{Group: Group, Kind: URLMapKind}.String()
+	URLMapKindAPIVersion   = URLMapKind + "." + SchemeGroupVersion.String()
+	URLMapGroupVersionKind = SchemeGroupVersion.WithKind(URLMapKind)
+)
+
+// SSLCertificate type metadata.
+var (
+	SSLCertificateKind             = reflect.TypeOf(SSLCertificate{}).Name()
+	SSLCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: SSLCertificateKind}.String()
+	SSLCertificateKindAPIVersion   = SSLCertificateKind + "." + SchemeGroupVersion.String()
+	SSLCertificateGroupVersionKind = SchemeGroupVersion.WithKind(SSLCertificateKind)
+)
+
+// TargetHTTPSProxy type metadata.
+var (
+	TargetHTTPSProxyKind             = reflect.TypeOf(TargetHTTPSProxy{}).Name()
+	TargetHTTPSProxyGroupKind        = schema.GroupKind{Group: Group, Kind: TargetHTTPSProxyKind}.String()
+	TargetHTTPSProxyKindAPIVersion   = TargetHTTPSProxyKind + "." + SchemeGroupVersion.String()
+	TargetHTTPSProxyGroupVersionKind = SchemeGroupVersion.WithKind(TargetHTTPSProxyKind)
+)
+
+// GlobalForwardingRule type metadata.
+var (
+	GlobalForwardingRuleKind             = reflect.TypeOf(GlobalForwardingRule{}).Name()
+	GlobalForwardingRuleGroupKind        = schema.GroupKind{Group: Group, Kind: GlobalForwardingRuleKind}.String()
+	GlobalForwardingRuleKindAPIVersion   = GlobalForwardingRuleKind + "." + SchemeGroupVersion.String()
+	GlobalForwardingRuleGroupVersionKind = SchemeGroupVersion.WithKind(GlobalForwardingRuleKind)
+)
+
+// SecurityPolicy type metadata.
+var (
+	SecurityPolicyKind             = reflect.TypeOf(SecurityPolicy{}).Name()
+	SecurityPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: SecurityPolicyKind}.String()
+	SecurityPolicyKindAPIVersion   = SecurityPolicyKind + "." + SchemeGroupVersion.String()
+	SecurityPolicyGroupVersionKind = SchemeGroupVersion.WithKind(SecurityPolicyKind)
+)
+
+// Disk type metadata.
+var (
+	DiskKind             = reflect.TypeOf(Disk{}).Name()
+	DiskGroupKind        = schema.GroupKind{Group: Group, Kind: DiskKind}.String()
+	DiskKindAPIVersion   = DiskKind + "." + SchemeGroupVersion.String()
+	DiskGroupVersionKind = SchemeGroupVersion.WithKind(DiskKind)
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// ResourcePolicy type metadata.
+var (
+	ResourcePolicyKind             = reflect.TypeOf(ResourcePolicy{}).Name()
+	ResourcePolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ResourcePolicyKind}.String()
+	ResourcePolicyKindAPIVersion   = ResourcePolicyKind + "." + SchemeGroupVersion.String()
+	ResourcePolicyGroupVersionKind = SchemeGroupVersion.WithKind(ResourcePolicyKind)
+)
+
+// NetworkPeering type metadata.
+var (
+	NetworkPeeringKind             = reflect.TypeOf(NetworkPeering{}).Name()
+	NetworkPeeringGroupKind        = schema.GroupKind{Group: Group, Kind: NetworkPeeringKind}.String()
+	NetworkPeeringKindAPIVersion   = NetworkPeeringKind + "." + SchemeGroupVersion.String()
+	NetworkPeeringGroupVersionKind = SchemeGroupVersion.WithKind(NetworkPeeringKind)
+)
+
+// SharedVPCHostProject type metadata.
+var (
+	SharedVPCHostProjectKind             = reflect.TypeOf(SharedVPCHostProject{}).Name()
+	SharedVPCHostProjectGroupKind        = schema.GroupKind{Group: Group, Kind: SharedVPCHostProjectKind}.String()
+	SharedVPCHostProjectKindAPIVersion   = SharedVPCHostProjectKind + "." + SchemeGroupVersion.String()
+	SharedVPCHostProjectGroupVersionKind = SchemeGroupVersion.WithKind(SharedVPCHostProjectKind)
+)
+
+// SharedVPCServiceProject type metadata.
+var (
+	SharedVPCServiceProjectKind             = reflect.TypeOf(SharedVPCServiceProject{}).Name()
+	SharedVPCServiceProjectGroupKind        = schema.GroupKind{Group: Group, Kind: SharedVPCServiceProjectKind}.String()
+	SharedVPCServiceProjectKindAPIVersion   = SharedVPCServiceProjectKind + "." + SchemeGroupVersion.String()
+	SharedVPCServiceProjectGroupVersionKind = SchemeGroupVersion.WithKind(SharedVPCServiceProjectKind)
+)
+
+// SubnetworkIAMMember type metadata.
+var (
+	SubnetworkIAMMemberKind             = reflect.TypeOf(SubnetworkIAMMember{}).Name()
+	SubnetworkIAMMemberGroupKind        = schema.GroupKind{Group: Group, Kind: SubnetworkIAMMemberKind}.String()
+	SubnetworkIAMMemberKindAPIVersion   = SubnetworkIAMMemberKind + "." + SchemeGroupVersion.String()
+	SubnetworkIAMMemberGroupVersionKind = SchemeGroupVersion.WithKind(SubnetworkIAMMemberKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&HealthCheck{}, &HealthCheckList{})
+	SchemeBuilder.Register(&BackendService{}, &BackendServiceList{})
+	SchemeBuilder.Register(&URLMap{}, &URLMapList{})
+	SchemeBuilder.Register(&SSLCertificate{}, &SSLCertificateList{})
+	SchemeBuilder.Register(&TargetHTTPSProxy{}, &TargetHTTPSProxyList{})
+	SchemeBuilder.Register(&GlobalForwardingRule{}, &GlobalForwardingRuleList{})
+	SchemeBuilder.Register(&SecurityPolicy{}, &SecurityPolicyList{})
+	SchemeBuilder.Register(&Disk{}, &DiskList{})
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&ResourcePolicy{}, &ResourcePolicyList{})
+	SchemeBuilder.Register(&NetworkPeering{}, &NetworkPeeringList{})
+	SchemeBuilder.Register(&SharedVPCHostProject{}, &SharedVPCHostProjectList{})
+	SchemeBuilder.Register(&SharedVPCServiceProject{}, &SharedVPCServiceProjectList{})
+	SchemeBuilder.Register(&SubnetworkIAMMember{}, &SubnetworkIAMMemberList{})
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known ResourcePolicy statuses.
+const (
+	ResourcePolicyStatusCreating = "CREATING"
+	ResourcePolicyStatusDeleting = "DELETING"
+	ResourcePolicyStatusExpired  = "EXPIRED"
+	ResourcePolicyStatusInvalid  = "INVALID"
+	ResourcePolicyStatusReady    = "READY"
+)
+
+// Possible values of a ResourcePolicySnapshotRetentionPolicy
+// OnSourceDiskDelete.
+const (
+	OnSourceDiskDeleteApplyRetentionPolicy = "APPLY_RETENTION_POLICY"
+	OnSourceDiskDeleteKeepAutoSnapshots    = "KEEP_AUTO_SNAPSHOTS"
+)
+
+// ResourcePolicyParameters define the desired state of a Google Compute
+// Engine resource policy. Only snapshot schedule policies are supported.
+// Resource policies cannot be changed once created. Most fields map directly
+// to a ResourcePolicy:
+// https://cloud.google.com/compute/docs/reference/rest/v1/resourcePolicies
+type ResourcePolicyParameters struct {
+	// Region: The region in which this resource policy resides. Disks may
+	// only use resource policies in their own region.
+	// +immutable
+	Region string `json:"region"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// SnapshotSchedulePolicy: A policy for creating snapshots of the disks
+	// that this resource policy is attached to.
+	// +immutable
+	SnapshotSchedulePolicy ResourcePolicySnapshotSchedulePolicy `json:"snapshotSchedulePolicy"`
+}
+
+// A ResourcePolicySnapshotSchedulePolicy specifies when and how snapshots
+// are taken, and how long they are retained.
+type ResourcePolicySnapshotSchedulePolicy struct {
+	// Schedule: Specifies when snapshots are taken. Exactly one of
+	// DailySchedule, HourlySchedule or WeeklySchedule must be specified.
+	Schedule ResourcePolicySnapshotSchedule `json:"schedule"`
+
+	// RetentionPolicy: Retention policy applied to snapshots created by
+	// this resource policy.
+	// +optional
+	RetentionPolicy *ResourcePolicySnapshotRetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// SnapshotProperties: Properties with which snapshots are created such
+	// as labels and storage locations.
+	// +optional
+	SnapshotProperties *ResourcePolicySnapshotProperties `json:"snapshotProperties,omitempty"`
+}
+
+// A ResourcePolicySnapshotSchedule specifies when snapshots are taken.
+type ResourcePolicySnapshotSchedule struct {
+	// DailySchedule takes a snapshot once a day.
+	// +optional
+	DailySchedule *ResourcePolicyDailyCycle `json:"dailySchedule,omitempty"`
+
+	// HourlySchedule takes a snapshot every few hours.
+	// +optional
+	HourlySchedule *ResourcePolicyHourlyCycle `json:"hourlySchedule,omitempty"`
+
+	// WeeklySchedule takes a snapshot on the specified days of the week.
+	// +optional
+	WeeklySchedule *ResourcePolicyWeeklyCycle `json:"weeklySchedule,omitempty"`
+}
+
+// A ResourcePolicyDailyCycle is a schedule that runs every day.
+type ResourcePolicyDailyCycle struct {
+	// DaysInCycle: Defines a schedule with units measured in days. The
+	// value determines how many days pass between the start of each cycle.
+	// +kubebuilder:validation:Minimum=1
+	DaysInCycle int64 `json:"daysInCycle"`
+
+	// StartTime: Start time of the window in UTC, in the 24 hour format
+	// HH:MM. It must be on the hour.
+	StartTime string `json:"startTime"`
+}
+
+// A ResourcePolicyHourlyCycle is a schedule that runs every few hours.
+type ResourcePolicyHourlyCycle struct {
+	// HoursInCycle: Defines a schedule with units measured in hours. The
+	// value determines how many hours pass between the start of each
+	// cycle.
+	// +kubebuilder:validation:Minimum=1
+	HoursInCycle int64 `json:"hoursInCycle"`
+
+	// StartTime: Start time of the window in UTC, in the 24 hour format
+	// HH:MM. It must be on the hour.
+	StartTime string `json:"startTime"`
+}
+
+// A ResourcePolicyWeeklyCycle is a schedule that runs on specific days of
+// the week.
+type ResourcePolicyWeeklyCycle struct {
+	// DayOfWeeks: Up to 7 intervals/windows, one for each day of the week.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=7
+	DayOfWeeks []ResourcePolicyWeeklyCycleDayOfWeek `json:"dayOfWeeks"`
+}
+
+// A ResourcePolicyWeeklyCycleDayOfWeek is a window on one day of the week.
+type ResourcePolicyWeeklyCycleDayOfWeek struct {
+	// Day: The day of the week.
+	// +kubebuilder:validation:Enum=MONDAY;TUESDAY;WEDNESDAY;THURSDAY;FRIDAY;SATURDAY;SUNDAY
+	Day string `json:"day"`
+
+	// StartTime: Start time of the window in UTC, in the 24 hour format
+	// HH:MM. It must be on the hour.
+	StartTime string `json:"startTime"`
+}
+
+// A ResourcePolicySnapshotRetentionPolicy specifies how long snapshots
+// created by a schedule are retained.
+type ResourcePolicySnapshotRetentionPolicy struct {
+	// MaxRetentionDays: Maximum age of the snapshot that is allowed to be
+	// kept.
+	// +kubebuilder:validation:Minimum=1
+	MaxRetentionDays int64 `json:"maxRetentionDays"`
+
+	// OnSourceDiskDelete: Specifies the behavior to apply to scheduled
+	// snapshots when the source disk is deleted.
+	//
+	// Possible values:
+	//   "APPLY_RETENTION_POLICY"
+	//   "KEEP_AUTO_SNAPSHOTS"
+	// +optional
+	// +kubebuilder:validation:Enum=APPLY_RETENTION_POLICY;KEEP_AUTO_SNAPSHOTS
+	OnSourceDiskDelete *string `json:"onSourceDiskDelete,omitempty"`
+}
+
+// ResourcePolicySnapshotProperties specify the properties of snapshots
+// created by a schedule.
+type ResourcePolicySnapshotProperties struct {
+	// GuestFlush: Indication to perform a 'guest aware' snapshot.
+	// +optional
+	GuestFlush *bool `json:"guestFlush,omitempty"`
+
+	// Labels to apply to scheduled snapshots.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// StorageLocations: Cloud Storage bucket storage location of the auto
+	// snapshot (regional or multi-regional).
+	// +optional
+	StorageLocations []string `json:"storageLocations,omitempty"`
+}
+
+// A ResourcePolicyObservation reflects the observed state of a
+// ResourcePolicy on GCP.
+type ResourcePolicyObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status: The status of the resource policy.
+	//
+	// Possible values:
+	//   "CREATING"
+	//   "DELETING"
+	//   "EXPIRED"
+	//   "INVALID"
+	//   "READY"
+	Status string `json:"status,omitempty"`
+}
+
+// A ResourcePolicySpec defines the desired state of a ResourcePolicy.
+type ResourcePolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourcePolicyParameters `json:"forProvider"`
+}
+
+// A ResourcePolicyStatus represents the observed state of a ResourcePolicy.
+type ResourcePolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourcePolicyObservation `json:"atProvider,omitempty"`
+}
+
+// A ResourcePolicy is a managed resource that represents a Google Compute
+// Engine snapshot schedule.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type ResourcePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourcePolicySpec   `json:"spec"`
+	Status ResourcePolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourcePolicyList contains a list of ResourcePolicy.
+type ResourcePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePolicy `json:"items"`
+}
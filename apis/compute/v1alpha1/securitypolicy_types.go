@@ -0,0 +1,272 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// DefaultSecurityPolicyRulePriority is the priority of the default rule that
+// every security policy has. The default rule may be modified but cannot be
+// removed.
+const DefaultSecurityPolicyRulePriority = 2147483647
+
+// Possible values of a SecurityPolicyRuleMatcher VersionedExpr.
+const (
+	VersionedExprSrcIPsV1 = "SRC_IPS_V1"
+)
+
+// Possible values of a SecurityPolicyRuleRateLimitOptions EnforceOnKey.
+const (
+	EnforceOnKeyAll        = "ALL"
+	EnforceOnKeyHTTPCookie = "HTTP_COOKIE"
+	EnforceOnKeyHTTPHeader = "HTTP_HEADER"
+	EnforceOnKeyIP         = "IP"
+	EnforceOnKeyXFFIP      = "XFF_IP"
+)
+
+// SecurityPolicyParameters define the desired state of a Google Cloud Armor
+// security policy. Most fields map directly to a SecurityPolicy:
+// https://cloud.google.com/compute/docs/reference/rest/v1/securityPolicies
+type SecurityPolicyParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Rules: The rules that belong to this policy. Rules are identified by
+	// their priority; rules that are added, changed or removed are
+	// reconciled one at a time so that the policy is never left without
+	// its remaining rules. A default rule with priority 2147483647 always
+	// exists; if it is omitted here it is left as is.
+	// +optional
+	Rules []SecurityPolicyRule `json:"rules,omitempty"`
+}
+
+// A SecurityPolicyRule is a rule of a SecurityPolicy.
+type SecurityPolicyRule struct {
+	// Action: The action to perform when the client connection triggers
+	// the rule. Can currently be "allow", "deny(STATUS)", where valid
+	// values for STATUS are 403, 404, and 502, "rate_based_ban" or
+	// "throttle". The rate_based_ban and throttle actions require
+	// RateLimitOptions.
+	Action string `json:"action"`
+
+	// Description: An optional description of this rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Match: A match condition that incoming traffic is evaluated against.
+	// If it evaluates to true, the corresponding action is enforced.
+	Match SecurityPolicyRuleMatcher `json:"match"`
+
+	// Preview: If set to true, the specified action is not enforced.
+	// +optional
+	Preview *bool `json:"preview,omitempty"`
+
+	// Priority: An integer indicating the priority of a rule in the list.
+	// Rules are evaluated from highest to lowest priority where 0 is the
+	// highest priority and 2147483647 is the lowest priority. Priorities
+	// must be unique within a policy.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2147483647
+	Priority int64 `json:"priority"`
+
+	// RateLimitOptions: Must be specified if the action is "rate_based_ban"
+	// or "throttle", and cannot be specified for any other action.
+	// +optional
+	RateLimitOptions *SecurityPolicyRuleRateLimitOptions `json:"rateLimitOptions,omitempty"`
+}
+
+// A SecurityPolicyRuleMatcher represents a match condition that incoming
+// traffic is evaluated against. Exactly one of Expr or VersionedExpr must be
+// specified.
+type SecurityPolicyRuleMatcher struct {
+	// Config: The configuration options available when specifying
+	// VersionedExpr. This field must be specified if VersionedExpr is
+	// specified.
+	// +optional
+	Config *SecurityPolicyRuleMatcherConfig `json:"config,omitempty"`
+
+	// Expr: A user defined Common Expression Language (CEL) expression.
+	// +optional
+	Expr *SecurityPolicyRuleExpr `json:"expr,omitempty"`
+
+	// VersionedExpr: Preconfigured versioned expression. SRC_IPS_V1
+	// requires the SrcIPRanges field of Config.
+	//
+	// Possible values:
+	//   "SRC_IPS_V1"
+	// +optional
+	// +kubebuilder:validation:Enum=SRC_IPS_V1
+	VersionedExpr *string `json:"versionedExpr,omitempty"`
+}
+
+// SecurityPolicyRuleMatcherConfig configures a versioned match expression.
+type SecurityPolicyRuleMatcherConfig struct {
+	// SrcIPRanges: CIDR IP address ranges. A maximum of 10 ranges are
+	// allowed.
+	// +kubebuilder:validation:MaxItems=10
+	SrcIPRanges []string `json:"srcIpRanges"`
+}
+
+// SecurityPolicyRuleExpr is a Common Expression Language (CEL) expression.
+type SecurityPolicyRuleExpr struct {
+	// Expression: Textual representation of an expression in Common
+	// Expression Language syntax, e.g.
+	// "origin.region_code == 'AU'".
+	Expression string `json:"expression"`
+}
+
+// SecurityPolicyRuleRateLimitOptions configure the rate limit of a
+// rate_based_ban or throttle rule.
+type SecurityPolicyRuleRateLimitOptions struct {
+	// BanDurationSec: Can only be specified if the action for the rule is
+	// "rate_based_ban". If specified, determines the time (in seconds) the
+	// traffic will continue to be banned by the rate limit after the rate
+	// falls below the threshold.
+	// +optional
+	BanDurationSec *int64 `json:"banDurationSec,omitempty"`
+
+	// BanThreshold: Can only be specified if the action for the rule is
+	// "rate_based_ban". If specified, the key will be banned for
+	// BanDurationSec when the number of requests that exceed
+	// RateLimitThreshold also exceed this threshold.
+	// +optional
+	BanThreshold *SecurityPolicyRuleRateLimitThreshold `json:"banThreshold,omitempty"`
+
+	// ConformAction: Action to take for requests that are under the
+	// configured rate limit threshold. Valid option is "allow" only.
+	ConformAction string `json:"conformAction"`
+
+	// EnforceOnKey: Determines the key to enforce the RateLimitThreshold
+	// on. Defaults to ALL, which applies a single threshold to all the
+	// requests matching this rule. HTTP_HEADER and HTTP_COOKIE require
+	// EnforceOnKeyName.
+	//
+	// Possible values:
+	//   "ALL"
+	//   "HTTP_COOKIE"
+	//   "HTTP_HEADER"
+	//   "IP"
+	//   "XFF_IP"
+	// +optional
+	// +kubebuilder:validation:Enum=ALL;HTTP_COOKIE;HTTP_HEADER;IP;XFF_IP
+	EnforceOnKey *string `json:"enforceOnKey,omitempty"`
+
+	// EnforceOnKeyName: The name of the HTTP header or cookie whose value
+	// is taken as the key value when EnforceOnKey is HTTP_HEADER or
+	// HTTP_COOKIE.
+	// +optional
+	EnforceOnKeyName *string `json:"enforceOnKeyName,omitempty"`
+
+	// ExceedAction: Action to take for requests that are above the
+	// configured rate limit threshold, to either deny with a specified HTTP
+	// response code, or redirect to a different endpoint. Valid options are
+	// "deny(STATUS)", where valid values for STATUS are 403, 404, 429, and
+	// 502, and "redirect", which requires ExceedRedirectOptions.
+	ExceedAction string `json:"exceedAction"`
+
+	// ExceedRedirectOptions: Parameters defining the redirect action that
+	// is used as the exceed action. Cannot be specified if the exceed
+	// action is not redirect.
+	// +optional
+	ExceedRedirectOptions *SecurityPolicyRuleRedirectOptions `json:"exceedRedirectOptions,omitempty"`
+
+	// RateLimitThreshold: Threshold at which to begin rate limiting.
+	RateLimitThreshold SecurityPolicyRuleRateLimitThreshold `json:"rateLimitThreshold"`
+}
+
+// A SecurityPolicyRuleRateLimitThreshold is a number of requests over an
+// interval.
+type SecurityPolicyRuleRateLimitThreshold struct {
+	// Count: Number of HTTP(S) requests for calculating the threshold.
+	Count int64 `json:"count"`
+
+	// IntervalSec: Interval over which the threshold is computed.
+	IntervalSec int64 `json:"intervalSec"`
+}
+
+// SecurityPolicyRuleRedirectOptions configure a redirect action.
+type SecurityPolicyRuleRedirectOptions struct {
+	// Target: Target for the redirect action. This is required if the type
+	// is EXTERNAL_302 and cannot be specified for GOOGLE_RECAPTCHA.
+	// +optional
+	Target *string `json:"target,omitempty"`
+
+	// Type: Type of the redirect action.
+	//
+	// Possible values:
+	//   "EXTERNAL_302"
+	//   "GOOGLE_RECAPTCHA"
+	// +kubebuilder:validation:Enum=EXTERNAL_302;GOOGLE_RECAPTCHA
+	Type string `json:"type"`
+}
+
+// A SecurityPolicyObservation reflects the observed state of a
+// SecurityPolicy on GCP.
+type SecurityPolicyObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint of this resource. A hash of the contents stored in this
+	// object. This field is used in optimistic locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A SecurityPolicySpec defines the desired state of a SecurityPolicy.
+type SecurityPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SecurityPolicyParameters `json:"forProvider"`
+}
+
+// A SecurityPolicyStatus represents the observed state of a SecurityPolicy.
+type SecurityPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SecurityPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// A SecurityPolicy is a managed resource that represents a Google Cloud
+// Armor security policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecurityPolicySpec   `json:"spec"`
+	Status SecurityPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecurityPolicyList contains a list of SecurityPolicy.
+type SecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecurityPolicy `json:"items"`
+}
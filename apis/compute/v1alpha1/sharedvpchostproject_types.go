@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SharedVPCHostProjectParameters define the desired state of a Shared VPC
+// host project. The host project is always the project of this resource's
+// ProviderConfig, so there is nothing else to configure.
+// https://cloud.google.com/compute/docs/reference/rest/v1/projects/enableXpnHost
+type SharedVPCHostProjectParameters struct{}
+
+// A SharedVPCHostProjectSpec defines the desired state of a
+// SharedVPCHostProject.
+type SharedVPCHostProjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+
+	// +optional
+	ForProvider SharedVPCHostProjectParameters `json:"forProvider,omitempty"`
+}
+
+// A SharedVPCHostProjectStatus represents the observed state of a
+// SharedVPCHostProject.
+type SharedVPCHostProjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// A SharedVPCHostProject is a managed resource that enables the project of
+// its ProviderConfig as a Shared VPC host project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SharedVPCHostProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SharedVPCHostProjectSpec   `json:"spec"`
+	Status SharedVPCHostProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SharedVPCHostProjectList contains a list of SharedVPCHostProject.
+type SharedVPCHostProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SharedVPCHostProject `json:"items"`
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// SharedVPCServiceProjectParameters define the desired state of a service
+// project attached to a Shared VPC host project. The host project is the
+// project of this resource's ProviderConfig, which must already be enabled
+// as a host, for example by a SharedVPCHostProject.
+// https://cloud.google.com/compute/docs/reference/rest/v1/projects/enableXpnResource
+type SharedVPCServiceProjectParameters struct {
+	// ServiceProject: The ID of the project to attach to the host project
+	// as a service project.
+	// +immutable
+	ServiceProject string `json:"serviceProject"`
+}
+
+// A SharedVPCServiceProjectSpec defines the desired state of a
+// SharedVPCServiceProject.
+type SharedVPCServiceProjectSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SharedVPCServiceProjectParameters `json:"forProvider"`
+}
+
+// A SharedVPCServiceProjectStatus represents the observed state of a
+// SharedVPCServiceProject.
+type SharedVPCServiceProjectStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// A SharedVPCServiceProject is a managed resource that attaches a service
+// project to a Shared VPC host project.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE-PROJECT",type="string",JSONPath=".spec.forProvider.serviceProject"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SharedVPCServiceProject struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SharedVPCServiceProjectSpec   `json:"spec"`
+	Status SharedVPCServiceProjectStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SharedVPCServiceProjectList contains a list of SharedVPCServiceProject.
+type SharedVPCServiceProjectList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SharedVPCServiceProject `json:"items"`
+}
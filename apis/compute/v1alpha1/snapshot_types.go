@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known Snapshot statuses.
+const (
+	SnapshotStatusCreating  = "CREATING"
+	SnapshotStatusDeleting  = "DELETING"
+	SnapshotStatusFailed    = "FAILED"
+	SnapshotStatusReady     = "READY"
+	SnapshotStatusUploading = "UPLOADING"
+)
+
+// SnapshotParameters define the desired state of a Google Compute Engine
+// persistent disk snapshot. Most fields map directly to a Snapshot:
+// https://cloud.google.com/compute/docs/reference/rest/v1/snapshots
+type SnapshotParameters struct {
+	// SourceDisk: The partially qualified URL of the zonal or regional disk
+	// to snapshot, for example projects/example/zones/us-central1-a/disks/eg.
+	// +optional
+	// +immutable
+	SourceDisk *string `json:"sourceDisk,omitempty"`
+
+	// SourceDiskRef references a Disk in order to set SourceDisk.
+	// +optional
+	SourceDiskRef *xpv1.Reference `json:"sourceDiskRef,omitempty"`
+
+	// SourceDiskSelector selects a reference to a Disk in order to set
+	// SourceDisk.
+	// +optional
+	SourceDiskSelector *xpv1.Selector `json:"sourceDiskSelector,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Labels to apply to this snapshot.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// SnapshotEncryptionKey: Encrypts the snapshot using a customer-managed
+	// encryption key. If omitted the snapshot is encrypted using a key
+	// managed by Google.
+	// +optional
+	// +immutable
+	SnapshotEncryptionKey *CustomerEncryptionKey `json:"snapshotEncryptionKey,omitempty"`
+
+	// StorageLocations: Cloud Storage bucket storage location of the
+	// snapshot (regional or multi-regional).
+	// +optional
+	// +immutable
+	StorageLocations []string `json:"storageLocations,omitempty"`
+}
+
+// A SnapshotObservation reflects the observed state of a Snapshot on GCP.
+type SnapshotObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// DiskSizeGB: Size of the source disk, specified in GB.
+	DiskSizeGB int64 `json:"diskSizeGb,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// LabelFingerprint: A fingerprint for the labels being applied to this
+	// snapshot, used for optimistic locking.
+	LabelFingerprint string `json:"labelFingerprint,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// SourceDiskID: The ID value of the disk used to create this snapshot.
+	SourceDiskID string `json:"sourceDiskId,omitempty"`
+
+	// Status: The status of the snapshot.
+	//
+	// Possible values:
+	//   "CREATING"
+	//   "DELETING"
+	//   "FAILED"
+	//   "READY"
+	//   "UPLOADING"
+	Status string `json:"status,omitempty"`
+
+	// StorageBytes: A size of the storage used by the snapshot.
+	StorageBytes int64 `json:"storageBytes,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// A Snapshot is a managed resource that represents a Google Compute Engine
+// persistent disk snapshot.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshot.
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Known SSLCertificate types.
+const (
+	SSLCertificateTypeManaged     = "MANAGED"
+	SSLCertificateTypeSelfManaged = "SELF_MANAGED"
+)
+
+// Known managed SSLCertificate statuses.
+const (
+	SSLCertificateStatusActive                        = "ACTIVE"
+	SSLCertificateStatusProvisioning                  = "PROVISIONING"
+	SSLCertificateStatusProvisioningFailed            = "PROVISIONING_FAILED"
+	SSLCertificateStatusProvisioningFailedPermanently = "PROVISIONING_FAILED_PERMANENTLY"
+	SSLCertificateStatusRenewalFailed                 = "RENEWAL_FAILED"
+)
+
+// SSLCertificateParameters define the desired state of a Google Compute
+// Engine global SSL certificate. Most fields map directly to an
+// SslCertificate:
+// https://cloud.google.com/compute/docs/reference/rest/v1/sslCertificates
+type SSLCertificateParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Type: Specifies the type of SSL certificate, either "SELF_MANAGED"
+	// or "MANAGED".
+	//
+	// Possible values:
+	//   "MANAGED"
+	//   "SELF_MANAGED"
+	// +immutable
+	// +kubebuilder:validation:Enum=MANAGED;SELF_MANAGED
+	Type string `json:"type"`
+
+	// Managed: Configuration and status of a managed SSL certificate. Must
+	// be set if type is MANAGED.
+	// +optional
+	// +immutable
+	Managed *ManagedSSLCertificate `json:"managed,omitempty"`
+
+	// SelfManaged: Configuration of a self-managed SSL certificate. Must
+	// be set if type is SELF_MANAGED.
+	// +optional
+	// +immutable
+	SelfManaged *SelfManagedSSLCertificate `json:"selfManaged,omitempty"`
+}
+
+// ManagedSSLCertificate is the configuration of a Google-managed SSL
+// certificate.
+type ManagedSSLCertificate struct {
+	// Domains: The domains for which a managed SSL certificate will be
+	// generated. Each Google-managed SSL certificate supports up to the
+	// maximum number of domains per Google-managed SSL certificate.
+	Domains []string `json:"domains"`
+}
+
+// SelfManagedSSLCertificate is the configuration of a self-managed SSL
+// certificate. Both the certificate and its private key are read from
+// Kubernetes secrets, e.g. the tls.crt and tls.key keys of a kubernetes.io/tls
+// secret.
+type SelfManagedSSLCertificate struct {
+	// CertificateSecretRef references the key of a secret that contains a
+	// local certificate file. The certificate must be in PEM format. The
+	// certificate chain must be no greater than 5 certs long.
+	CertificateSecretRef xpv1.SecretKeySelector `json:"certificateSecretRef"`
+
+	// PrivateKeySecretRef references the key of a secret that contains
+	// the write-only private key in PEM format.
+	PrivateKeySecretRef xpv1.SecretKeySelector `json:"privateKeySecretRef"`
+}
+
+// An SSLCertificateObservation reflects the observed state of an
+// SSLCertificate on GCP.
+type SSLCertificateObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// DomainStatus: Detailed statuses of the domains specified for a
+	// managed certificate resource.
+	DomainStatus map[string]string `json:"domainStatus,omitempty"`
+
+	// ExpireTime: Expire time of the certificate in RFC3339 text format.
+	ExpireTime string `json:"expireTime,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Status of the managed certificate resource.
+	//
+	// Possible values:
+	//   "ACTIVE"
+	//   "PROVISIONING"
+	//   "PROVISIONING_FAILED"
+	//   "PROVISIONING_FAILED_PERMANENTLY"
+	//   "RENEWAL_FAILED"
+	Status string `json:"status,omitempty"`
+
+	// SubjectAlternativeNames: Domains associated with the certificate
+	// via Subject Alternative Name.
+	SubjectAlternativeNames []string `json:"subjectAlternativeNames,omitempty"`
+}
+
+// An SSLCertificateSpec defines the desired state of an SSLCertificate.
+type SSLCertificateSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SSLCertificateParameters `json:"forProvider"`
+}
+
+// An SSLCertificateStatus represents the observed state of an SSLCertificate.
+type SSLCertificateStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SSLCertificateObservation `json:"atProvider,omitempty"`
+}
+
+// An SSLCertificate is a managed resource that represents a Google Compute
+// Engine global SSL certificate, either Google-managed or self-managed.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SSLCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SSLCertificateSpec   `json:"spec"`
+	Status SSLCertificateStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SSLCertificateList contains a list of SSLCertificate.
+type SSLCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SSLCertificate `json:"items"`
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+// SubnetworkIAMMemberParameters define the desired state of a single member
+// of a role binding in the IAM policy of a Subnetwork. Other members and
+// bindings of the policy are left untouched, so several
+// SubnetworkIAMMembers may grant roles on the same Subnetwork.
+// https://cloud.google.com/compute/docs/reference/rest/v1/subnetworks/setIamPolicy
+type SubnetworkIAMMemberParameters struct {
+	// Subnetwork: The partially qualified URL of the Subnetwork, for
+	// example projects/example/regions/us-central1/subnetworks/eg.
+	// +optional
+	// +immutable
+	Subnetwork *string `json:"subnetwork,omitempty"`
+
+	// SubnetworkRef references a Subnetwork in order to set Subnetwork.
+	// +optional
+	SubnetworkRef *xpv1.Reference `json:"subnetworkRef,omitempty"`
+
+	// SubnetworkSelector selects a reference to a Subnetwork in order to
+	// set Subnetwork.
+	// +optional
+	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
+
+	// Role: Role that is assigned to the member, for example
+	// roles/compute.networkUser.
+	// +immutable
+	Role string `json:"role"`
+
+	// Member: The identity the role is granted to, for example
+	// user:alice@example.com or serviceAccount:sa@example.iam.gserviceaccount.com.
+	// +optional
+	// +immutable
+	Member *string `json:"member,omitempty"`
+
+	// ServiceAccountMemberRef references a ServiceAccount in order to set
+	// Member.
+	// +optional
+	ServiceAccountMemberRef *xpv1.Reference `json:"serviceAccountMemberRef,omitempty"`
+
+	// ServiceAccountMemberSelector selects a reference to a ServiceAccount
+	// in order to set Member.
+	// +optional
+	ServiceAccountMemberSelector *xpv1.Selector `json:"serviceAccountMemberSelector,omitempty"`
+
+	// Condition: The condition that is associated with the binding.
+	// +optional
+	// +immutable
+	Condition *iamv1alpha1.Expr `json:"condition,omitempty"`
+}
+
+// A SubnetworkIAMMemberSpec defines the desired state of a
+// SubnetworkIAMMember.
+type SubnetworkIAMMemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SubnetworkIAMMemberParameters `json:"forProvider"`
+}
+
+// A SubnetworkIAMMemberStatus represents the observed state of a
+// SubnetworkIAMMember.
+type SubnetworkIAMMemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// A SubnetworkIAMMember is a managed resource that grants a role on a
+// Subnetwork to a single member.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="MEMBER",type="string",JSONPath=".spec.forProvider.member"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type SubnetworkIAMMember struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubnetworkIAMMemberSpec   `json:"spec"`
+	Status SubnetworkIAMMemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubnetworkIAMMemberList contains a list of SubnetworkIAMMember.
+type SubnetworkIAMMemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SubnetworkIAMMember `json:"items"`
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// TargetHTTPSProxyParameters define the desired state of a Google Compute
+// Engine global target HTTPS proxy. Most fields map directly to a
+// TargetHttpsProxy:
+// https://cloud.google.com/compute/docs/reference/rest/v1/targetHttpsProxies
+type TargetHTTPSProxyParameters struct {
+	// Description: An optional description of this resource.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// QuicOverride: Specifies the QUIC override policy for this
+	// TargetHttpsProxy resource.
+	//
+	// Possible values:
+	//   "DISABLE"
+	//   "ENABLE"
+	//   "NONE"
+	// +optional
+	// +kubebuilder:validation:Enum=DISABLE;ENABLE;NONE
+	QuicOverride *string `json:"quicOverride,omitempty"`
+
+	// SSLCertificates: URLs to SslCertificate resources that are used to
+	// authenticate connections between users and the load balancer. At
+	// least one SSL certificate must be specified.
+	// +optional
+	SSLCertificates []string `json:"sslCertificates,omitempty"`
+
+	// SSLCertificateRefs references SSLCertificates to retrieve their URLs.
+	// +optional
+	SSLCertificateRefs []xpv1.Reference `json:"sslCertificateRefs,omitempty"`
+
+	// SSLCertificateSelector selects references to SSLCertificates.
+	// +optional
+	SSLCertificateSelector *xpv1.Selector `json:"sslCertificateSelector,omitempty"`
+
+	// SSLPolicy: URL of SslPolicy resource that will be associated with
+	// the TargetHttpsProxy resource. If not set, the TargetHttpsProxy
+	// resource has no SSL policy configured.
+	// +optional
+	SSLPolicy *string `json:"sslPolicy,omitempty"`
+
+	// URLMap: A fully-qualified or valid partial URL to the UrlMap
+	// resource that defines the mapping from URL to the BackendService.
+	// +optional
+	URLMap *string `json:"urlMap,omitempty"`
+
+	// URLMapRef references a URLMap to retrieve its URL.
+	// +optional
+	URLMapRef *xpv1.Reference `json:"urlMapRef,omitempty"`
+
+	// URLMapSelector selects a reference to a URLMap.
+	// +optional
+	URLMapSelector *xpv1.Selector `json:"urlMapSelector,omitempty"`
+}
+
+// A TargetHTTPSProxyObservation reflects the observed state of a
+// TargetHTTPSProxy on GCP.
+type TargetHTTPSProxyObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A TargetHTTPSProxySpec defines the desired state of a TargetHTTPSProxy.
+type TargetHTTPSProxySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetHTTPSProxyParameters `json:"forProvider"`
+}
+
+// A TargetHTTPSProxyStatus represents the observed state of a
+// TargetHTTPSProxy.
+type TargetHTTPSProxyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetHTTPSProxyObservation `json:"atProvider,omitempty"`
+}
+
+// A TargetHTTPSProxy is a managed resource that represents a Google Compute
+// Engine global target HTTPS proxy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type TargetHTTPSProxy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetHTTPSProxySpec   `json:"spec"`
+	Status TargetHTTPSProxyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetHTTPSProxyList contains a list of TargetHTTPSProxy.
+type TargetHTTPSProxyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetHTTPSProxy `json:"items"`
+}
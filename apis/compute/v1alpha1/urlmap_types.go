@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// URLMapParameters define the desired state of a Google Compute Engine global
+// URL map. Most fields map directly to a UrlMap:
+// https://cloud.google.com/compute/docs/reference/rest/v1/urlMaps
+type URLMapParameters struct {
+	// DefaultService: The full or partial URL of the BackendService to
+	// which traffic is directed if none of the hostRules match. Only one
+	// of defaultService and defaultUrlRedirect may be set.
+	// +optional
+	DefaultService *string `json:"defaultService,omitempty"`
+
+	// DefaultServiceRef references a BackendService to retrieve its URL.
+	// +optional
+	DefaultServiceRef *xpv1.Reference `json:"defaultServiceRef,omitempty"`
+
+	// DefaultServiceSelector selects a reference to a BackendService.
+	// +optional
+	DefaultServiceSelector *xpv1.Selector `json:"defaultServiceSelector,omitempty"`
+
+	// DefaultURLRedirect: When none of the specified hostRules match, the
+	// request is redirected to a URL specified by defaultUrlRedirect.
+	// +optional
+	DefaultURLRedirect *HTTPRedirectAction `json:"defaultUrlRedirect,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// HostRules: The list of HostRules to use against the URL.
+	// +optional
+	HostRules []HostRule `json:"hostRules,omitempty"`
+
+	// PathMatchers: The list of named PathMatchers to use against the URL.
+	// +optional
+	PathMatchers []PathMatcher `json:"pathMatchers,omitempty"`
+}
+
+// A HostRule matches incoming hosts to a PathMatcher.
+type HostRule struct {
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Hosts: The list of host patterns to match. They must be valid
+	// hostnames with optional port numbers in the format host:port.
+	Hosts []string `json:"hosts"`
+
+	// PathMatcher: The name of the PathMatcher to use to match the path
+	// portion of the URL if the hostRule matches the URL's host portion.
+	PathMatcher string `json:"pathMatcher"`
+}
+
+// A PathMatcher matches the path portion of the URL to a BackendService.
+type PathMatcher struct {
+	// Name: The name to which this PathMatcher is referred by the
+	// HostRule.
+	Name string `json:"name"`
+
+	// DefaultService: The full or partial URL to the BackendService
+	// resource used if none of the pathRules defined by this PathMatcher
+	// are matched.
+	// +optional
+	DefaultService *string `json:"defaultService,omitempty"`
+
+	// DefaultServiceRef references a BackendService to retrieve its URL.
+	// +optional
+	DefaultServiceRef *xpv1.Reference `json:"defaultServiceRef,omitempty"`
+
+	// DefaultServiceSelector selects a reference to a BackendService.
+	// +optional
+	DefaultServiceSelector *xpv1.Selector `json:"defaultServiceSelector,omitempty"`
+
+	// Description: An optional description of this resource.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// PathRules: The list of path rules. The order by which path rules
+	// are specified does not matter. Matches are always done on the
+	// longest-path-first basis.
+	// +optional
+	PathRules []PathRule `json:"pathRules,omitempty"`
+}
+
+// A PathRule maps a set of paths to a BackendService.
+type PathRule struct {
+	// Paths: The list of path patterns to match. Each must start with /
+	// and the only place a * is allowed is at the end following a /.
+	Paths []string `json:"paths"`
+
+	// Service: The full or partial URL of the BackendService resource if
+	// this rule is matched.
+	// +optional
+	Service *string `json:"service,omitempty"`
+
+	// ServiceRef references a BackendService to retrieve its URL.
+	// +optional
+	ServiceRef *xpv1.Reference `json:"serviceRef,omitempty"`
+
+	// ServiceSelector selects a reference to a BackendService.
+	// +optional
+	ServiceSelector *xpv1.Selector `json:"serviceSelector,omitempty"`
+}
+
+// HTTPRedirectAction specifies how a request is redirected.
+type HTTPRedirectAction struct {
+	// HostRedirect: The host that will be used in the redirect response
+	// instead of the one that was supplied in the request.
+	// +optional
+	HostRedirect *string `json:"hostRedirect,omitempty"`
+
+	// HTTPSRedirect: If set to true, the URL scheme in the redirected
+	// request is set to https.
+	// +optional
+	HTTPSRedirect *bool `json:"httpsRedirect,omitempty"`
+
+	// PathRedirect: The path that will be used in the redirect response
+	// instead of the one that was supplied in the request.
+	// +optional
+	PathRedirect *string `json:"pathRedirect,omitempty"`
+
+	// RedirectResponseCode: The HTTP Status code to use for this
+	// RedirectAction.
+	//
+	// Possible values:
+	//   "FOUND"
+	//   "MOVED_PERMANENTLY_DEFAULT"
+	//   "PERMANENT_REDIRECT"
+	//   "SEE_OTHER"
+	//   "TEMPORARY_REDIRECT"
+	// +optional
+	// +kubebuilder:validation:Enum=FOUND;MOVED_PERMANENTLY_DEFAULT;PERMANENT_REDIRECT;SEE_OTHER;TEMPORARY_REDIRECT
+	RedirectResponseCode *string `json:"redirectResponseCode,omitempty"`
+
+	// StripQuery: If set to true, any accompanying query portion of the
+	// original URL is removed prior to redirecting the request.
+	StripQuery bool `json:"stripQuery"`
+}
+
+// A URLMapObservation reflects the observed state of a URLMap on GCP.
+type URLMapObservation struct {
+	// CreationTimestamp in RFC3339 text format.
+	CreationTimestamp string `json:"creationTimestamp,omitempty"`
+
+	// Fingerprint of this resource. A hash of the contents stored in this
+	// object. This field is used in optimistic locking.
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// ID for the resource. This identifier is defined by the server.
+	ID uint64 `json:"id,omitempty"`
+
+	// SelfLink: Server-defined URL for the resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A URLMapSpec defines the desired state of a URLMap.
+type URLMapSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       URLMapParameters `json:"forProvider"`
+}
+
+// A URLMapStatus represents the observed state of a URLMap.
+type URLMapStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          URLMapObservation `json:"atProvider,omitempty"`
+}
+
+// A URLMap is a managed resource that represents a Google Compute Engine
+// global URL map.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type URLMap struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   URLMapSpec   `json:"spec"`
+	Status URLMapStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// URLMapList contains a list of URLMap.
+type URLMapList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []URLMap `json:"items"`
+}
@@ -69,7 +69,7 @@ type NetworkObservation struct {
 	ID uint64 `json:"id,omitempty"`
 
 	// Peerings: A list of network peerings for the resource.
-	Peerings []*NetworkPeering `json:"peerings,omitempty"`
+	Peerings []*NetworkPeeringObservation `json:"peerings,omitempty"`
 
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
@@ -79,9 +79,9 @@ type NetworkObservation struct {
 	Subnetworks []string `json:"subnetworks,omitempty"`
 }
 
-// A NetworkPeering represents the observed state of a Google Compute Engine
+// A NetworkPeeringObservation represents the observed state of a Google Compute Engine
 // VPC Network Peering.
-type NetworkPeering struct {
+type NetworkPeeringObservation struct {
 	// AutoCreateRoutes: This field will be deprecated soon. Use the
 	// exchange_subnet_routes field instead. Indicates whether full mesh
 	// connectivity is created and managed automatically between peered
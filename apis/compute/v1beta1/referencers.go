@@ -90,3 +90,14 @@ func (mg *Subnetwork) ResolveReferences(ctx context.Context, c client.Reader) er
 
 	return nil
 }
+
+// GlobalAddressURL extracts the partially qualified URL of a GlobalAddress.
+func GlobalAddressURL() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		ga, ok := mg.(*GlobalAddress)
+		if !ok {
+			return ""
+		}
+		return strings.TrimPrefix(ga.Status.AtProvider.SelfLink, ComputeURIPrefix)
+	}
+}
@@ -277,11 +277,11 @@ func (in *NetworkObservation) DeepCopyInto(out *NetworkObservation) {
 	*out = *in
 	if in.Peerings != nil {
 		in, out := &in.Peerings, &out.Peerings
-		*out = make([]*NetworkPeering, len(*in))
+		*out = make([]*NetworkPeeringObservation, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(NetworkPeering)
+				*out = new(NetworkPeeringObservation)
 				**out = **in
 			}
 		}
@@ -334,16 +334,16 @@ func (in *NetworkParameters) DeepCopy() *NetworkParameters {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NetworkPeering) DeepCopyInto(out *NetworkPeering) {
+func (in *NetworkPeeringObservation) DeepCopyInto(out *NetworkPeeringObservation) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeering.
-func (in *NetworkPeering) DeepCopy() *NetworkPeering {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPeeringObservation.
+func (in *NetworkPeeringObservation) DeepCopy() *NetworkPeeringObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(NetworkPeering)
+	out := new(NetworkPeeringObservation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -18,6 +18,10 @@ limitations under the License.
 package v1beta1
 
 import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -36,6 +40,61 @@ const (
 	NodePoolStateError        = "ERROR"
 )
 
+// Blue-green upgrade phases.
+const (
+	BlueGreenPhaseUnspecified     = "PHASE_UNSPECIFIED"
+	BlueGreenPhaseUpdateStarted   = "UPDATE_STARTED"
+	BlueGreenPhaseCreatingGreen   = "CREATING_GREEN_POOL"
+	BlueGreenPhaseCordoningBlue   = "CORDONING_BLUE_POOL"
+	BlueGreenPhaseDrainingBlue    = "DRAINING_BLUE_POOL"
+	BlueGreenPhaseNodePoolSoaking = "NODE_POOL_SOAKING"
+	BlueGreenPhaseDeletingBlue    = "DELETING_BLUE_POOL"
+	BlueGreenPhaseRollbackStarted = "ROLLBACK_STARTED"
+)
+
+// Actions on an ongoing node pool upgrade.
+const (
+	// UpgradeActionComplete completes an ongoing blue-green upgrade,
+	// skipping the remaining soak time.
+	UpgradeActionComplete = "Complete"
+
+	// UpgradeActionRollback rolls back an ongoing blue-green upgrade.
+	UpgradeActionRollback = "Rollback"
+)
+
+// Conditions of a NodePool.
+const (
+	// TypeReplacementRequired indicates whether the NodePool has changes that
+	// can only be applied by replacing the node pool.
+	TypeReplacementRequired xpv1.ConditionType = "ReplacementRequired"
+
+	ReasonImmutableFieldChanged   xpv1.ConditionReason = "ImmutableFieldChanged"
+	ReasonNoImmutableFieldChanged xpv1.ConditionReason = "NoImmutableFieldChanged"
+)
+
+// ReplacementRequired returns a condition that indicates the supplied fields
+// differ from the node pool and can only be changed by replacing it.
+func ReplacementRequired(fields ...string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReplacementRequired,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonImmutableFieldChanged,
+		Message:            fmt.Sprintf("changes to %s require replacing the node pool", strings.Join(fields, ", ")),
+	}
+}
+
+// NoReplacementRequired returns a condition that indicates all changes to the
+// NodePool can be applied in place.
+func NoReplacementRequired() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReplacementRequired,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoImmutableFieldChanged,
+	}
+}
+
 // NodePoolObservation is used to show the observed state of the GKE Node Pool
 // resource on GCP.
 type NodePoolObservation struct {
@@ -90,6 +149,44 @@ type NodePoolObservation struct {
 	// status of this
 	// node pool instance, if available.
 	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// UpdateInfo: Information about the ongoing upgrade of the node pool.
+	UpdateInfo *UpdateInfo `json:"updateInfo,omitempty"`
+
+	// UpgradeActionTarget: The blue instance groups of the blue-green
+	// upgrade that UpgradeAction applies to. It is recorded when an upgrade
+	// is first observed while UpgradeAction is set, and cleared when
+	// UpgradeAction is unset.
+	UpgradeActionTarget []string `json:"upgradeActionTarget,omitempty"`
+}
+
+// UpdateInfo is information about an ongoing upgrade of a node pool.
+type UpdateInfo struct {
+	// BlueGreenInfo: Information about an ongoing blue-green upgrade.
+	BlueGreenInfo *BlueGreenInfo `json:"blueGreenInfo,omitempty"`
+}
+
+// BlueGreenInfo is information about an ongoing blue-green upgrade.
+type BlueGreenInfo struct {
+	// Phase: The current phase of the upgrade; one of UPDATE_STARTED,
+	// CREATING_GREEN_POOL, CORDONING_BLUE_POOL, DRAINING_BLUE_POOL,
+	// NODE_POOL_SOAKING, DELETING_BLUE_POOL or ROLLBACK_STARTED.
+	Phase string `json:"phase,omitempty"`
+
+	// GreenPoolVersion: The version of the green pool.
+	GreenPoolVersion string `json:"greenPoolVersion,omitempty"`
+
+	// BluePoolDeletionStartTime: The time the deletion of the blue pool
+	// starts to complete the upgrade, in RFC3339 text format.
+	BluePoolDeletionStartTime string `json:"bluePoolDeletionStartTime,omitempty"`
+
+	// BlueInstanceGroupUrls: The resource URLs of the managed instance
+	// groups associated with the blue pool.
+	BlueInstanceGroupUrls []string `json:"blueInstanceGroupUrls,omitempty"`
+
+	// GreenInstanceGroupUrls: The resource URLs of the managed instance
+	// groups associated with the green pool.
+	GreenInstanceGroupUrls []string `json:"greenInstanceGroupUrls,omitempty"`
 }
 
 // NodePoolParameters define the desired state of a Google Kubernetes Engine
@@ -169,6 +266,22 @@ type NodePoolParameters struct {
 	// +immutable
 	MaxPodsConstraint *v1beta2.MaxPodsConstraint `json:"maxPodsConstraint,omitempty"`
 
+	// TrackMasterVersion: Upgrade the node pool to the version of the
+	// control plane of its cluster once the control plane is done
+	// upgrading. Version is ignored when it is true.
+	// +optional
+	TrackMasterVersion *bool `json:"trackMasterVersion,omitempty"`
+
+	// UpgradeAction: An action on an ongoing blue-green upgrade of the node
+	// pool. Complete completes it, skipping the remaining soak time.
+	// Rollback rolls it back; the version of the node pool is not
+	// reconciled while it is set, so revert the version before unsetting
+	// it. The action only applies to the first upgrade that is observed
+	// while it is set; unset it and set it again to act on a later upgrade.
+	// +kubebuilder:validation:Enum=Complete;Rollback
+	// +optional
+	UpgradeAction *string `json:"upgradeAction,omitempty"`
+
 	// UpgradeSettings: Upgrade settings control disruption and speed of the
 	// upgrade.
 	UpgradeSettings *v1beta2.UpgradeSettings `json:"upgradeSettings,omitempty"`
@@ -248,7 +361,6 @@ type NodeConfig struct {
 	ImageType *string `json:"imageType,omitempty"`
 
 	// KubeletConfig: Node kubelet configs.
-	// +immutable
 	// +optional
 	KubeletConfig *NodeKubeletConfig `json:"kubeletConfig,omitempty"`
 
@@ -265,7 +377,6 @@ type NodeConfig struct {
 	// see:
 	// https://kubernetes.io/docs/concepts/overview/working-with-objects
 	// /labels/
-	// +immutable
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 
@@ -425,7 +536,6 @@ type NodeConfig struct {
 	// the client during cluster or node pool creation. Each tag within the
 	// list
 	// must comply with RFC1035.
-	// +immutable
 	// +optional
 	Tags []string `json:"tags,omitempty"`
 
@@ -435,7 +545,6 @@ type NodeConfig struct {
 	// see:
 	// https://kubernetes.io/docs/concepts/configuration/taint-and-toler
 	// ation/
-	// +immutable
 	// +optional
 	Taints []*NodeTaint `json:"taints,omitempty"`
 
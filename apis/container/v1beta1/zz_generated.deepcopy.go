@@ -56,6 +56,31 @@ func (in *AutoUpgradeOptions) DeepCopy() *AutoUpgradeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenInfo) DeepCopyInto(out *BlueGreenInfo) {
+	*out = *in
+	if in.BlueInstanceGroupUrls != nil {
+		in, out := &in.BlueInstanceGroupUrls, &out.BlueInstanceGroupUrls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GreenInstanceGroupUrls != nil {
+		in, out := &in.GreenInstanceGroupUrls, &out.GreenInstanceGroupUrls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenInfo.
+func (in *BlueGreenInfo) DeepCopy() *BlueGreenInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LinuxNodeConfig) DeepCopyInto(out *LinuxNodeConfig) {
 	*out = *in
@@ -412,6 +437,16 @@ func (in *NodePoolObservation) DeepCopyInto(out *NodePoolObservation) {
 		*out = new(NodeManagementStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.UpdateInfo != nil {
+		in, out := &in.UpdateInfo, &out.UpdateInfo
+		*out = new(UpdateInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeActionTarget != nil {
+		in, out := &in.UpgradeActionTarget, &out.UpgradeActionTarget
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePoolObservation.
@@ -467,6 +502,16 @@ func (in *NodePoolParameters) DeepCopyInto(out *NodePoolParameters) {
 		*out = new(v1beta2.MaxPodsConstraint)
 		**out = **in
 	}
+	if in.TrackMasterVersion != nil {
+		in, out := &in.TrackMasterVersion, &out.TrackMasterVersion
+		*out = new(bool)
+		**out = **in
+	}
+	if in.UpgradeAction != nil {
+		in, out := &in.UpgradeAction, &out.UpgradeAction
+		*out = new(string)
+		**out = **in
+	}
 	if in.UpgradeSettings != nil {
 		in, out := &in.UpgradeSettings, &out.UpgradeSettings
 		*out = new(v1beta2.UpgradeSettings)
@@ -608,6 +653,26 @@ func (in *ShieldedInstanceConfig) DeepCopy() *ShieldedInstanceConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateInfo) DeepCopyInto(out *UpdateInfo) {
+	*out = *in
+	if in.BlueGreenInfo != nil {
+		in, out := &in.BlueGreenInfo, &out.BlueGreenInfo
+		*out = new(BlueGreenInfo)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateInfo.
+func (in *UpdateInfo) DeepCopy() *UpdateInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkloadMetadataConfig) DeepCopyInto(out *WorkloadMetadataConfig) {
 	*out = *in
@@ -18,6 +18,7 @@ limitations under the License.
 package v1beta2
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -34,9 +35,130 @@ const (
 	ClusterStateDegraded     = "DEGRADED"
 )
 
+// Cluster operation states.
+const (
+	OperationStatePending  = "PENDING"
+	OperationStateRunning  = "RUNNING"
+	OperationStateDone     = "DONE"
+	OperationStateAborting = "ABORTING"
+)
+
+// Bootstrap node pool policies.
+const (
+	// BootstrapNodePoolPolicyDelete deletes the bootstrap node pool once the
+	// cluster is created. Node pools are managed using NodePool resources.
+	BootstrapNodePoolPolicyDelete = "Delete"
+
+	// BootstrapNodePoolPolicyKeep keeps the bootstrap node pool once the
+	// cluster is created so that it can be managed by a NodePool resource
+	// whose external name is the name of the bootstrap node pool.
+	BootstrapNodePoolPolicyKeep = "Keep"
+
+	// BootstrapNodePoolPolicyInline creates the cluster with the initial
+	// node count and autoscaling of the bootstrap node pool and keeps it as
+	// part of the cluster.
+	BootstrapNodePoolPolicyInline = "Inline"
+)
+
+// Control plane upgrade states.
+const (
+	// MasterUpgradeStatePending means the control plane does not run the
+	// desired version and an upgrade is queued.
+	MasterUpgradeStatePending = "Pending"
+
+	// MasterUpgradeStateInProgress means the control plane is being
+	// upgraded to the desired version.
+	MasterUpgradeStateInProgress = "InProgress"
+
+	// MasterUpgradeStateComplete means the control plane runs the desired
+	// version.
+	MasterUpgradeStateComplete = "Complete"
+
+	// MasterUpgradeStateRefused means the control plane will not be
+	// upgraded to the desired version because it would violate the version
+	// skew policy.
+	MasterUpgradeStateRefused = "Refused"
+)
+
+// Conditions of a Cluster.
+const (
+	// TypeMasterUpgradeRefused indicates whether the upgrade of the control
+	// plane to the desired master version is refused.
+	TypeMasterUpgradeRefused xpv1.ConditionType = "MasterUpgradeRefused"
+
+	ReasonVersionSkewViolated   xpv1.ConditionReason = "VersionSkewViolated"
+	ReasonNoVersionSkewViolated xpv1.ConditionReason = "NoVersionSkewViolated"
+)
+
+// MasterUpgradeRefused returns a condition that indicates the control plane
+// is not upgraded to the desired master version for the supplied reason.
+func MasterUpgradeRefused(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMasterUpgradeRefused,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonVersionSkewViolated,
+		Message:            msg,
+	}
+}
+
+// NoMasterUpgradeRefused returns a condition that indicates the control plane
+// runs or may be upgraded to the desired master version.
+func NoMasterUpgradeRefused() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMasterUpgradeRefused,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoVersionSkewViolated,
+	}
+}
+
+// Node pool upgrade strategies.
+const (
+	UpgradeStrategySurge     = "SURGE"
+	UpgradeStrategyBlueGreen = "BLUE_GREEN"
+)
+
 // Defaults for GKE resources.
 const (
 	DefaultNumberOfNodes = int64(1)
+
+	// DefaultMaxNodeMinorVersionSkew is the number of minor versions that
+	// nodes are allowed to be behind the control plane, per the Kubernetes
+	// version skew policy.
+	DefaultMaxNodeMinorVersionSkew = int64(2)
+)
+
+// Keys of a Cluster's connection secret in addition to the common ones.
+const (
+	// ConnectionSecretExecKubeconfigKey is the key of a kubeconfig that
+	// authenticates using gke-gcloud-auth-plugin rather than an access
+	// token.
+	ConnectionSecretExecKubeconfigKey = "execKubeconfig"
+
+	// ConnectionSecretTokenExpiryKey is the key of the RFC 3339 expiry time
+	// of the access token in the connection secret.
+	ConnectionSecretTokenExpiryKey = "tokenExpiry"
+
+	// ConnectionSecretPrivateEndpointKey is the key of the private endpoint
+	// of the cluster when both endpoints are published.
+	ConnectionSecretPrivateEndpointKey = "privateEndpoint"
+
+	// ConnectionSecretPrivateKubeconfigKey is the key of a kubeconfig that
+	// uses the private endpoint when both endpoints are published.
+	ConnectionSecretPrivateKubeconfigKey = "privateKubeconfig"
+
+	// ConnectionSecretPrivateExecKubeconfigKey is the key of an exec
+	// kubeconfig that uses the private endpoint when both endpoints are
+	// published.
+	ConnectionSecretPrivateExecKubeconfigKey = "privateExecKubeconfig"
+)
+
+// Endpoints of a Cluster that may be used in its connection secret.
+const (
+	ConnectionEndpointPublic  = "Public"
+	ConnectionEndpointPrivate = "Private"
+	ConnectionEndpointBoth    = "Both"
 )
 
 // ClusterParameters define the desired state of a Google Kubernetes Engine
@@ -83,6 +205,13 @@ type ClusterParameters struct {
 	// +optional
 	BinaryAuthorization *BinaryAuthorization `json:"binaryAuthorization,omitempty"`
 
+	// BootstrapNodePool: Configuration of the node pool the cluster is
+	// created with, and what happens to it once the cluster is created.
+	// It is ignored when Autopilot is enabled.
+	// +optional
+	// +immutable
+	BootstrapNodePool *BootstrapNodePool `json:"bootstrapNodePool,omitempty"`
+
 	// ClusterIpv4Cidr: The IP address range of the container pods in this
 	// cluster,
 	// in
@@ -99,6 +228,16 @@ type ClusterParameters struct {
 	// +immutable
 	ConfidentialNodes *ConfidentialNodes `json:"confidentialNodes,omitempty"`
 
+	// ConnectionEndpoint: The endpoint of the cluster that is used by the
+	// kubeconfigs in the connection secret. Public uses the cluster's
+	// endpoint, Private uses the internal IP address of the master of a
+	// private cluster, and Both additionally publishes kubeconfigs for the
+	// private endpoint under separate keys. Clusters without a private
+	// endpoint always use the public one. Defaults to Public.
+	// +kubebuilder:validation:Enum=Public;Private;Both
+	// +optional
+	ConnectionEndpoint *string `json:"connectionEndpoint,omitempty"`
+
 	// DatabaseEncryption: Configuration of etcd encryption.
 	// +optional
 	DatabaseEncryption *DatabaseEncryption `json:"databaseEncryption,omitempty"`
@@ -220,6 +359,19 @@ type ClusterParameters struct {
 	// +optional
 	MasterAuthorizedNetworksConfig *MasterAuthorizedNetworksConfig `json:"masterAuthorizedNetworksConfig,omitempty"`
 
+	// MasterVersion: The desired Kubernetes version of the control plane,
+	// e.g. "1.20" or "1.20.8-gke.900", or one of the aliases "latest" and
+	// "-" for the default version. A version prefix is matched by any
+	// version that starts with it. The control plane is upgraded using
+	// clusters.updateMaster when it does not run a matching version, one
+	// minor version at a time, and only if the upgrade does not violate the
+	// version skew policy. An alias is passed to GKE as is, once for each
+	// time it is set. A refused upgrade is reported in
+	// status.atProvider.masterUpgrade and by the MasterUpgradeRefused
+	// condition.
+	// +optional
+	MasterVersion *string `json:"masterVersion,omitempty"`
+
 	// MonitoringService: The monitoring service the cluster should use to
 	// write metrics.
 	// Currently available options:
@@ -307,6 +459,11 @@ type ClusterParameters struct {
 	// +immutable
 	SubnetworkSelector *xpv1.Selector `json:"subnetworkSelector,omitempty"`
 
+	// VersionSkewPolicy: The version skew between the control plane and
+	// the nodes that upgrades of the control plane must respect.
+	// +optional
+	VersionSkewPolicy *VersionSkewPolicy `json:"versionSkewPolicy,omitempty"`
+
 	// VerticalPodAutoscaling: Cluster-level Vertical Pod Autoscaling
 	// configuration.
 	// +optional
@@ -402,6 +559,19 @@ type ClusterObservation struct {
 	// specified.
 	NodePools []*NodePoolClusterStatus `json:"nodePools,omitempty"`
 
+	// Operation: The most recent update operation that was started on the
+	// cluster. Updates are applied one operation at a time; the next
+	// pending update is started once this operation is done.
+	Operation *ClusterOperation `json:"operation,omitempty"`
+
+	// PendingUpdates: The fields that differ from the desired state, in the
+	// order they will be updated.
+	PendingUpdates []string `json:"pendingUpdates,omitempty"`
+
+	// MasterUpgrade: The progress of the upgrade of the control plane to
+	// the desired master version.
+	MasterUpgrade *MasterUpgradeStatus `json:"masterUpgrade,omitempty"`
+
 	// SelfLink: Server-defined URL for the resource.
 	SelfLink string `json:"selfLink,omitempty"`
 
@@ -462,6 +632,51 @@ type ClusterObservation struct {
 	Zone string `json:"zone,omitempty"`
 }
 
+// MasterUpgradeStatus reports the progress of an upgrade of the control
+// plane.
+type MasterUpgradeStatus struct {
+	// DesiredVersion: The desired version of the control plane.
+	DesiredVersion string `json:"desiredVersion"`
+
+	// State: The state of the upgrade; one of Pending, InProgress, Complete
+	// or Refused.
+	State string `json:"state"`
+
+	// Message: Why the upgrade was refused, if it was.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterOperation is an operation that updates a cluster.
+type ClusterOperation struct {
+	// Name: The server-assigned ID for the operation.
+	Name string `json:"name"`
+
+	// OperationType: The operation type, e.g. UPDATE_CLUSTER.
+	OperationType string `json:"operationType,omitempty"`
+
+	// Update: The pending update that the operation applies.
+	Update string `json:"update,omitempty"`
+
+	// Status: The current status of the operation.
+	//
+	// Possible values:
+	//   "PENDING" - Not started.
+	//   "RUNNING" - The operation is in progress.
+	//   "DONE" - The operation is done, either cancelled or completed.
+	//   "ABORTING" - The operation is aborting.
+	Status string `json:"status,omitempty"`
+
+	// StatusMessage: Detailed status information of the operation, e.g.
+	// the error of a failed operation.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// StartTime: The time the operation started, in RFC3339 text format.
+	StartTime string `json:"startTime,omitempty"`
+
+	// EndTime: The time the operation completed, in RFC3339 text format.
+	EndTime string `json:"endTime,omitempty"`
+}
+
 // AddonsConfig is configuration for the addons that can be automatically
 // spun up in the
 // cluster, enabling additional functionality.
@@ -642,6 +857,13 @@ type ClusterAutoscaling struct {
 	// created by NAP.
 	AutoprovisioningNodePoolDefaults *AutoprovisioningNodePoolDefaults `json:"autoprovisioningNodePoolDefaults,omitempty"`
 
+	// AutoscalingProfile: Defines autoscaling behaviour. OPTIMIZE_UTILIZATION
+	// prioritizes optimizing utilization of resources, BALANCED is the
+	// default autoscaling configuration.
+	// +kubebuilder:validation:Enum=OPTIMIZE_UTILIZATION;BALANCED
+	// +optional
+	AutoscalingProfile *string `json:"autoscalingProfile,omitempty"`
+
 	// EnableNodeAutoprovisioning: Enables automatic node pool creation and
 	// deletion.
 	// +optional
@@ -679,6 +901,10 @@ type AutoprovisioningNodePoolDefaults struct {
 	// +optional
 	DiskType *string `json:"diskType,omitempty"`
 
+	// ImageType: The image type to use for NAP created node pools.
+	// +optional
+	ImageType *string `json:"imageType,omitempty"`
+
 	// Management: Specifies the node management options for NAP created
 	// node-pools.
 	Management *NodeManagement `json:"management,omitempty"`
@@ -793,6 +1019,51 @@ type UpgradeSettings struct {
 	// considered available if its status is Ready.
 	// +optional
 	MaxUnavailable *int64 `json:"maxUnavailable,omitempty"`
+
+	// Strategy: Update strategy of the node pool. SURGE upgrades nodes in
+	// place with the parallelism given by maxSurge and maxUnavailable.
+	// BLUE_GREEN creates a new set of nodes, drains the old ones in batches
+	// and keeps them until the soak duration passes so that the upgrade
+	// can be rolled back.
+	// +kubebuilder:validation:Enum=SURGE;BLUE_GREEN
+	// +optional
+	Strategy *string `json:"strategy,omitempty"`
+
+	// BlueGreenSettings: Settings for the blue-green upgrade strategy.
+	// +optional
+	BlueGreenSettings *BlueGreenSettings `json:"blueGreenSettings,omitempty"`
+}
+
+// BlueGreenSettings are the settings of a blue-green upgrade.
+type BlueGreenSettings struct {
+	// NodePoolSoakDuration: Time to wait after draining the entire blue
+	// pool before it is deleted, e.g. "3600s".
+	// +optional
+	NodePoolSoakDuration *string `json:"nodePoolSoakDuration,omitempty"`
+
+	// StandardRolloutPolicy: The policy used to drain the blue pool.
+	// +optional
+	StandardRolloutPolicy *StandardRolloutPolicy `json:"standardRolloutPolicy,omitempty"`
+}
+
+// StandardRolloutPolicy drains the blue pool of a blue-green upgrade in
+// batches. Only one of batchNodeCount and batchPercentage may be set.
+type StandardRolloutPolicy struct {
+	// BatchNodeCount: Number of blue nodes to drain in a batch.
+	// +optional
+	BatchNodeCount *int64 `json:"batchNodeCount,omitempty"`
+
+	// BatchPercentage: Percentage of the blue pool nodes to drain in a
+	// batch.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	BatchPercentage *int64 `json:"batchPercentage,omitempty"`
+
+	// BatchSoakDuration: Soak time after each batch gets drained, e.g.
+	// "60s". Defaults to zero.
+	// +optional
+	BatchSoakDuration *string `json:"batchSoakDuration,omitempty"`
 }
 
 // BinaryAuthorization is configuration for Binary Authorization.
@@ -803,6 +1074,97 @@ type BinaryAuthorization struct {
 	Enabled bool `json:"enabled"`
 }
 
+// BootstrapNodePool is the node pool a GKE cluster is created with. GKE
+// requires at least one node pool to create a cluster that does not use
+// Autopilot.
+type BootstrapNodePool struct {
+	// Policy: What happens to the bootstrap node pool once the cluster is
+	// created. Delete deletes it, Keep keeps it so that it can be managed
+	// by a NodePool resource and Inline creates it with the given initial
+	// node count and autoscaling and keeps it as part of the cluster.
+	// Defaults to Delete.
+	// +kubebuilder:validation:Enum=Delete;Keep;Inline
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// Name: The name of the bootstrap node pool. Defaults to
+	// crossplane-bootstrap.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// InitialNodeCount: The initial node count of the bootstrap node pool.
+	// It is only honored when the policy is Inline, the pool is otherwise
+	// created without any nodes.
+	// +optional
+	InitialNodeCount *int64 `json:"initialNodeCount,omitempty"`
+
+	// Autoscaling: Autoscaler configuration of the bootstrap node pool. It
+	// is only honored when the policy is Inline.
+	// +optional
+	Autoscaling *BootstrapNodePoolAutoscaling `json:"autoscaling,omitempty"`
+
+	// MachineType: The name of a Google Compute Engine machine type (e.g.
+	// `e2-medium`) of the bootstrap node pool.
+	// +optional
+	MachineType *string `json:"machineType,omitempty"`
+
+	// DiskSizeGb: Size of the disk attached to each node of the bootstrap
+	// node pool, specified in GB.
+	// +optional
+	DiskSizeGb *int64 `json:"diskSizeGb,omitempty"`
+
+	// DiskType: Type of the disk attached to each node of the bootstrap
+	// node pool (e.g. 'pd-standard', 'pd-balanced' or 'pd-ssd').
+	// +optional
+	DiskType *string `json:"diskType,omitempty"`
+
+	// ImageType: The image type to use for the nodes of the bootstrap node
+	// pool.
+	// +optional
+	ImageType *string `json:"imageType,omitempty"`
+
+	// ServiceAccount: The Google Cloud Platform Service Account to be used
+	// by the nodes of the bootstrap node pool. The Compute Engine default
+	// service account is used if unspecified.
+	// +optional
+	ServiceAccount *string `json:"serviceAccount,omitempty"`
+
+	// OauthScopes: The set of Google API scopes to be made available on
+	// the nodes of the bootstrap node pool.
+	// +optional
+	OauthScopes []string `json:"oauthScopes,omitempty"`
+}
+
+// BootstrapNodePoolAutoscaling contains information required by cluster
+// autoscaler to adjust the size of the bootstrap node pool.
+type BootstrapNodePoolAutoscaling struct {
+	// Enabled: Is autoscaling enabled for the bootstrap node pool.
+	Enabled bool `json:"enabled"`
+
+	// MaxNodeCount: Maximum number of nodes in the bootstrap node pool.
+	// Must be >= minNodeCount.
+	// +optional
+	MaxNodeCount *int64 `json:"maxNodeCount,omitempty"`
+
+	// MinNodeCount: Minimum number of nodes in the bootstrap node pool.
+	// Must be <= maxNodeCount.
+	// +optional
+	MinNodeCount *int64 `json:"minNodeCount,omitempty"`
+}
+
+// VersionSkewPolicy is the version skew between the control plane and the
+// nodes of a cluster.
+type VersionSkewPolicy struct {
+	// MaxNodeMinorVersionSkew: The number of minor versions the nodes are
+	// allowed to be behind the control plane. An upgrade of the control
+	// plane that would leave a node pool further behind is refused.
+	// Defaults to 2.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2
+	// +optional
+	MaxNodeMinorVersionSkew *int64 `json:"maxNodeMinorVersionSkew,omitempty"`
+}
+
 // ConfidentialNodes is configuration for Confidential Nodes.
 type ConfidentialNodes struct {
 	// Enabled: Whether Confidential Nodes feature is enabled for all nodes
@@ -168,6 +168,11 @@ func (in *AutoprovisioningNodePoolDefaults) DeepCopyInto(out *AutoprovisioningNo
 		*out = new(string)
 		**out = **in
 	}
+	if in.ImageType != nil {
+		in, out := &in.ImageType, &out.ImageType
+		*out = new(string)
+		**out = **in
+	}
 	if in.Management != nil {
 		in, out := &in.Management, &out.Management
 		*out = new(NodeManagement)
@@ -240,6 +245,121 @@ func (in *BinaryAuthorization) DeepCopy() *BinaryAuthorization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueGreenSettings) DeepCopyInto(out *BlueGreenSettings) {
+	*out = *in
+	if in.NodePoolSoakDuration != nil {
+		in, out := &in.NodePoolSoakDuration, &out.NodePoolSoakDuration
+		*out = new(string)
+		**out = **in
+	}
+	if in.StandardRolloutPolicy != nil {
+		in, out := &in.StandardRolloutPolicy, &out.StandardRolloutPolicy
+		*out = new(StandardRolloutPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueGreenSettings.
+func (in *BlueGreenSettings) DeepCopy() *BlueGreenSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueGreenSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapNodePool) DeepCopyInto(out *BootstrapNodePool) {
+	*out = *in
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.InitialNodeCount != nil {
+		in, out := &in.InitialNodeCount, &out.InitialNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(BootstrapNodePoolAutoscaling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineType != nil {
+		in, out := &in.MachineType, &out.MachineType
+		*out = new(string)
+		**out = **in
+	}
+	if in.DiskSizeGb != nil {
+		in, out := &in.DiskSizeGb, &out.DiskSizeGb
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DiskType != nil {
+		in, out := &in.DiskType, &out.DiskType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageType != nil {
+		in, out := &in.ImageType, &out.ImageType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccount != nil {
+		in, out := &in.ServiceAccount, &out.ServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.OauthScopes != nil {
+		in, out := &in.OauthScopes, &out.OauthScopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapNodePool.
+func (in *BootstrapNodePool) DeepCopy() *BootstrapNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapNodePoolAutoscaling) DeepCopyInto(out *BootstrapNodePoolAutoscaling) {
+	*out = *in
+	if in.MaxNodeCount != nil {
+		in, out := &in.MaxNodeCount, &out.MaxNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MinNodeCount != nil {
+		in, out := &in.MinNodeCount, &out.MinNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapNodePoolAutoscaling.
+func (in *BootstrapNodePoolAutoscaling) DeepCopy() *BootstrapNodePoolAutoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapNodePoolAutoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CidrBlock) DeepCopyInto(out *CidrBlock) {
 	*out = *in
@@ -335,6 +455,11 @@ func (in *ClusterAutoscaling) DeepCopyInto(out *ClusterAutoscaling) {
 		*out = new(AutoprovisioningNodePoolDefaults)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AutoscalingProfile != nil {
+		in, out := &in.AutoscalingProfile, &out.AutoscalingProfile
+		*out = new(string)
+		**out = **in
+	}
 	if in.EnableNodeAutoprovisioning != nil {
 		in, out := &in.EnableNodeAutoprovisioning, &out.EnableNodeAutoprovisioning
 		*out = new(bool)
@@ -435,6 +560,21 @@ func (in *ClusterObservation) DeepCopyInto(out *ClusterObservation) {
 			}
 		}
 	}
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ClusterOperation)
+		**out = **in
+	}
+	if in.PendingUpdates != nil {
+		in, out := &in.PendingUpdates, &out.PendingUpdates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MasterUpgrade != nil {
+		in, out := &in.MasterUpgrade, &out.MasterUpgrade
+		*out = new(MasterUpgradeStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterObservation.
@@ -447,6 +587,21 @@ func (in *ClusterObservation) DeepCopy() *ClusterObservation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperation) DeepCopyInto(out *ClusterOperation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperation.
+func (in *ClusterOperation) DeepCopy() *ClusterOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 	*out = *in
@@ -475,6 +630,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(BinaryAuthorization)
 		**out = **in
 	}
+	if in.BootstrapNodePool != nil {
+		in, out := &in.BootstrapNodePool, &out.BootstrapNodePool
+		*out = new(BootstrapNodePool)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClusterIpv4Cidr != nil {
 		in, out := &in.ClusterIpv4Cidr, &out.ClusterIpv4Cidr
 		*out = new(string)
@@ -485,6 +645,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(ConfidentialNodes)
 		**out = **in
 	}
+	if in.ConnectionEndpoint != nil {
+		in, out := &in.ConnectionEndpoint, &out.ConnectionEndpoint
+		*out = new(string)
+		**out = **in
+	}
 	if in.DatabaseEncryption != nil {
 		in, out := &in.DatabaseEncryption, &out.DatabaseEncryption
 		*out = new(DatabaseEncryption)
@@ -555,6 +720,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(MasterAuthorizedNetworksConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterVersion != nil {
+		in, out := &in.MasterVersion, &out.MasterVersion
+		*out = new(string)
+		**out = **in
+	}
 	if in.MonitoringService != nil {
 		in, out := &in.MonitoringService, &out.MonitoringService
 		*out = new(string)
@@ -627,6 +797,11 @@ func (in *ClusterParameters) DeepCopyInto(out *ClusterParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VersionSkewPolicy != nil {
+		in, out := &in.VersionSkewPolicy, &out.VersionSkewPolicy
+		*out = new(VersionSkewPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VerticalPodAutoscaling != nil {
 		in, out := &in.VerticalPodAutoscaling, &out.VerticalPodAutoscaling
 		*out = new(VerticalPodAutoscaling)
@@ -1089,6 +1264,21 @@ func (in *MasterAuthorizedNetworksConfig) DeepCopy() *MasterAuthorizedNetworksCo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasterUpgradeStatus) DeepCopyInto(out *MasterUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUpgradeStatus.
+func (in *MasterUpgradeStatus) DeepCopy() *MasterUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MasterUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaxPodsConstraint) DeepCopyInto(out *MaxPodsConstraint) {
 	*out = *in
@@ -1647,6 +1837,36 @@ func (in *ShieldedInstanceConfigClusterStatus) DeepCopy() *ShieldedInstanceConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StandardRolloutPolicy) DeepCopyInto(out *StandardRolloutPolicy) {
+	*out = *in
+	if in.BatchNodeCount != nil {
+		in, out := &in.BatchNodeCount, &out.BatchNodeCount
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BatchPercentage != nil {
+		in, out := &in.BatchPercentage, &out.BatchPercentage
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BatchSoakDuration != nil {
+		in, out := &in.BatchSoakDuration, &out.BatchSoakDuration
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StandardRolloutPolicy.
+func (in *StandardRolloutPolicy) DeepCopy() *StandardRolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(StandardRolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatusCondition) DeepCopyInto(out *StatusCondition) {
 	*out = *in
@@ -1690,6 +1910,16 @@ func (in *UpgradeSettings) DeepCopyInto(out *UpgradeSettings) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(string)
+		**out = **in
+	}
+	if in.BlueGreenSettings != nil {
+		in, out := &in.BlueGreenSettings, &out.BlueGreenSettings
+		*out = new(BlueGreenSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeSettings.
@@ -1702,6 +1932,26 @@ func (in *UpgradeSettings) DeepCopy() *UpgradeSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionSkewPolicy) DeepCopyInto(out *VersionSkewPolicy) {
+	*out = *in
+	if in.MaxNodeMinorVersionSkew != nil {
+		in, out := &in.MaxNodeMinorVersionSkew, &out.MaxNodeMinorVersionSkew
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionSkewPolicy.
+func (in *VersionSkewPolicy) DeepCopy() *VersionSkewPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionSkewPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaling) DeepCopyInto(out *VerticalPodAutoscaling) {
 	*out = *in
@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudSQL backup run statuses.
+const (
+	BackupRunStatusSuccessful = "SUCCESSFUL"
+	BackupRunStatusFailed     = "FAILED"
+	BackupRunStatusSkipped    = "SKIPPED"
+
+	// BackupRunStatusExpired is reported once the backup run has been
+	// deleted at the end of its retention period.
+	BackupRunStatusExpired = "EXPIRED"
+)
+
+// CloudSQLBackupRunParameters define the desired state of an on-demand
+// backup of a Google CloudSQL instance. The ID of the backup run is the
+// external name of the resource.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/backupRuns
+type CloudSQLBackupRunParameters struct {
+	// Instance: The name of the CloudSQL instance that is backed up.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Description: The description of this backup run.
+	// +optional
+	// +immutable
+	Description *string `json:"description,omitempty"`
+
+	// Location: Location of the backups, e.g. a multi-region such as us.
+	// Defaults to the backup location of the instance.
+	// +optional
+	// +immutable
+	Location *string `json:"location,omitempty"`
+
+	// RetentionDays: The number of days after its completion at which the
+	// backup run is deleted. The CloudSQLBackupRun is kept and reports the
+	// backup run as EXPIRED. Backup runs are kept until the
+	// CloudSQLBackupRun is deleted if it is not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+}
+
+// CloudSQLBackupRunObservation is used to show the observed state of the
+// CloudSQLBackupRun.
+type CloudSQLBackupRunObservation struct {
+	// Instance: The name of the instance that was backed up.
+	Instance string `json:"instance,omitempty"`
+
+	// Status: The status of this run, e.g. ENQUEUED, RUNNING, SUCCESSFUL or
+	// FAILED.
+	Status string `json:"status,omitempty"`
+
+	// Type: The type of this run, which is ON_DEMAND for backup runs
+	// requested by a CloudSQLBackupRun.
+	Type string `json:"type,omitempty"`
+
+	// BackupKind: Specifies the kind of backup, PHYSICAL or SNAPSHOT.
+	BackupKind string `json:"backupKind,omitempty"`
+
+	// Location: Location of the backups.
+	Location string `json:"location,omitempty"`
+
+	// EnqueuedTime: The time the run was enqueued in RFC 3339 format.
+	EnqueuedTime string `json:"enqueuedTime,omitempty"`
+
+	// StartTime: The time the backup operation actually started in RFC 3339
+	// format.
+	StartTime string `json:"startTime,omitempty"`
+
+	// EndTime: The time the backup operation completed in RFC 3339 format.
+	EndTime string `json:"endTime,omitempty"`
+
+	// ExpirationTime: The time at which the backup run is deleted in RFC
+	// 3339 format, if RetentionDays is set.
+	ExpirationTime string `json:"expirationTime,omitempty"`
+
+	// Error: Information about why the backup operation failed. This is
+	// only present if the run has the FAILED status.
+	Error string `json:"error,omitempty"`
+
+	// SelfLink: The URI of this resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A CloudSQLBackupRunSpec defines the desired state of a CloudSQLBackupRun.
+type CloudSQLBackupRunSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLBackupRunParameters `json:"forProvider"`
+}
+
+// A CloudSQLBackupRunStatus represents the observed state of a
+// CloudSQLBackupRun.
+type CloudSQLBackupRunStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLBackupRunObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLBackupRun is a managed resource that represents an on-demand
+// backup of a Google CloudSQL instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLBackupRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLBackupRunSpec   `json:"spec"`
+	Status CloudSQLBackupRunStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLBackupRunList contains a list of CloudSQLBackupRun
+type CloudSQLBackupRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLBackupRun `json:"items"`
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudSQLDatabaseParameters define the desired state of a database of a
+// Google CloudSQL instance. The name of the database is the external name
+// of the resource.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/databases
+type CloudSQLDatabaseParameters struct {
+	// Instance: The name of the CloudSQL instance the database belongs to.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Charset: The character set of the database, for example utf8mb4 or
+	// UTF8.
+	// +optional
+	Charset *string `json:"charset,omitempty"`
+
+	// Collation: The collation of the database, for example
+	// utf8mb4_general_ci or en_US.UTF8.
+	// +optional
+	Collation *string `json:"collation,omitempty"`
+}
+
+// CloudSQLDatabaseObservation is used to show the observed state of the
+// CloudSQLDatabase.
+type CloudSQLDatabaseObservation struct {
+	// SelfLink: The URI of this resource.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A CloudSQLDatabaseSpec defines the desired state of a CloudSQLDatabase.
+type CloudSQLDatabaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLDatabaseParameters `json:"forProvider"`
+}
+
+// A CloudSQLDatabaseStatus represents the observed state of a
+// CloudSQLDatabase.
+type CloudSQLDatabaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLDatabaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLDatabase is a managed resource that represents a database of a
+// Google CloudSQL instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLDatabase struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLDatabaseSpec   `json:"spec"`
+	Status CloudSQLDatabaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLDatabaseList contains a list of CloudSQLDatabase
+type CloudSQLDatabaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLDatabase `json:"items"`
+}
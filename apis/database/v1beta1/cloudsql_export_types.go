@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudSQLExportParameters define the desired state of an export of a Google
+// CloudSQL instance to a file in Cloud Storage. The name of the export
+// operation is the external name of the resource. The export is run again
+// when its parameters change.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/instances/export
+type CloudSQLExportParameters struct {
+	// Instance: The name of the CloudSQL instance that is exported.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Bucket: The name of the Cloud Storage bucket the file is written to.
+	// The instance service account must be able to write to it.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket in order to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket in order to set
+	// Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Object: The name of the file within the bucket, e.g.
+	// dumps/nightly.sql.gz. Files ending in .gz are compressed. An existing
+	// file is overwritten.
+	Object string `json:"object"`
+
+	// FileType: The format of the file, SQL or CSV. Defaults to SQL.
+	// +optional
+	// +kubebuilder:validation:Enum=SQL;CSV
+	FileType *string `json:"fileType,omitempty"`
+
+	// Databases: The databases that are exported. All databases of MySQL
+	// instances are exported if it is not set. Exactly one database must be
+	// set for PostgreSQL instances and for CSV files.
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// Offload: Whether the export is run on a temporary instance in order
+	// to reduce the load on the exported instance.
+	// +optional
+	Offload *bool `json:"offload,omitempty"`
+
+	// CSVExportOptions: The options of CSV exports. Required for CSV files.
+	// +optional
+	CSVExportOptions *CSVExportOptions `json:"csvExportOptions,omitempty"`
+}
+
+// CSVExportOptions are the options of a CSV export.
+type CSVExportOptions struct {
+	// SelectQuery: The query whose results are exported.
+	SelectQuery string `json:"selectQuery"`
+}
+
+// CloudSQLExportObservation is used to show the observed state of the
+// CloudSQLExport.
+type CloudSQLExportObservation struct {
+	// URI: The URI of the exported file.
+	URI string `json:"uri,omitempty"`
+
+	// Status: The status of the export operation, e.g. PENDING, RUNNING or
+	// DONE.
+	Status string `json:"status,omitempty"`
+
+	// Error: The reason why the export failed, if it did.
+	Error string `json:"error,omitempty"`
+
+	// StartTime: The time the export started in RFC 3339 format.
+	StartTime string `json:"startTime,omitempty"`
+
+	// EndTime: The time the export completed in RFC 3339 format.
+	EndTime string `json:"endTime,omitempty"`
+
+	// SelfLink: The URI of the export operation.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A CloudSQLExportSpec defines the desired state of a CloudSQLExport.
+type CloudSQLExportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLExportParameters `json:"forProvider"`
+}
+
+// A CloudSQLExportStatus represents the observed state of a CloudSQLExport.
+type CloudSQLExportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLExportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLExport is a managed resource that represents an export of a
+// Google CloudSQL instance to a file in Cloud Storage.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLExport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLExportSpec   `json:"spec"`
+	Status CloudSQLExportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLExportList contains a list of CloudSQLExport
+type CloudSQLExportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLExport `json:"items"`
+}
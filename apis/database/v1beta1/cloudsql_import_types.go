@@ -0,0 +1,164 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// File types of CloudSQL imports and exports.
+const (
+	FileTypeSQL = "SQL"
+	FileTypeCSV = "CSV"
+)
+
+// AnnotationKeyParametersHash is set on a CloudSQLImport or CloudSQLExport
+// to a hash of the parameters it was last run with. It is run again when the
+// hash of its current parameters differs.
+const AnnotationKeyParametersHash = "database.gcp.crossplane.io/parameters-hash"
+
+// CloudSQLImportParameters define the desired state of an import of a file in
+// Cloud Storage into a Google CloudSQL instance. The name of the import
+// operation is the external name of the resource. The import is run again
+// when its parameters change.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/instances/import
+type CloudSQLImportParameters struct {
+	// Instance: The name of the CloudSQL instance the file is imported into.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Bucket: The name of the Cloud Storage bucket that holds the file. The
+	// instance service account must be able to read from it.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket in order to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket in order to set
+	// Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Object: The name of the file within the bucket, e.g. dumps/prod.sql.gz.
+	// Files ending in .gz are decompressed.
+	Object string `json:"object"`
+
+	// FileType: The format of the file, SQL or CSV. Defaults to SQL.
+	// +optional
+	// +kubebuilder:validation:Enum=SQL;CSV
+	FileType *string `json:"fileType,omitempty"`
+
+	// Database: The database the file is imported into. Required for CSV
+	// files and for SQL files imported into PostgreSQL instances.
+	// +optional
+	Database *string `json:"database,omitempty"`
+
+	// ImportUser: The PostgreSQL user that runs the import.
+	// +optional
+	ImportUser *string `json:"importUser,omitempty"`
+
+	// CSVImportOptions: The options of CSV imports.
+	// +optional
+	CSVImportOptions *CSVImportOptions `json:"csvImportOptions,omitempty"`
+}
+
+// CSVImportOptions are the options of a CSV import.
+type CSVImportOptions struct {
+	// Table: The table the CSV file is imported into.
+	Table string `json:"table"`
+
+	// Columns: The columns the CSV file is imported into. All columns of
+	// the table are imported if it is not set.
+	// +optional
+	Columns []string `json:"columns,omitempty"`
+}
+
+// CloudSQLImportObservation is used to show the observed state of the
+// CloudSQLImport.
+type CloudSQLImportObservation struct {
+	// URI: The URI of the imported file.
+	URI string `json:"uri,omitempty"`
+
+	// Status: The status of the import operation, e.g. PENDING, RUNNING or
+	// DONE.
+	Status string `json:"status,omitempty"`
+
+	// Error: The reason why the import failed, if it did.
+	Error string `json:"error,omitempty"`
+
+	// StartTime: The time the import started in RFC 3339 format.
+	StartTime string `json:"startTime,omitempty"`
+
+	// EndTime: The time the import completed in RFC 3339 format.
+	EndTime string `json:"endTime,omitempty"`
+
+	// SelfLink: The URI of the import operation.
+	SelfLink string `json:"selfLink,omitempty"`
+}
+
+// A CloudSQLImportSpec defines the desired state of a CloudSQLImport.
+type CloudSQLImportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLImportParameters `json:"forProvider"`
+}
+
+// A CloudSQLImportStatus represents the observed state of a CloudSQLImport.
+type CloudSQLImportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLImportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLImport is a managed resource that represents an import of a file
+// in Cloud Storage into a Google CloudSQL instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLImport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLImportSpec   `json:"spec"`
+	Status CloudSQLImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLImportList contains a list of CloudSQLImport
+type CloudSQLImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLImport `json:"items"`
+}
@@ -47,17 +47,66 @@ const (
 const (
 	MysqlDBVersionPrefix = "MYSQL"
 	MysqlDefaultUser     = "root"
+	MysqlDefaultUserHost = "%"
 
 	PostgresqlDBVersionPrefix = "POSTGRES"
 	PostgresqlDefaultUser     = "postgres"
 
-	PrivateIPType = "PRIVATE"
-	PublicIPType  = "PRIMARY"
+	PrivateIPType  = "PRIVATE"
+	PublicIPType   = "PRIMARY"
+	OutgoingIPType = "OUTGOING"
 
-	PrivateIPKey = "privateIP"
-	PublicIPKey  = "publicIP"
+	PrivateIPKey  = "privateIP"
+	PublicIPKey   = "publicIP"
+	OutgoingIPKey = "outgoingIP"
+
+	CloudSQLSecretAuthProxyCommandKey = "authProxyCommand"
+)
+
+// CloudSQL instance types.
+const (
+	InstanceTypeCloudSQL    = "CLOUD_SQL_INSTANCE"
+	InstanceTypeReadReplica = "READ_REPLICA_INSTANCE"
+)
+
+// Policies for applying changes that restart a CloudSQL instance.
+const (
+	RestartPolicyImmediate         = "Immediate"
+	RestartPolicyMaintenanceWindow = "MaintenanceWindow"
+	RestartPolicyBlock             = "Block"
+)
+
+// Types of address that may be published as the endpoint of a CloudSQL
+// instance.
+const (
+	ConnectionEndpointPrivate = "Private"
+	ConnectionEndpointPublic  = "Public"
+	ConnectionEndpointPSC     = "PSC"
 )
 
+// AnnotationKeyRestoreOperation is set on a CloudSQLInstance that is restored
+// from a backup run once the restore has been requested. Its value is the
+// name of the restore operation. Removing it requests another restore.
+const AnnotationKeyRestoreOperation = "database.gcp.crossplane.io/restore-operation"
+
+// AnnotationKeyFinalBackupOperation is set on a CloudSQLInstance that is
+// being deleted once its final backup has been requested. Its value is the
+// name of the export operation.
+const AnnotationKeyFinalBackupOperation = "database.gcp.crossplane.io/final-backup-operation"
+
+// AnnotationKeyPasswordHash is set on a CloudSQLInstance or CloudSQLUser once
+// the password stored in its password secret has been applied. Its value is a
+// hash of that password. The password is applied again when the hash of the
+// stored password differs.
+const AnnotationKeyPasswordHash = "database.gcp.crossplane.io/password-hash"
+
+// AnnotationKeyRotateRootPassword can be set on a CloudSQLInstance in order to
+// have a new password generated for its default user. The annotation is
+// removed once the password has been rotated. If RootPasswordSecretRef is set
+// the password is not rotated; the annotation is removed and a warning event
+// is emitted instead.
+const AnnotationKeyRotateRootPassword = "database.gcp.crossplane.io/rotate-root-password"
+
 // CloudSQLInstanceParameters define the desired state of a Google CloudSQL
 // instance. Most of its fields are direct mirror of GCP DatabaseInstance object.
 // See https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/instances#DatabaseInstance
@@ -83,11 +132,49 @@ type CloudSQLInstanceParameters struct {
 	DatabaseVersion *string `json:"databaseVersion,omitempty"`
 
 	// MasterInstanceName: The name of the instance which will act as master
-	// in the replication setup.
+	// in the replication setup. The instance is created as a read replica
+	// of its master. The master may itself be a read replica, in which case
+	// the instance is a cascading replica.
 	// +optional
 	// +immutable
 	MasterInstanceName *string `json:"masterInstanceName,omitempty"`
 
+	// MasterInstanceNameRef references a CloudSQLInstance and retrieves its
+	// name in order to use it as the master of this instance.
+	// +optional
+	// +immutable
+	MasterInstanceNameRef *xpv1.Reference `json:"masterInstanceNameRef,omitempty"`
+
+	// MasterInstanceNameSelector selects a MasterInstanceNameRef.
+	// +optional
+	MasterInstanceNameSelector *xpv1.Selector `json:"masterInstanceNameSelector,omitempty"`
+
+	// ReplicaConfiguration: Configuration specific to read replicas. It
+	// applies only if MasterInstanceName is set.
+	// +optional
+	// +immutable
+	ReplicaConfiguration *ReplicaConfiguration `json:"replicaConfiguration,omitempty"`
+
+	// Source: The source the instance is created from. Instances are
+	// created empty if it is not set.
+	// +optional
+	// +immutable
+	Source *InstanceSource `json:"source,omitempty"`
+
+	// FinalBackup: The export that is taken before the instance is
+	// deleted. The instance is deleted once the export has completed. A
+	// failed export is taken again, and the instance is not deleted until
+	// one succeeds or this is unset. No export is taken if it is not set.
+	// +optional
+	FinalBackup *FinalBackup `json:"finalBackup,omitempty"`
+
+	// PromoteReplica: Whether the read replica is promoted to a stand-alone
+	// instance. The instance stops replicating from its master once it has
+	// been promoted, which cannot be undone. Its replication settings are
+	// ignored from then on.
+	// +optional
+	PromoteReplica *bool `json:"promoteReplica,omitempty"`
+
 	// DiskEncryptionConfiguration: Disk encryption configuration specific
 	// to an instance. Applies only to Second Generation instances.
 	// +optional
@@ -128,6 +215,8 @@ type CloudSQLInstanceParameters struct {
 	OnPremisesConfiguration *OnPremisesConfiguration `json:"onPremisesConfiguration,omitempty"`
 
 	// ReplicaNames: The replicas of the instance.
+	// Deprecated: ReplicaNames is ignored. Replicas are created by setting
+	// their master, and are reported in status.atProvider.replicaNames.
 	// +optional
 	ReplicaNames []string `json:"replicaNames,omitempty"`
 
@@ -135,6 +224,33 @@ type CloudSQLInstanceParameters struct {
 	// the suspension.
 	// +optional
 	SuspensionReason []string `json:"suspensionReason,omitempty"`
+
+	// RootPasswordSecretRef references the key of a Secret that contains
+	// the password of the default user of the instance. A password is
+	// generated if it is not set. Changing the password in the Secret
+	// changes the password of the default user.
+	// +optional
+	RootPasswordSecretRef *xpv1.SecretKeySelector `json:"rootPasswordSecretRef,omitempty"`
+
+	// ConnectionEndpoint: The type of IP address that is published as the
+	// endpoint of the connection secret. The other type is used if the
+	// instance has no address of this type. PSC publishes the DNS name of
+	// an instance that has Private Service Connect enabled, and falls back
+	// to Private otherwise. Defaults to Private.
+	// +optional
+	// +kubebuilder:validation:Enum=Private;Public;PSC
+	ConnectionEndpoint *string `json:"connectionEndpoint,omitempty"`
+
+	// RestartPolicy: When changes that restart the instance, such as
+	// changes to the tier, the activation policy or some database flags,
+	// are applied. Immediate applies them right away, MaintenanceWindow
+	// applies them during the maintenance window of the instance, or right
+	// away if it has none, and Block never applies them. Other changes are
+	// always applied right away. Withheld changes are listed in
+	// status.atProvider.pendingRestartChanges. Defaults to Immediate.
+	// +optional
+	// +kubebuilder:validation:Enum=Immediate;MaintenanceWindow;Block
+	RestartPolicy *string `json:"restartPolicy,omitempty"`
 }
 
 // Settings is Cloud SQL database instance settings.
@@ -257,6 +373,123 @@ type Settings struct {
 	// instances.
 	// +optional
 	StorageAutoResizeLimit *int64 `json:"storageAutoResizeLimit,omitempty"`
+
+	// DenyMaintenancePeriods: The periods during which maintenance is not
+	// performed on the instance.
+	// +optional
+	DenyMaintenancePeriods []*DenyMaintenancePeriod `json:"denyMaintenancePeriods,omitempty"`
+
+	// InsightsConfig: The Query Insights configuration of the instance.
+	// Only relevant for PostgreSQL instances.
+	// +optional
+	InsightsConfig *InsightsConfig `json:"insightsConfig,omitempty"`
+
+	// PasswordValidationPolicy: The password validation policy of the
+	// local users of the instance.
+	// +optional
+	PasswordValidationPolicy *PasswordValidationPolicy `json:"passwordValidationPolicy,omitempty"`
+
+	// SQLServerAuditConfig: The audit configuration of SQL Server
+	// instances.
+	// +optional
+	SQLServerAuditConfig *SQLServerAuditConfig `json:"sqlServerAuditConfig,omitempty"`
+}
+
+// DenyMaintenancePeriod is a date range during which no maintenance is
+// performed on an instance.
+type DenyMaintenancePeriod struct {
+	// StartDate: The start date of the period in the format yyyy-mm-dd, e.g.
+	// 2020-11-01, or mm-dd, e.g. 11-01, for a period that recurs every
+	// year. The year must be omitted from both dates or from neither.
+	StartDate string `json:"startDate"`
+
+	// EndDate: The end date of the period in the format yyyy-mm-dd, e.g.
+	// 2020-11-01, or mm-dd, e.g. 11-01, for a period that recurs every
+	// year.
+	EndDate string `json:"endDate"`
+
+	// Time: The time in UTC at which the period starts on the start date
+	// and ends on the end date, in the format HH:mm:SS, e.g. 00:00:00.
+	// +optional
+	Time *string `json:"time,omitempty"`
+}
+
+// InsightsConfig is the configuration of Cloud SQL Query Insights.
+type InsightsConfig struct {
+	// QueryInsightsEnabled: Whether Query Insights is enabled.
+	// +optional
+	QueryInsightsEnabled *bool `json:"queryInsightsEnabled,omitempty"`
+
+	// QueryPlansPerMinute: The number of query execution plans captured by
+	// Query Insights per minute for all queries combined. Default is 5.
+	// +optional
+	QueryPlansPerMinute *int64 `json:"queryPlansPerMinute,omitempty"`
+
+	// QueryStringLength: The maximum length of stored queries in bytes,
+	// from 256 to 4500. Longer queries are truncated. Default is 1024.
+	// Changing it restarts the instance.
+	// +optional
+	QueryStringLength *int64 `json:"queryStringLength,omitempty"`
+
+	// RecordApplicationTags: Whether Query Insights records the application
+	// tags of queries.
+	// +optional
+	RecordApplicationTags *bool `json:"recordApplicationTags,omitempty"`
+
+	// RecordClientAddress: Whether Query Insights records the client
+	// address of queries.
+	// +optional
+	RecordClientAddress *bool `json:"recordClientAddress,omitempty"`
+}
+
+// PasswordValidationPolicy is the password validation policy of the local
+// users of an instance.
+type PasswordValidationPolicy struct {
+	// EnablePasswordPolicy: Whether the policy is enabled.
+	// +optional
+	EnablePasswordPolicy *bool `json:"enablePasswordPolicy,omitempty"`
+
+	// Complexity: The complexity of passwords. COMPLEXITY_DEFAULT requires
+	// a combination of lowercase, uppercase, numeric and non-alphanumeric
+	// characters.
+	// +optional
+	// +kubebuilder:validation:Enum=COMPLEXITY_UNSPECIFIED;COMPLEXITY_DEFAULT
+	Complexity *string `json:"complexity,omitempty"`
+
+	// DisallowUsernameSubstring: Whether passwords may not contain the user
+	// name.
+	// +optional
+	DisallowUsernameSubstring *bool `json:"disallowUsernameSubstring,omitempty"`
+
+	// MinLength: The minimum number of characters of passwords.
+	// +optional
+	MinLength *int64 `json:"minLength,omitempty"`
+
+	// PasswordChangeInterval: The minimum interval after which a password
+	// can be changed, e.g. 86400s. Only supported for PostgreSQL.
+	// +optional
+	PasswordChangeInterval *string `json:"passwordChangeInterval,omitempty"`
+
+	// ReuseInterval: The number of previous passwords that cannot be
+	// reused.
+	// +optional
+	ReuseInterval *int64 `json:"reuseInterval,omitempty"`
+}
+
+// SQLServerAuditConfig is the audit configuration of a SQL Server instance.
+type SQLServerAuditConfig struct {
+	// Bucket: The name of the bucket audit files are uploaded to, e.g.
+	// gs://mybucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// RetentionInterval: How long audit files are kept, e.g. 604800s.
+	// +optional
+	RetentionInterval *string `json:"retentionInterval,omitempty"`
+
+	// UploadInterval: How often audit files are uploaded, e.g. 3600s.
+	// +optional
+	UploadInterval *string `json:"uploadInterval,omitempty"`
 }
 
 // LocationPreference is preferred location. This specifies where a Cloud
@@ -317,6 +550,19 @@ type BackupConfiguration struct {
 	// timezone in the 24 hour format - HH:MM.
 	// +optional
 	StartTime *string `json:"startTime,omitempty"`
+
+	// PointInTimeRecoveryEnabled: Whether point in time recovery is enabled.
+	// Only supported for PostgreSQL instances; MySQL instances use binary
+	// logs instead.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// TransactionLogRetentionDays: The number of days of transaction logs
+	// that are retained for point in time recovery, from 1 to 7.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=7
+	TransactionLogRetentionDays *int64 `json:"transactionLogRetentionDays,omitempty"`
 }
 
 // DatabaseFlags are database flags for Cloud SQL instances.
@@ -468,6 +714,24 @@ type CloudSQLInstanceObservation struct {
 	// properly. During update, use the most recent settingsVersion value
 	// for this instance and do not try to update this value.
 	SettingsVersion int64 `json:"settingsVersion,omitempty"`
+
+	// PendingRestartChanges: The fields whose changes would restart the
+	// instance and are withheld according to the restart policy, for
+	// example settings.tier.
+	PendingRestartChanges []string `json:"pendingRestartChanges,omitempty"`
+
+	// MasterInstanceName: The name of the instance this read replica
+	// replicates from.
+	MasterInstanceName string `json:"masterInstanceName,omitempty"`
+
+	// ReplicaNames: The read replicas of the instance.
+	ReplicaNames []string `json:"replicaNames,omitempty"`
+
+	// Replication: The state of replication of a read replica.
+	Replication *ReplicationStatus `json:"replication,omitempty"`
+
+	// Source: The state of the creation of the instance from its source.
+	Source *InstanceSourceStatus `json:"source,omitempty"`
 }
 
 // IPMapping is database instance IP Mapping.
@@ -520,6 +784,123 @@ type DatabaseInstanceFailoverReplicaStatus struct {
 	Available bool `json:"available"`
 }
 
+// ReplicaConfiguration is the configuration of a read replica.
+type ReplicaConfiguration struct {
+	// FailoverTarget: Specifies if the replica is the failover target. If
+	// the field is set to true the replica will be designated as a failover
+	// replica. In case the primary instance fails, the replica instance
+	// will be promoted as the new primary instance. Only one replica can be
+	// specified as failover target, and the replica has to be in a
+	// different zone than the primary instance.
+	// +optional
+	FailoverTarget *bool `json:"failoverTarget,omitempty"`
+}
+
+// FinalBackup is an export to a Cloud Storage bucket that is taken before a
+// CloudSQL instance is deleted. Backup runs are not used because Cloud SQL
+// deletes them along with the instance.
+type FinalBackup struct {
+	// Bucket: The name of the Cloud Storage bucket the instance is exported
+	// to. The instance service account must be able to write to it. It
+	// must be set, either directly or by reference, or the instance is not
+	// deleted.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+
+	// BucketRef references a Bucket in order to set Bucket.
+	// +optional
+	BucketRef *xpv1.Reference `json:"bucketRef,omitempty"`
+
+	// BucketSelector selects a reference to a Bucket in order to set
+	// Bucket.
+	// +optional
+	BucketSelector *xpv1.Selector `json:"bucketSelector,omitempty"`
+
+	// Databases: The databases that are exported. All databases of MySQL
+	// instances are exported if it is not set. Exactly one database must be
+	// set for PostgreSQL instances.
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+}
+
+// InstanceSource is the source of a CloudSQL instance. The instance is cloned
+// from the source instance unless a backup run is set, in which case it is
+// created and then restored from the backup run.
+type InstanceSource struct {
+	// Instance: The name of the instance to clone, or of the instance that
+	// was backed up.
+	// +optional
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// BackupRunID: The ID of the backup run to restore.
+	// +optional
+	BackupRunID *string `json:"backupRunId,omitempty"`
+
+	// BackupRunIDRef references a CloudSQLBackupRun in order to set
+	// BackupRunID.
+	// +optional
+	BackupRunIDRef *xpv1.Reference `json:"backupRunIdRef,omitempty"`
+
+	// BackupRunIDSelector selects a reference to a CloudSQLBackupRun in
+	// order to set BackupRunID.
+	// +optional
+	BackupRunIDSelector *xpv1.Selector `json:"backupRunIdSelector,omitempty"`
+
+	// PointInTime: The point in time, in RFC 3339 format, at which the
+	// source instance is cloned. The most recent state of the source
+	// instance is cloned if it is not set. It does not apply to restores.
+	// +optional
+	PointInTime *string `json:"pointInTime,omitempty"`
+}
+
+// InstanceSourceStatus is the state of the creation of a CloudSQL instance
+// from its source.
+type InstanceSourceStatus struct {
+	// Instance: The name of the instance that was cloned or backed up.
+	Instance string `json:"instance,omitempty"`
+
+	// BackupRunID: The ID of the backup run that is restored.
+	BackupRunID string `json:"backupRunId,omitempty"`
+
+	// PointInTime: The point in time at which the source instance was
+	// cloned.
+	PointInTime string `json:"pointInTime,omitempty"`
+
+	// Operation: The name of the operation that restores the backup run.
+	Operation string `json:"operation,omitempty"`
+
+	// Status: The status of the restore operation, i.e. PENDING, RUNNING
+	// or DONE.
+	Status string `json:"status,omitempty"`
+
+	// Error: Information about why the restore operation failed.
+	Error string `json:"error,omitempty"`
+}
+
+// ReplicationStatus is the state of replication of a read replica.
+type ReplicationStatus struct {
+	// Enabled: Whether the replica is replicating from its master.
+	Enabled bool `json:"enabled"`
+
+	// FailoverTarget: Whether the replica is the failover target of its
+	// master.
+	FailoverTarget bool `json:"failoverTarget,omitempty"`
+
+	// LagSeconds: The most recently reported number of seconds the replica
+	// is behind its master. It is not set if the lag cannot be looked up in
+	// Cloud Monitoring.
+	LagSeconds *int64 `json:"lagSeconds,omitempty"`
+}
+
 // A CloudSQLInstanceSpec defines the desired state of a CloudSQLInstance.
 type CloudSQLInstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Connection secret keys of a CloudSQLSSLCert.
+const (
+	CloudSQLSecretClientCertificateKey = "clientCertificate"
+	CloudSQLSecretClientPrivateKeyKey  = "clientPrivateKey"
+)
+
+// AnnotationKeyReplacedCertificate is set on a CloudSQLSSLCert once its
+// certificate has been renewed. Its value is the fingerprint of the replaced
+// certificate, which is deleted once its replacement has been published.
+const AnnotationKeyReplacedCertificate = "database.gcp.crossplane.io/replaced-certificate"
+
+// CloudSQLSSLCertParameters define the desired state of a client SSL
+// certificate of a Google CloudSQL instance. The SHA1 fingerprint of the
+// certificate is the external name of the resource.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/sslCerts
+type CloudSQLSSLCertParameters struct {
+	// Instance: The name of the CloudSQL instance the certificate belongs
+	// to.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// CommonName: User supplied name. Must be a distinct name from the
+	// other certificates for this instance. A renewed certificate is named
+	// after it, suffixed with the Unix time of the renewal.
+	// +immutable
+	CommonName string `json:"commonName"`
+
+	// RenewBeforeDays: The number of days before its expiration time at
+	// which the certificate is replaced by a new one. Defaults to 30.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	RenewBeforeDays *int64 `json:"renewBeforeDays,omitempty"`
+}
+
+// CloudSQLSSLCertObservation is used to show the observed state of the
+// CloudSQLSSLCert.
+type CloudSQLSSLCertObservation struct {
+	// CertSerialNumber: Serial number, as extracted from the certificate.
+	CertSerialNumber string `json:"certSerialNumber,omitempty"`
+
+	// CreateTime: The time when the certificate was created in RFC 3339
+	// format.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// ExpirationTime: The time when the certificate expires in RFC 3339
+	// format.
+	ExpirationTime string `json:"expirationTime,omitempty"`
+
+	// SelfLink: The URI of this resource.
+	SelfLink string `json:"selfLink,omitempty"`
+
+	// Sha1Fingerprint: Sha1 Fingerprint.
+	Sha1Fingerprint string `json:"sha1Fingerprint,omitempty"`
+}
+
+// A CloudSQLSSLCertSpec defines the desired state of a CloudSQLSSLCert.
+type CloudSQLSSLCertSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLSSLCertParameters `json:"forProvider"`
+}
+
+// A CloudSQLSSLCertStatus represents the observed state of a
+// CloudSQLSSLCert.
+type CloudSQLSSLCertStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CloudSQLSSLCertObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLSSLCert is a managed resource that represents a client SSL
+// certificate of a Google CloudSQL instance. Its connection secret contains
+// the client certificate and private key along with the server CA
+// certificate of the instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="string",JSONPath=".status.atProvider.expirationTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLSSLCert struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLSSLCertSpec   `json:"spec"`
+	Status CloudSQLSSLCertStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLSSLCertList contains a list of CloudSQLSSLCert
+type CloudSQLSSLCertList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLSSLCert `json:"items"`
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CloudSQLUserParameters define the desired state of a user of a Google
+// CloudSQL instance. The name of the user is the external name of the
+// resource.
+// https://cloud.google.com/sql/docs/mysql/admin-api/rest/v1beta4/users
+type CloudSQLUserParameters struct {
+	// Instance: The name of the CloudSQL instance the user belongs to.
+	// +optional
+	// +immutable
+	Instance *string `json:"instance,omitempty"`
+
+	// InstanceRef references a CloudSQLInstance in order to set Instance.
+	// +optional
+	InstanceRef *xpv1.Reference `json:"instanceRef,omitempty"`
+
+	// InstanceSelector selects a reference to a CloudSQLInstance in order
+	// to set Instance.
+	// +optional
+	InstanceSelector *xpv1.Selector `json:"instanceSelector,omitempty"`
+
+	// Host: The host name from which the user can connect. Only applicable
+	// to MySQL instances; defaults to any host.
+	// +optional
+	// +immutable
+	Host *string `json:"host,omitempty"`
+
+	// PasswordSecretRef references the key of a Secret that contains the
+	// password of the user. A password is generated if it is not set.
+	// Changing the password in the Secret changes the password of the
+	// user.
+	// +optional
+	PasswordSecretRef *xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
+
+	// ConnectionEndpoint: The type of IP address of the instance that is
+	// published as the endpoint of the connection secret. The other type
+	// is used if the instance has no address of this type. PSC publishes
+	// the DNS name of an instance that has Private Service Connect
+	// enabled, and falls back to Private otherwise. Defaults to Private.
+	// +optional
+	// +kubebuilder:validation:Enum=Private;Public;PSC
+	ConnectionEndpoint *string `json:"connectionEndpoint,omitempty"`
+}
+
+// A CloudSQLUserSpec defines the desired state of a CloudSQLUser.
+type CloudSQLUserSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CloudSQLUserParameters `json:"forProvider"`
+}
+
+// A CloudSQLUserStatus represents the observed state of a CloudSQLUser.
+type CloudSQLUserStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CloudSQLUser is a managed resource that represents a user of a Google
+// CloudSQL instance. Its connection secret contains the credentials of the
+// user along with the endpoint and server CA certificate of the instance.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instance"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type CloudSQLUser struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudSQLUserSpec   `json:"spec"`
+	Status CloudSQLUserStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CloudSQLUserList contains a list of CloudSQLUser
+type CloudSQLUserList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CloudSQLUser `json:"items"`
+}
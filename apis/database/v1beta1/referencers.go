@@ -25,19 +25,79 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 
 	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	storagev1alpha3 "github.com/crossplane/provider-gcp/apis/storage/v1alpha3"
 )
 
 // ResolveReferences of this CloudSQLInstance
 func (mg *CloudSQLInstance) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.masterInstanceName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.MasterInstanceName),
+		Reference:    mg.Spec.ForProvider.MasterInstanceNameRef,
+		Selector:     mg.Spec.ForProvider.MasterInstanceNameSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.masterInstanceName")
+	}
+	mg.Spec.ForProvider.MasterInstanceName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MasterInstanceNameRef = rsp.ResolvedReference
+
+	if mg.Spec.ForProvider.Source != nil {
+		// Resolve spec.forProvider.source.instance
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source.Instance),
+			Reference:    mg.Spec.ForProvider.Source.InstanceRef,
+			Selector:     mg.Spec.ForProvider.Source.InstanceSelector,
+			To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.source.instance")
+		}
+		mg.Spec.ForProvider.Source.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Source.InstanceRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.source.backupRunId
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Source.BackupRunID),
+			Reference:    mg.Spec.ForProvider.Source.BackupRunIDRef,
+			Selector:     mg.Spec.ForProvider.Source.BackupRunIDSelector,
+			To:           reference.To{Managed: &CloudSQLBackupRun{}, List: &CloudSQLBackupRunList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.source.backupRunId")
+		}
+		mg.Spec.ForProvider.Source.BackupRunID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.Source.BackupRunIDRef = rsp.ResolvedReference
+	}
+
+	if mg.Spec.ForProvider.FinalBackup != nil {
+		// Resolve spec.forProvider.finalBackup.bucket
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FinalBackup.Bucket),
+			Reference:    mg.Spec.ForProvider.FinalBackup.BucketRef,
+			Selector:     mg.Spec.ForProvider.FinalBackup.BucketSelector,
+			To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.finalBackup.bucket")
+		}
+		mg.Spec.ForProvider.FinalBackup.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.FinalBackup.BucketRef = rsp.ResolvedReference
+	}
 
 	if mg.Spec.ForProvider.Settings.IPConfiguration == nil {
 		return nil
 	}
 
-	r := reference.NewAPIResolver(c, mg)
-
 	// Resolve spec.forProvider.settings.ipConfiguration.privateNetwork
-	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetwork),
 		Reference:    mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetworkRef,
 		Selector:     mg.Spec.ForProvider.Settings.IPConfiguration.PrivateNetworkSelector,
@@ -52,3 +112,157 @@ func (mg *CloudSQLInstance) ResolveReferences(ctx context.Context, c client.Read
 
 	return nil
 }
+
+// ResolveReferences of this CloudSQLDatabase
+func (mg *CloudSQLDatabase) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudSQLUser
+func (mg *CloudSQLUser) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudSQLSSLCert
+func (mg *CloudSQLSSLCert) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudSQLBackupRun
+func (mg *CloudSQLBackupRun) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudSQLImport
+func (mg *CloudSQLImport) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Bucket),
+		Reference:    mg.Spec.ForProvider.BucketRef,
+		Selector:     mg.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	mg.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CloudSQLExport
+func (mg *CloudSQLExport) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.instance
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Instance),
+		Reference:    mg.Spec.ForProvider.InstanceRef,
+		Selector:     mg.Spec.ForProvider.InstanceSelector,
+		To:           reference.To{Managed: &CloudSQLInstance{}, List: &CloudSQLInstanceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.instance")
+	}
+	mg.Spec.ForProvider.Instance = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.InstanceRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.bucket
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Bucket),
+		Reference:    mg.Spec.ForProvider.BucketRef,
+		Selector:     mg.Spec.ForProvider.BucketSelector,
+		To:           reference.To{Managed: &storagev1alpha3.Bucket{}, List: &storagev1alpha3.BucketList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.bucket")
+	}
+	mg.Spec.ForProvider.Bucket = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.BucketRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -45,6 +45,60 @@ var (
 	CloudSQLInstanceGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLInstanceKind)
 )
 
+// CloudSQLDatabase type metadata.
+var (
+	CloudSQLDatabaseKind             = reflect.TypeOf(CloudSQLDatabase{}).Name()
+	CloudSQLDatabaseGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLDatabaseKind}.String()
+	CloudSQLDatabaseKindAPIVersion   = CloudSQLDatabaseKind + "." + SchemeGroupVersion.String()
+	CloudSQLDatabaseGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLDatabaseKind)
+)
+
+// CloudSQLUser type metadata.
+var (
+	CloudSQLUserKind             = reflect.TypeOf(CloudSQLUser{}).Name()
+	CloudSQLUserGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLUserKind}.String()
+	CloudSQLUserKindAPIVersion   = CloudSQLUserKind + "." + SchemeGroupVersion.String()
+	CloudSQLUserGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLUserKind)
+)
+
+// CloudSQLSSLCert type metadata.
+var (
+	CloudSQLSSLCertKind             = reflect.TypeOf(CloudSQLSSLCert{}).Name()
+	CloudSQLSSLCertGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLSSLCertKind}.String()
+	CloudSQLSSLCertKindAPIVersion   = CloudSQLSSLCertKind + "." + SchemeGroupVersion.String()
+	CloudSQLSSLCertGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLSSLCertKind)
+)
+
+// CloudSQLBackupRun type metadata.
+var (
+	CloudSQLBackupRunKind             = reflect.TypeOf(CloudSQLBackupRun{}).Name()
+	CloudSQLBackupRunGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLBackupRunKind}.String()
+	CloudSQLBackupRunKindAPIVersion   = CloudSQLBackupRunKind + "." + SchemeGroupVersion.String()
+	CloudSQLBackupRunGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLBackupRunKind)
+)
+
+// CloudSQLImport type metadata.
+var (
+	CloudSQLImportKind             = reflect.TypeOf(CloudSQLImport{}).Name()
+	CloudSQLImportGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLImportKind}.String()
+	CloudSQLImportKindAPIVersion   = CloudSQLImportKind + "." + SchemeGroupVersion.String()
+	CloudSQLImportGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLImportKind)
+)
+
+// CloudSQLExport type metadata.
+var (
+	CloudSQLExportKind             = reflect.TypeOf(CloudSQLExport{}).Name()
+	CloudSQLExportGroupKind        = schema.GroupKind{Group: Group, Kind: CloudSQLExportKind}.String()
+	CloudSQLExportKindAPIVersion   = CloudSQLExportKind + "." + SchemeGroupVersion.String()
+	CloudSQLExportGroupVersionKind = SchemeGroupVersion.WithKind(CloudSQLExportKind)
+)
+
 func init() {
-	SchemeBuilder.Register(&CloudSQLInstance{}, &CloudSQLInstanceList{})
+	SchemeBuilder.Register(&CloudSQLInstance{}, &CloudSQLInstanceList{},
+		&CloudSQLDatabase{}, &CloudSQLDatabaseList{},
+		&CloudSQLUser{}, &CloudSQLUserList{},
+		&CloudSQLSSLCert{}, &CloudSQLSSLCertList{},
+		&CloudSQLBackupRun{}, &CloudSQLBackupRunList{},
+		&CloudSQLImport{}, &CloudSQLImportList{},
+		&CloudSQLExport{}, &CloudSQLExportList{})
 }
@@ -83,6 +83,16 @@ func (in *BackupConfiguration) DeepCopyInto(out *BackupConfiguration) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PointInTimeRecoveryEnabled != nil {
+		in, out := &in.PointInTimeRecoveryEnabled, &out.PointInTimeRecoveryEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransactionLogRetentionDays != nil {
+		in, out := &in.TransactionLogRetentionDays, &out.TransactionLogRetentionDays
+		*out = new(int64)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupConfiguration.
@@ -96,7 +106,42 @@ func (in *BackupConfiguration) DeepCopy() *BackupConfiguration {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstance) DeepCopyInto(out *CloudSQLInstance) {
+func (in *CSVExportOptions) DeepCopyInto(out *CSVExportOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVExportOptions.
+func (in *CSVExportOptions) DeepCopy() *CSVExportOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVExportOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSVImportOptions) DeepCopyInto(out *CSVImportOptions) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSVImportOptions.
+func (in *CSVImportOptions) DeepCopy() *CSVImportOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSVImportOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLBackupRun) DeepCopyInto(out *CloudSQLBackupRun) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -104,18 +149,18 @@ func (in *CloudSQLInstance) DeepCopyInto(out *CloudSQLInstance) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstance.
-func (in *CloudSQLInstance) DeepCopy() *CloudSQLInstance {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRun.
+func (in *CloudSQLBackupRun) DeepCopy() *CloudSQLBackupRun {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstance)
+	out := new(CloudSQLBackupRun)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CloudSQLInstance) DeepCopyObject() runtime.Object {
+func (in *CloudSQLBackupRun) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -123,31 +168,31 @@ func (in *CloudSQLInstance) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstanceList) DeepCopyInto(out *CloudSQLInstanceList) {
+func (in *CloudSQLBackupRunList) DeepCopyInto(out *CloudSQLBackupRunList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]CloudSQLInstance, len(*in))
+		*out = make([]CloudSQLBackupRun, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceList.
-func (in *CloudSQLInstanceList) DeepCopy() *CloudSQLInstanceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRunList.
+func (in *CloudSQLBackupRunList) DeepCopy() *CloudSQLBackupRunList {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstanceList)
+	out := new(CloudSQLBackupRunList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *CloudSQLInstanceList) DeepCopyObject() runtime.Object {
+func (in *CloudSQLBackupRunList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -155,137 +200,1129 @@ func (in *CloudSQLInstanceList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstanceObservation) DeepCopyInto(out *CloudSQLInstanceObservation) {
+func (in *CloudSQLBackupRunObservation) DeepCopyInto(out *CloudSQLBackupRunObservation) {
 	*out = *in
-	if in.DiskEncryptionStatus != nil {
-		in, out := &in.DiskEncryptionStatus, &out.DiskEncryptionStatus
-		*out = new(DiskEncryptionStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRunObservation.
+func (in *CloudSQLBackupRunObservation) DeepCopy() *CloudSQLBackupRunObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLBackupRunObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLBackupRunParameters) DeepCopyInto(out *CloudSQLBackupRunParameters) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
 		**out = **in
 	}
-	if in.FailoverReplica != nil {
-		in, out := &in.FailoverReplica, &out.FailoverReplica
-		*out = new(DatabaseInstanceFailoverReplicaStatus)
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.IPAddresses != nil {
-		in, out := &in.IPAddresses, &out.IPAddresses
-		*out = make([]*IPMapping, len(*in))
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRunParameters.
+func (in *CloudSQLBackupRunParameters) DeepCopy() *CloudSQLBackupRunParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLBackupRunParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLBackupRunSpec) DeepCopyInto(out *CloudSQLBackupRunSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRunSpec.
+func (in *CloudSQLBackupRunSpec) DeepCopy() *CloudSQLBackupRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLBackupRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLBackupRunStatus) DeepCopyInto(out *CloudSQLBackupRunStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLBackupRunStatus.
+func (in *CloudSQLBackupRunStatus) DeepCopy() *CloudSQLBackupRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLBackupRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabase) DeepCopyInto(out *CloudSQLDatabase) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabase.
+func (in *CloudSQLDatabase) DeepCopy() *CloudSQLDatabase {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabase)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLDatabase) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabaseList) DeepCopyInto(out *CloudSQLDatabaseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLDatabase, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(IPMapping)
-				**out = **in
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceObservation.
-func (in *CloudSQLInstanceObservation) DeepCopy() *CloudSQLInstanceObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabaseList.
+func (in *CloudSQLDatabaseList) DeepCopy() *CloudSQLDatabaseList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabaseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLDatabaseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabaseObservation) DeepCopyInto(out *CloudSQLDatabaseObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabaseObservation.
+func (in *CloudSQLDatabaseObservation) DeepCopy() *CloudSQLDatabaseObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabaseObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabaseParameters) DeepCopyInto(out *CloudSQLDatabaseParameters) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Charset != nil {
+		in, out := &in.Charset, &out.Charset
+		*out = new(string)
+		**out = **in
+	}
+	if in.Collation != nil {
+		in, out := &in.Collation, &out.Collation
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabaseParameters.
+func (in *CloudSQLDatabaseParameters) DeepCopy() *CloudSQLDatabaseParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabaseParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabaseSpec) DeepCopyInto(out *CloudSQLDatabaseSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabaseSpec.
+func (in *CloudSQLDatabaseSpec) DeepCopy() *CloudSQLDatabaseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabaseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLDatabaseStatus) DeepCopyInto(out *CloudSQLDatabaseStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLDatabaseStatus.
+func (in *CloudSQLDatabaseStatus) DeepCopy() *CloudSQLDatabaseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLDatabaseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExport) DeepCopyInto(out *CloudSQLExport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExport.
+func (in *CloudSQLExport) DeepCopy() *CloudSQLExport {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstanceObservation)
-	in.DeepCopyInto(out)
-	return out
+	out := new(CloudSQLExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLExport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExportList) DeepCopyInto(out *CloudSQLExportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExportList.
+func (in *CloudSQLExportList) DeepCopy() *CloudSQLExportList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLExportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLExportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExportObservation) DeepCopyInto(out *CloudSQLExportObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExportObservation.
+func (in *CloudSQLExportObservation) DeepCopy() *CloudSQLExportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLExportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExportParameters) DeepCopyInto(out *CloudSQLExportParameters) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileType != nil {
+		in, out := &in.FileType, &out.FileType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Offload != nil {
+		in, out := &in.Offload, &out.Offload
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CSVExportOptions != nil {
+		in, out := &in.CSVExportOptions, &out.CSVExportOptions
+		*out = new(CSVExportOptions)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExportParameters.
+func (in *CloudSQLExportParameters) DeepCopy() *CloudSQLExportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLExportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExportSpec) DeepCopyInto(out *CloudSQLExportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExportSpec.
+func (in *CloudSQLExportSpec) DeepCopy() *CloudSQLExportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLExportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLExportStatus) DeepCopyInto(out *CloudSQLExportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLExportStatus.
+func (in *CloudSQLExportStatus) DeepCopy() *CloudSQLExportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLExportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImport) DeepCopyInto(out *CloudSQLImport) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImport.
+func (in *CloudSQLImport) DeepCopy() *CloudSQLImport {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLImport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImportList) DeepCopyInto(out *CloudSQLImportList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLImport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImportList.
+func (in *CloudSQLImportList) DeepCopy() *CloudSQLImportList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImportList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLImportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImportObservation) DeepCopyInto(out *CloudSQLImportObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImportObservation.
+func (in *CloudSQLImportObservation) DeepCopy() *CloudSQLImportObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImportObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImportParameters) DeepCopyInto(out *CloudSQLImportParameters) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileType != nil {
+		in, out := &in.FileType, &out.FileType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImportUser != nil {
+		in, out := &in.ImportUser, &out.ImportUser
+		*out = new(string)
+		**out = **in
+	}
+	if in.CSVImportOptions != nil {
+		in, out := &in.CSVImportOptions, &out.CSVImportOptions
+		*out = new(CSVImportOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImportParameters.
+func (in *CloudSQLImportParameters) DeepCopy() *CloudSQLImportParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImportParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImportSpec) DeepCopyInto(out *CloudSQLImportSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImportSpec.
+func (in *CloudSQLImportSpec) DeepCopy() *CloudSQLImportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLImportStatus) DeepCopyInto(out *CloudSQLImportStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLImportStatus.
+func (in *CloudSQLImportStatus) DeepCopy() *CloudSQLImportStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLImportStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstance) DeepCopyInto(out *CloudSQLInstance) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstance.
+func (in *CloudSQLInstance) DeepCopy() *CloudSQLInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLInstance) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstanceList) DeepCopyInto(out *CloudSQLInstanceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceList.
+func (in *CloudSQLInstanceList) DeepCopy() *CloudSQLInstanceList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstanceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLInstanceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstanceObservation) DeepCopyInto(out *CloudSQLInstanceObservation) {
+	*out = *in
+	if in.DiskEncryptionStatus != nil {
+		in, out := &in.DiskEncryptionStatus, &out.DiskEncryptionStatus
+		*out = new(DiskEncryptionStatus)
+		**out = **in
+	}
+	if in.FailoverReplica != nil {
+		in, out := &in.FailoverReplica, &out.FailoverReplica
+		*out = new(DatabaseInstanceFailoverReplicaStatus)
+		**out = **in
+	}
+	if in.IPAddresses != nil {
+		in, out := &in.IPAddresses, &out.IPAddresses
+		*out = make([]*IPMapping, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(IPMapping)
+				**out = **in
+			}
+		}
+	}
+	if in.PendingRestartChanges != nil {
+		in, out := &in.PendingRestartChanges, &out.PendingRestartChanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReplicaNames != nil {
+		in, out := &in.ReplicaNames, &out.ReplicaNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Replication != nil {
+		in, out := &in.Replication, &out.Replication
+		*out = new(ReplicationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(InstanceSourceStatus)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceObservation.
+func (in *CloudSQLInstanceObservation) DeepCopy() *CloudSQLInstanceObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstanceObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstanceParameters) DeepCopyInto(out *CloudSQLInstanceParameters) {
+	*out = *in
+	in.Settings.DeepCopyInto(&out.Settings)
+	if in.DatabaseVersion != nil {
+		in, out := &in.DatabaseVersion, &out.DatabaseVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterInstanceName != nil {
+		in, out := &in.MasterInstanceName, &out.MasterInstanceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MasterInstanceNameRef != nil {
+		in, out := &in.MasterInstanceNameRef, &out.MasterInstanceNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.MasterInstanceNameSelector != nil {
+		in, out := &in.MasterInstanceNameSelector, &out.MasterInstanceNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaConfiguration != nil {
+		in, out := &in.ReplicaConfiguration, &out.ReplicaConfiguration
+		*out = new(ReplicaConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(InstanceSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FinalBackup != nil {
+		in, out := &in.FinalBackup, &out.FinalBackup
+		*out = new(FinalBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PromoteReplica != nil {
+		in, out := &in.PromoteReplica, &out.PromoteReplica
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DiskEncryptionConfiguration != nil {
+		in, out := &in.DiskEncryptionConfiguration, &out.DiskEncryptionConfiguration
+		*out = new(DiskEncryptionConfiguration)
+		**out = **in
+	}
+	if in.FailoverReplica != nil {
+		in, out := &in.FailoverReplica, &out.FailoverReplica
+		*out = new(DatabaseInstanceFailoverReplicaSpec)
+		**out = **in
+	}
+	if in.GceZone != nil {
+		in, out := &in.GceZone, &out.GceZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceType != nil {
+		in, out := &in.InstanceType, &out.InstanceType
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxDiskSize != nil {
+		in, out := &in.MaxDiskSize, &out.MaxDiskSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.OnPremisesConfiguration != nil {
+		in, out := &in.OnPremisesConfiguration, &out.OnPremisesConfiguration
+		*out = new(OnPremisesConfiguration)
+		**out = **in
+	}
+	if in.ReplicaNames != nil {
+		in, out := &in.ReplicaNames, &out.ReplicaNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SuspensionReason != nil {
+		in, out := &in.SuspensionReason, &out.SuspensionReason
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RootPasswordSecretRef != nil {
+		in, out := &in.RootPasswordSecretRef, &out.RootPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+	if in.ConnectionEndpoint != nil {
+		in, out := &in.ConnectionEndpoint, &out.ConnectionEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.RestartPolicy != nil {
+		in, out := &in.RestartPolicy, &out.RestartPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceParameters.
+func (in *CloudSQLInstanceParameters) DeepCopy() *CloudSQLInstanceParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstanceParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstanceSpec) DeepCopyInto(out *CloudSQLInstanceSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceSpec.
+func (in *CloudSQLInstanceSpec) DeepCopy() *CloudSQLInstanceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstanceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLInstanceStatus) DeepCopyInto(out *CloudSQLInstanceStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceStatus.
+func (in *CloudSQLInstanceStatus) DeepCopy() *CloudSQLInstanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLInstanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCert) DeepCopyInto(out *CloudSQLSSLCert) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCert.
+func (in *CloudSQLSSLCert) DeepCopy() *CloudSQLSSLCert {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLSSLCert) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCertList) DeepCopyInto(out *CloudSQLSSLCertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLSSLCert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCertList.
+func (in *CloudSQLSSLCertList) DeepCopy() *CloudSQLSSLCertList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLSSLCertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCertObservation) DeepCopyInto(out *CloudSQLSSLCertObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCertObservation.
+func (in *CloudSQLSSLCertObservation) DeepCopy() *CloudSQLSSLCertObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCertObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCertParameters) DeepCopyInto(out *CloudSQLSSLCertParameters) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RenewBeforeDays != nil {
+		in, out := &in.RenewBeforeDays, &out.RenewBeforeDays
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCertParameters.
+func (in *CloudSQLSSLCertParameters) DeepCopy() *CloudSQLSSLCertParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCertParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCertSpec) DeepCopyInto(out *CloudSQLSSLCertSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCertSpec.
+func (in *CloudSQLSSLCertSpec) DeepCopy() *CloudSQLSSLCertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLSSLCertStatus) DeepCopyInto(out *CloudSQLSSLCertStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLSSLCertStatus.
+func (in *CloudSQLSSLCertStatus) DeepCopy() *CloudSQLSSLCertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLSSLCertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLUser) DeepCopyInto(out *CloudSQLUser) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLUser.
+func (in *CloudSQLUser) DeepCopy() *CloudSQLUser {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLUser) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudSQLUserList) DeepCopyInto(out *CloudSQLUserList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudSQLUser, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLUserList.
+func (in *CloudSQLUserList) DeepCopy() *CloudSQLUserList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudSQLUserList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudSQLUserList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstanceParameters) DeepCopyInto(out *CloudSQLInstanceParameters) {
+func (in *CloudSQLUserParameters) DeepCopyInto(out *CloudSQLUserParameters) {
 	*out = *in
-	in.Settings.DeepCopyInto(&out.Settings)
-	if in.DatabaseVersion != nil {
-		in, out := &in.DatabaseVersion, &out.DatabaseVersion
-		*out = new(string)
-		**out = **in
-	}
-	if in.MasterInstanceName != nil {
-		in, out := &in.MasterInstanceName, &out.MasterInstanceName
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
 		*out = new(string)
 		**out = **in
 	}
-	if in.DiskEncryptionConfiguration != nil {
-		in, out := &in.DiskEncryptionConfiguration, &out.DiskEncryptionConfiguration
-		*out = new(DiskEncryptionConfiguration)
-		**out = **in
-	}
-	if in.FailoverReplica != nil {
-		in, out := &in.FailoverReplica, &out.FailoverReplica
-		*out = new(DatabaseInstanceFailoverReplicaSpec)
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
 		**out = **in
 	}
-	if in.GceZone != nil {
-		in, out := &in.GceZone, &out.GceZone
-		*out = new(string)
-		**out = **in
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.InstanceType != nil {
-		in, out := &in.InstanceType, &out.InstanceType
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
 		*out = new(string)
 		**out = **in
 	}
-	if in.MaxDiskSize != nil {
-		in, out := &in.MaxDiskSize, &out.MaxDiskSize
-		*out = new(int64)
+	if in.PasswordSecretRef != nil {
+		in, out := &in.PasswordSecretRef, &out.PasswordSecretRef
+		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
-	if in.OnPremisesConfiguration != nil {
-		in, out := &in.OnPremisesConfiguration, &out.OnPremisesConfiguration
-		*out = new(OnPremisesConfiguration)
+	if in.ConnectionEndpoint != nil {
+		in, out := &in.ConnectionEndpoint, &out.ConnectionEndpoint
+		*out = new(string)
 		**out = **in
 	}
-	if in.ReplicaNames != nil {
-		in, out := &in.ReplicaNames, &out.ReplicaNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.SuspensionReason != nil {
-		in, out := &in.SuspensionReason, &out.SuspensionReason
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceParameters.
-func (in *CloudSQLInstanceParameters) DeepCopy() *CloudSQLInstanceParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLUserParameters.
+func (in *CloudSQLUserParameters) DeepCopy() *CloudSQLUserParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstanceParameters)
+	out := new(CloudSQLUserParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstanceSpec) DeepCopyInto(out *CloudSQLInstanceSpec) {
+func (in *CloudSQLUserSpec) DeepCopyInto(out *CloudSQLUserSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceSpec.
-func (in *CloudSQLInstanceSpec) DeepCopy() *CloudSQLInstanceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLUserSpec.
+func (in *CloudSQLUserSpec) DeepCopy() *CloudSQLUserSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstanceSpec)
+	out := new(CloudSQLUserSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CloudSQLInstanceStatus) DeepCopyInto(out *CloudSQLInstanceStatus) {
+func (in *CloudSQLUserStatus) DeepCopyInto(out *CloudSQLUserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLInstanceStatus.
-func (in *CloudSQLInstanceStatus) DeepCopy() *CloudSQLInstanceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudSQLUserStatus.
+func (in *CloudSQLUserStatus) DeepCopy() *CloudSQLUserStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(CloudSQLInstanceStatus)
+	out := new(CloudSQLUserStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -335,6 +1372,26 @@ func (in *DatabaseInstanceFailoverReplicaStatus) DeepCopy() *DatabaseInstanceFai
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DenyMaintenancePeriod) DeepCopyInto(out *DenyMaintenancePeriod) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DenyMaintenancePeriod.
+func (in *DenyMaintenancePeriod) DeepCopy() *DenyMaintenancePeriod {
+	if in == nil {
+		return nil
+	}
+	out := new(DenyMaintenancePeriod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskEncryptionConfiguration) DeepCopyInto(out *DiskEncryptionConfiguration) {
 	*out = *in
@@ -365,6 +1422,41 @@ func (in *DiskEncryptionStatus) DeepCopy() *DiskEncryptionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FinalBackup) DeepCopyInto(out *FinalBackup) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.BucketRef != nil {
+		in, out := &in.BucketRef, &out.BucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BucketSelector != nil {
+		in, out := &in.BucketSelector, &out.BucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FinalBackup.
+func (in *FinalBackup) DeepCopy() *FinalBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(FinalBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPConfiguration) DeepCopyInto(out *IPConfiguration) {
 	*out = *in
@@ -431,6 +1523,111 @@ func (in *IPMapping) DeepCopy() *IPMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InsightsConfig) DeepCopyInto(out *InsightsConfig) {
+	*out = *in
+	if in.QueryInsightsEnabled != nil {
+		in, out := &in.QueryInsightsEnabled, &out.QueryInsightsEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.QueryPlansPerMinute != nil {
+		in, out := &in.QueryPlansPerMinute, &out.QueryPlansPerMinute
+		*out = new(int64)
+		**out = **in
+	}
+	if in.QueryStringLength != nil {
+		in, out := &in.QueryStringLength, &out.QueryStringLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RecordApplicationTags != nil {
+		in, out := &in.RecordApplicationTags, &out.RecordApplicationTags
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RecordClientAddress != nil {
+		in, out := &in.RecordClientAddress, &out.RecordClientAddress
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InsightsConfig.
+func (in *InsightsConfig) DeepCopy() *InsightsConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InsightsConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSource) DeepCopyInto(out *InstanceSource) {
+	*out = *in
+	if in.Instance != nil {
+		in, out := &in.Instance, &out.Instance
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstanceRef != nil {
+		in, out := &in.InstanceRef, &out.InstanceRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.InstanceSelector != nil {
+		in, out := &in.InstanceSelector, &out.InstanceSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackupRunID != nil {
+		in, out := &in.BackupRunID, &out.BackupRunID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackupRunIDRef != nil {
+		in, out := &in.BackupRunIDRef, &out.BackupRunIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.BackupRunIDSelector != nil {
+		in, out := &in.BackupRunIDSelector, &out.BackupRunIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PointInTime != nil {
+		in, out := &in.PointInTime, &out.PointInTime
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSource.
+func (in *InstanceSource) DeepCopy() *InstanceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstanceSourceStatus) DeepCopyInto(out *InstanceSourceStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstanceSourceStatus.
+func (in *InstanceSourceStatus) DeepCopy() *InstanceSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InstanceSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocationPreference) DeepCopyInto(out *LocationPreference) {
 	*out = *in
@@ -501,6 +1698,121 @@ func (in *OnPremisesConfiguration) DeepCopy() *OnPremisesConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordValidationPolicy) DeepCopyInto(out *PasswordValidationPolicy) {
+	*out = *in
+	if in.EnablePasswordPolicy != nil {
+		in, out := &in.EnablePasswordPolicy, &out.EnablePasswordPolicy
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Complexity != nil {
+		in, out := &in.Complexity, &out.Complexity
+		*out = new(string)
+		**out = **in
+	}
+	if in.DisallowUsernameSubstring != nil {
+		in, out := &in.DisallowUsernameSubstring, &out.DisallowUsernameSubstring
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MinLength != nil {
+		in, out := &in.MinLength, &out.MinLength
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PasswordChangeInterval != nil {
+		in, out := &in.PasswordChangeInterval, &out.PasswordChangeInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReuseInterval != nil {
+		in, out := &in.ReuseInterval, &out.ReuseInterval
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordValidationPolicy.
+func (in *PasswordValidationPolicy) DeepCopy() *PasswordValidationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordValidationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaConfiguration) DeepCopyInto(out *ReplicaConfiguration) {
+	*out = *in
+	if in.FailoverTarget != nil {
+		in, out := &in.FailoverTarget, &out.FailoverTarget
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaConfiguration.
+func (in *ReplicaConfiguration) DeepCopy() *ReplicaConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicaConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicationStatus) DeepCopyInto(out *ReplicationStatus) {
+	*out = *in
+	if in.LagSeconds != nil {
+		in, out := &in.LagSeconds, &out.LagSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationStatus.
+func (in *ReplicationStatus) DeepCopy() *ReplicationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReplicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SQLServerAuditConfig) DeepCopyInto(out *SQLServerAuditConfig) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetentionInterval != nil {
+		in, out := &in.RetentionInterval, &out.RetentionInterval
+		*out = new(string)
+		**out = **in
+	}
+	if in.UploadInterval != nil {
+		in, out := &in.UploadInterval, &out.UploadInterval
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SQLServerAuditConfig.
+func (in *SQLServerAuditConfig) DeepCopy() *SQLServerAuditConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SQLServerAuditConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Settings) DeepCopyInto(out *Settings) {
 	*out = *in
@@ -597,6 +1909,32 @@ func (in *Settings) DeepCopyInto(out *Settings) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DenyMaintenancePeriods != nil {
+		in, out := &in.DenyMaintenancePeriods, &out.DenyMaintenancePeriods
+		*out = make([]*DenyMaintenancePeriod, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DenyMaintenancePeriod)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.InsightsConfig != nil {
+		in, out := &in.InsightsConfig, &out.InsightsConfig
+		*out = new(InsightsConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PasswordValidationPolicy != nil {
+		in, out := &in.PasswordValidationPolicy, &out.PasswordValidationPolicy
+		*out = new(PasswordValidationPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SQLServerAuditConfig != nil {
+		in, out := &in.SQLServerAuditConfig, &out.SQLServerAuditConfig
+		*out = new(SQLServerAuditConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Settings.
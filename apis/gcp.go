@@ -21,10 +21,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	cachev1beta1 "github.com/crossplane/provider-gcp/apis/cache/v1beta1"
+	computev1alpha1 "github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
 	computev1beta1 "github.com/crossplane/provider-gcp/apis/compute/v1beta1"
 	containerv1beta1 "github.com/crossplane/provider-gcp/apis/container/v1beta1"
 	containerv1beta2 "github.com/crossplane/provider-gcp/apis/container/v1beta2"
 	databasev1beta1 "github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gkehubv1alpha1 "github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
 	iam "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
 	kms "github.com/crossplane/provider-gcp/apis/kms/v1alpha1"
 	pubsub "github.com/crossplane/provider-gcp/apis/pubsub/v1alpha1"
@@ -41,10 +43,12 @@ func init() {
 		gcpv1alpha3.SchemeBuilder.AddToScheme,
 		gcpv1beta1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
+		computev1alpha1.SchemeBuilder.AddToScheme,
 		computev1beta1.SchemeBuilder.AddToScheme,
 		containerv1beta2.SchemeBuilder.AddToScheme,
 		containerv1beta1.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
+		gkehubv1alpha1.SchemeBuilder.AddToScheme,
 		iam.SchemeBuilder.AddToScheme,
 		kms.SchemeBuilder.AddToScheme,
 		pubsub.SchemeBuilder.AddToScheme,
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Feature resource states.
+const (
+	FeatureResourceStateUnspecified = "STATE_UNSPECIFIED"
+	FeatureResourceStateEnabling    = "ENABLING"
+	FeatureResourceStateActive      = "ACTIVE"
+	FeatureResourceStateDisabling   = "DISABLING"
+	FeatureResourceStateUpdating    = "UPDATING"
+)
+
+// FeatureParameters define the desired state of a GKE Hub Feature. The
+// external name of a Feature is the name of the feature to enable, e.g.
+// configmanagement.
+// https://cloud.google.com/anthos/fleet-management/docs/reference/rest/v1/projects.locations.features
+type FeatureParameters struct {
+	// Location: The location of the Feature. Defaults to global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	Location string `json:"location,omitempty"`
+
+	// Labels: GCP labels for this Feature.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// FeatureState describes the high-level state of a Feature.
+type FeatureState struct {
+	// Code: The high-level, machine-readable status of this Feature.
+	Code string `json:"code,omitempty"`
+
+	// Description: A human-readable description of the current status.
+	Description string `json:"description,omitempty"`
+
+	// UpdateTime: The time this status was updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+}
+
+// FeatureObservation is used to show the observed state of the Feature
+// resource on GCP.
+type FeatureObservation struct {
+	// Name: The full, unique name of this Feature resource in the format
+	// `projects/*/locations/*/features/*`.
+	Name string `json:"name,omitempty"`
+
+	// CreateTime: When the Feature resource was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: When the Feature resource was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// ResourceState: The current state of the Feature resource in the Hub
+	// API.
+	ResourceState string `json:"resourceState,omitempty"`
+
+	// State: The Hub-wide Feature state.
+	State *FeatureState `json:"state,omitempty"`
+}
+
+// FeatureSpec defines the desired state of a Feature.
+type FeatureSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeatureParameters `json:"forProvider"`
+}
+
+// FeatureStatus represents the observed state of a Feature.
+type FeatureStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Feature is a managed resource that represents a GKE Hub Feature enabled
+// for a fleet.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.resourceState"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Feature struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureSpec   `json:"spec"`
+	Status FeatureStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureList contains a list of Feature types
+type FeatureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Feature `json:"items"`
+}
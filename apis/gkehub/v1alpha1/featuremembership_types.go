@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// FeatureMembershipParameters define the desired configuration of a GKE Hub
+// Feature for a single Membership.
+type FeatureMembershipParameters struct {
+	// Location: The location of the Feature and the Membership. Defaults to
+	// global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	Location string `json:"location,omitempty"`
+
+	// Feature: The name of the Feature, e.g. configmanagement.
+	// +optional
+	// +immutable
+	Feature *string `json:"feature,omitempty"`
+
+	// FeatureRef references a Feature and retrieves its name.
+	// +optional
+	// +immutable
+	FeatureRef *xpv1.Reference `json:"featureRef,omitempty"`
+
+	// FeatureSelector selects a reference to a Feature.
+	// +optional
+	FeatureSelector *xpv1.Selector `json:"featureSelector,omitempty"`
+
+	// Membership: The ID of the Membership to configure.
+	// +optional
+	// +immutable
+	Membership *string `json:"membership,omitempty"`
+
+	// MembershipRef references a Membership and retrieves its ID.
+	// +optional
+	// +immutable
+	MembershipRef *xpv1.Reference `json:"membershipRef,omitempty"`
+
+	// MembershipSelector selects a reference to a Membership.
+	// +optional
+	MembershipSelector *xpv1.Selector `json:"membershipSelector,omitempty"`
+
+	// ConfigManagement: Config Management configuration of the member
+	// cluster.
+	// +optional
+	ConfigManagement *ConfigManagement `json:"configManagement,omitempty"`
+}
+
+// ConfigManagement configures Config Sync and Policy Controller for a member
+// cluster.
+type ConfigManagement struct {
+	// Version: Version of Config Management to install.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// ConfigSync: Config Sync configuration for the cluster.
+	// +optional
+	ConfigSync *ConfigSync `json:"configSync,omitempty"`
+
+	// PolicyController: Policy Controller configuration for the cluster.
+	// +optional
+	PolicyController *PolicyController `json:"policyController,omitempty"`
+}
+
+// ConfigSync configures Config Sync for a member cluster.
+type ConfigSync struct {
+	// Enabled: Enables the installation of Config Sync.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// SourceFormat: Whether the repository is in hierarchical or
+	// unstructured mode. Defaults to hierarchy.
+	// +optional
+	// +kubebuilder:validation:Enum=hierarchy;unstructured
+	SourceFormat *string `json:"sourceFormat,omitempty"`
+
+	// PreventDrift: Enables the Config Sync admission webhook to prevent
+	// drift.
+	// +optional
+	PreventDrift *bool `json:"preventDrift,omitempty"`
+
+	// Git: Git repository configuration for the cluster.
+	// +optional
+	Git *GitConfig `json:"git,omitempty"`
+}
+
+// GitConfig configures the Git repository Config Sync syncs from.
+type GitConfig struct {
+	// SyncRepo: The URL of the Git repository to use as the source of
+	// truth.
+	SyncRepo string `json:"syncRepo"`
+
+	// SyncBranch: The branch of the repository to sync from. Defaults to
+	// master.
+	// +optional
+	SyncBranch *string `json:"syncBranch,omitempty"`
+
+	// SyncRev: Git revision (tag or hash) to check out. Defaults to HEAD.
+	// +optional
+	SyncRev *string `json:"syncRev,omitempty"`
+
+	// PolicyDir: The path within the repository that represents the top
+	// level of the repository to sync.
+	// +optional
+	PolicyDir *string `json:"policyDir,omitempty"`
+
+	// SyncWaitSecs: Period in seconds between consecutive syncs. Defaults
+	// to 15.
+	// +optional
+	SyncWaitSecs *int64 `json:"syncWaitSecs,omitempty"`
+
+	// SecretType: Type of secret configured for access to the repository.
+	// +optional
+	// +kubebuilder:validation:Enum=ssh;cookiefile;gcenode;token;gcpserviceaccount;none
+	SecretType *string `json:"secretType,omitempty"`
+
+	// HTTPSProxy: URL for the HTTPS proxy to be used when communicating
+	// with the repository.
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty"`
+
+	// GCPServiceAccountEmail: The GCP service account used for
+	// authentication when SecretType is gcpserviceaccount.
+	// +optional
+	GCPServiceAccountEmail *string `json:"gcpServiceAccountEmail,omitempty"`
+}
+
+// PolicyController configures Policy Controller for a member cluster.
+type PolicyController struct {
+	// Enabled: Enables the installation of Policy Controller.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// TemplateLibraryInstalled: Installs the default template library
+	// along with Policy Controller.
+	// +optional
+	TemplateLibraryInstalled *bool `json:"templateLibraryInstalled,omitempty"`
+
+	// AuditIntervalSeconds: The interval for Policy Controller audit scans
+	// in seconds. Zero disables audit.
+	// +optional
+	AuditIntervalSeconds *int64 `json:"auditIntervalSeconds,omitempty"`
+
+	// ExemptableNamespaces: The namespaces that are excluded from Policy
+	// Controller checks.
+	// +optional
+	ExemptableNamespaces []string `json:"exemptableNamespaces,omitempty"`
+
+	// LogDeniesEnabled: Logs all denies and dry run failures.
+	// +optional
+	LogDeniesEnabled *bool `json:"logDeniesEnabled,omitempty"`
+
+	// ReferentialRulesEnabled: Enables constraint templates that reference
+	// objects other than the object currently being evaluated.
+	// +optional
+	ReferentialRulesEnabled *bool `json:"referentialRulesEnabled,omitempty"`
+
+	// MutationEnabled: Enables mutation in Policy Controller.
+	// +optional
+	MutationEnabled *bool `json:"mutationEnabled,omitempty"`
+}
+
+// FeatureMembershipObservation is used to show the observed state of a
+// Feature for a single Membership.
+type FeatureMembershipObservation struct {
+	// State: The high-level state of the Feature for the Membership.
+	State *FeatureState `json:"state,omitempty"`
+
+	// ConfigSyncState: The sync status of Config Sync.
+	ConfigSyncState string `json:"configSyncState,omitempty"`
+
+	// PolicyControllerState: The deployment state of Policy Controller.
+	PolicyControllerState string `json:"policyControllerState,omitempty"`
+}
+
+// FeatureMembershipSpec defines the desired state of a FeatureMembership.
+type FeatureMembershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       FeatureMembershipParameters `json:"forProvider"`
+}
+
+// FeatureMembershipStatus represents the observed state of a
+// FeatureMembership.
+type FeatureMembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          FeatureMembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A FeatureMembership is a managed resource that represents the
+// configuration of a GKE Hub Feature for a single member cluster.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="FEATURE",type="string",JSONPath=".spec.forProvider.feature"
+// +kubebuilder:printcolumn:name="MEMBERSHIP",type="string",JSONPath=".spec.forProvider.membership"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type FeatureMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FeatureMembershipSpec   `json:"spec"`
+	Status FeatureMembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FeatureMembershipList contains a list of FeatureMembership types
+type FeatureMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FeatureMembership `json:"items"`
+}
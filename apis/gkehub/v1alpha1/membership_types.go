@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Membership states.
+const (
+	MembershipStateUnspecified     = "CODE_UNSPECIFIED"
+	MembershipStateCreating        = "CREATING"
+	MembershipStateReady           = "READY"
+	MembershipStateDeleting        = "DELETING"
+	MembershipStateUpdating        = "UPDATING"
+	MembershipStateServiceUpdating = "SERVICE_UPDATING"
+)
+
+// MembershipParameters define the desired state of a GKE Hub Membership.
+// https://cloud.google.com/anthos/fleet-management/docs/reference/rest/v1/projects.locations.memberships
+type MembershipParameters struct {
+	// Location: The location of the Membership. Memberships of GKE clusters
+	// are usually global. Defaults to global.
+	// +optional
+	// +immutable
+	// +kubebuilder:default=global
+	Location string `json:"location,omitempty"`
+
+	// Cluster: The resource link of the GKE cluster to register, in the
+	// form
+	// //container.googleapis.com/projects/{project}/locations/{location}/clusters/{cluster}.
+	// +optional
+	// +immutable
+	Cluster *string `json:"cluster,omitempty"`
+
+	// ClusterRef references a Cluster and retrieves its resource link.
+	// +optional
+	// +immutable
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a Cluster.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// ExternalID: An externally-generated and managed ID for this
+	// Membership.
+	// +optional
+	ExternalID *string `json:"externalId,omitempty"`
+
+	// Labels: GCP labels for this Membership.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Authority: How to identify workloads from this Membership. Setting
+	// it enables fleet workload identity for the registered cluster.
+	// +optional
+	Authority *Authority `json:"authority,omitempty"`
+}
+
+// Authority encodes how Google will recognize identities from the
+// registered cluster.
+type Authority struct {
+	// Issuer: A JSON Web Token (JWT) issuer URI. Defaults to the issuer of
+	// the registered GKE cluster, i.e.
+	// https://container.googleapis.com/v1/projects/{project}/locations/{location}/clusters/{cluster}.
+	// +optional
+	Issuer *string `json:"issuer,omitempty"`
+}
+
+// MembershipObservation is used to show the observed state of the
+// Membership resource on GCP.
+type MembershipObservation struct {
+	// Name: The full, unique name of this Membership resource in the
+	// format `projects/*/locations/*/memberships/{membership_id}`.
+	Name string `json:"name,omitempty"`
+
+	// UniqueID: Google-generated UUID for this resource.
+	UniqueID string `json:"uniqueId,omitempty"`
+
+	// State: The current state of the Membership resource.
+	State string `json:"state,omitempty"`
+
+	// CreateTime: When the Membership was created.
+	CreateTime string `json:"createTime,omitempty"`
+
+	// UpdateTime: When the Membership was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// ClusterMissing: Whether the registered GKE cluster no longer exists.
+	ClusterMissing bool `json:"clusterMissing,omitempty"`
+
+	// WorkloadIdentityPool: The name of the workload identity pool in
+	// which the issuer will be recognized.
+	WorkloadIdentityPool string `json:"workloadIdentityPool,omitempty"`
+
+	// IdentityProvider: An identity provider that reflects the issuer in
+	// the workload identity pool.
+	IdentityProvider string `json:"identityProvider,omitempty"`
+}
+
+// MembershipSpec defines the desired state of a Membership.
+type MembershipSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MembershipParameters `json:"forProvider"`
+}
+
+// MembershipStatus represents the observed state of a Membership.
+type MembershipStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MembershipObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Membership is a managed resource that represents the registration of a
+// GKE cluster with a GKE Hub fleet.
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type Membership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MembershipSpec   `json:"spec"`
+	Status MembershipStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MembershipList contains a list of Membership types
+type MembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Membership `json:"items"`
+}
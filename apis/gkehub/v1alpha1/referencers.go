@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
+)
+
+// GKEResourceLinkPrefix is the prefix of the resource link of a GKE cluster.
+const GKEResourceLinkPrefix = "//container.googleapis.com/"
+
+// ClusterResourceLink extracts the resource link of a Cluster, in the form
+// //container.googleapis.com/projects/*/locations/*/clusters/*.
+func ClusterResourceLink() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		u := v1beta2.ClusterURL()(mg)
+		if u == "" {
+			return ""
+		}
+		return GKEResourceLinkPrefix + strings.ReplaceAll(u, "/zones/", "/locations/")
+	}
+}
+
+// ResolveReferences of this Membership
+func (mg *Membership) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.cluster
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Cluster),
+		Reference:    mg.Spec.ForProvider.ClusterRef,
+		Selector:     mg.Spec.ForProvider.ClusterSelector,
+		To:           reference.To{Managed: &v1beta2.Cluster{}, List: &v1beta2.ClusterList{}},
+		Extract:      ClusterResourceLink(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cluster")
+	}
+	mg.Spec.ForProvider.Cluster = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this FeatureMembership
+func (mg *FeatureMembership) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.feature
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Feature),
+		Reference:    mg.Spec.ForProvider.FeatureRef,
+		Selector:     mg.Spec.ForProvider.FeatureSelector,
+		To:           reference.To{Managed: &Feature{}, List: &FeatureList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.feature")
+	}
+	mg.Spec.ForProvider.Feature = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FeatureRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.membership
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.Membership),
+		Reference:    mg.Spec.ForProvider.MembershipRef,
+		Selector:     mg.Spec.ForProvider.MembershipSelector,
+		To:           reference.To{Managed: &Membership{}, List: &MembershipList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.membership")
+	}
+	mg.Spec.ForProvider.Membership = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.MembershipRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "gkehub.gcp.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Membership type metadata.
+var (
+	MembershipKind             = reflect.TypeOf(Membership{}).Name()
+	MembershipGroupKind        = schema.GroupKind{Group: Group, Kind: MembershipKind}.String()
+	MembershipKindAPIVersion   = MembershipKind + "." + SchemeGroupVersion.String()
+	MembershipGroupVersionKind = SchemeGroupVersion.WithKind(MembershipKind)
+)
+
+// Feature type metadata.
+var (
+	FeatureKind             = reflect.TypeOf(Feature{}).Name()
+	FeatureGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureKind}.String()
+	FeatureKindAPIVersion   = FeatureKind + "." + SchemeGroupVersion.String()
+	FeatureGroupVersionKind = SchemeGroupVersion.WithKind(FeatureKind)
+)
+
+// FeatureMembership type metadata.
+var (
+	FeatureMembershipKind             = reflect.TypeOf(FeatureMembership{}).Name()
+	FeatureMembershipGroupKind        = schema.GroupKind{Group: Group, Kind: FeatureMembershipKind}.String()
+	FeatureMembershipKindAPIVersion   = FeatureMembershipKind + "." + SchemeGroupVersion.String()
+	FeatureMembershipGroupVersionKind = SchemeGroupVersion.WithKind(FeatureMembershipKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Membership{}, &MembershipList{}, &Feature{}, &FeatureList{}, &FeatureMembership{}, &FeatureMembershipList{})
+}
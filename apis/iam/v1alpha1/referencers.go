@@ -25,6 +25,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
 )
 
 // ServiceAccountReferer defines a reference to a ServiceAccount either via its RRN,
@@ -78,6 +80,18 @@ func ServiceAccountMemberName() reference.ExtractValueFn {
 	}
 }
 
+// ClusterWorkloadPool returns the workload pool of a GKE Cluster, or an empty
+// string if Workload Identity is not enabled on it.
+func ClusterWorkloadPool() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		c, ok := mg.(*v1beta2.Cluster)
+		if !ok || c.Spec.ForProvider.WorkloadIdentityConfig == nil {
+			return ""
+		}
+		return c.Spec.ForProvider.WorkloadIdentityConfig.WorkloadPool
+	}
+}
+
 func (sar *ServiceAccountReferer) resolveReferences(ctx context.Context, resolver *reference.APIResolver) error {
 	// Resolve spec.forProvider.serviceAccount
 	rsp, err := resolver.Resolve(ctx, reference.ResolutionRequest{
@@ -130,3 +144,28 @@ func (in *ServiceAccountPolicy) ResolveReferences(ctx context.Context, c client.
 
 	return nil
 }
+
+// ResolveReferences of this WorkloadIdentityBinding
+func (in *WorkloadIdentityBinding) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, in)
+
+	if err := in.Spec.ForProvider.ServiceAccountReferer.resolveReferences(ctx, r); err != nil {
+		return err
+	}
+
+	// Resolve spec.forProvider.workloadPool
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(in.Spec.ForProvider.WorkloadPool),
+		Reference:    in.Spec.ForProvider.ClusterRef,
+		Selector:     in.Spec.ForProvider.ClusterSelector,
+		To:           reference.To{Managed: &v1beta2.Cluster{}, List: &v1beta2.ClusterList{}},
+		Extract:      ClusterWorkloadPool(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.workloadPool")
+	}
+	in.Spec.ForProvider.WorkloadPool = reference.ToPtrValue(rsp.ResolvedValue)
+	in.Spec.ForProvider.ClusterRef = rsp.ResolvedReference
+
+	return nil
+}
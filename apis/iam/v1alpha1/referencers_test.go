@@ -26,6 +26,8 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
 )
 
 const (
@@ -77,6 +79,42 @@ func TestServiceAccountMemberName(t *testing.T) {
 	}
 }
 
+func TestClusterWorkloadPool(t *testing.T) {
+	testCases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"NotCluster": {
+			mg:   &ServiceAccount{},
+			want: "",
+		},
+		"WorkloadIdentityDisabled": {
+			mg:   &v1beta2.Cluster{},
+			want: "",
+		},
+		"WorkloadIdentityEnabled": {
+			mg: &v1beta2.Cluster{
+				Spec: v1beta2.ClusterSpec{
+					ForProvider: v1beta2.ClusterParameters{
+						WorkloadIdentityConfig: &v1beta2.WorkloadIdentityConfig{
+							WorkloadPool: "key-test-project.svc.id.goog",
+						},
+					},
+				},
+			},
+			want: "key-test-project.svc.id.goog",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ClusterWorkloadPool()(tc.mg)); diff != "" {
+				t.Errorf("ClusterWorkloadPool(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestServiceAccountKey_ResolveReferences(t *testing.T) {
 	type args struct {
 		saKey *ServiceAccountKey
@@ -61,8 +61,17 @@ var (
 	ServiceAccountPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ServiceAccountPolicyKind)
 )
 
+// WorkloadIdentityBinding type metadata.
+var (
+	WorkloadIdentityBindingKind             = reflect.TypeOf(WorkloadIdentityBinding{}).Name()
+	WorkloadIdentityBindingGroupKind        = schema.GroupKind{Group: Group, Kind: WorkloadIdentityBindingKind}.String()
+	WorkloadIdentityBindingKindAPIVersion   = WorkloadIdentityBindingKind + "." + SchemeGroupVersion.String()
+	WorkloadIdentityBindingGroupVersionKind = SchemeGroupVersion.WithKind(WorkloadIdentityBindingKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&ServiceAccount{}, &ServiceAccountList{},
 		&ServiceAccountKey{}, &ServiceAccountKeyList{},
-		&ServiceAccountPolicy{}, &ServiceAccountPolicyList{})
+		&ServiceAccountPolicy{}, &ServiceAccountPolicyList{},
+		&WorkloadIdentityBinding{}, &WorkloadIdentityBindingList{})
 }
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WorkloadIdentityUserRole is the role that allows a Kubernetes service
+// account to impersonate a ServiceAccount.
+const WorkloadIdentityUserRole = "roles/iam.workloadIdentityUser"
+
+// WorkloadIdentityBindingParameters define the desired state of a
+// WorkloadIdentityBinding. The Kubernetes service account is granted
+// roles/iam.workloadIdentityUser on the ServiceAccount. Other members and
+// bindings of the ServiceAccount's IAM policy are left untouched.
+// https://cloud.google.com/kubernetes-engine/docs/how-to/workload-identity
+type WorkloadIdentityBindingParameters struct {
+	// ServiceAccountReferer refers to the ServiceAccount the Kubernetes
+	// service account is allowed to impersonate.
+	ServiceAccountReferer `json:",inline"`
+
+	// WorkloadPool: The workload pool of the cluster the Kubernetes service
+	// account lives in, for example my-project.svc.id.goog.
+	// +optional
+	// +immutable
+	WorkloadPool *string `json:"workloadPool,omitempty"`
+
+	// ClusterRef references a GKE Cluster with Workload Identity enabled in
+	// order to set WorkloadPool.
+	// +optional
+	ClusterRef *xpv1.Reference `json:"clusterRef,omitempty"`
+
+	// ClusterSelector selects a reference to a GKE Cluster in order to set
+	// WorkloadPool.
+	// +optional
+	ClusterSelector *xpv1.Selector `json:"clusterSelector,omitempty"`
+
+	// Namespace: The namespace of the Kubernetes service account.
+	// +immutable
+	Namespace string `json:"namespace"`
+
+	// KubernetesServiceAccount: The name of the Kubernetes service account.
+	// +immutable
+	KubernetesServiceAccount string `json:"kubernetesServiceAccount"`
+}
+
+// WorkloadIdentityBindingObservation is used to show the observed state of
+// the WorkloadIdentityBinding.
+type WorkloadIdentityBindingObservation struct {
+	// Member: The IAM member that identifies the Kubernetes service account,
+	// for example serviceAccount:my-project.svc.id.goog[default/app].
+	Member string `json:"member,omitempty"`
+}
+
+// A WorkloadIdentityBindingSpec defines the desired state of a
+// WorkloadIdentityBinding.
+type WorkloadIdentityBindingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkloadIdentityBindingParameters `json:"forProvider"`
+}
+
+// A WorkloadIdentityBindingStatus represents the observed state of a
+// WorkloadIdentityBinding.
+type WorkloadIdentityBindingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkloadIdentityBindingObservation `json:"atProvider,omitempty"`
+}
+
+// A WorkloadIdentityBinding is a managed resource that allows a Kubernetes
+// service account to impersonate a ServiceAccount through Workload Identity.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NAMESPACE",type="string",JSONPath=".spec.forProvider.namespace"
+// +kubebuilder:printcolumn:name="KSA",type="string",JSONPath=".spec.forProvider.kubernetesServiceAccount"
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,gcp}
+type WorkloadIdentityBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkloadIdentityBindingSpec   `json:"spec"`
+	Status WorkloadIdentityBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkloadIdentityBindingList contains a list of WorkloadIdentityBinding.
+type WorkloadIdentityBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkloadIdentityBinding `json:"items"`
+}
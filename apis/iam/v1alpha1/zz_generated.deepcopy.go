@@ -583,3 +583,142 @@ func (in *ServiceAccountStatus) DeepCopy() *ServiceAccountStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBinding) DeepCopyInto(out *WorkloadIdentityBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBinding.
+func (in *WorkloadIdentityBinding) DeepCopy() *WorkloadIdentityBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadIdentityBinding) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBindingList) DeepCopyInto(out *WorkloadIdentityBindingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadIdentityBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBindingList.
+func (in *WorkloadIdentityBindingList) DeepCopy() *WorkloadIdentityBindingList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBindingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadIdentityBindingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBindingObservation) DeepCopyInto(out *WorkloadIdentityBindingObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBindingObservation.
+func (in *WorkloadIdentityBindingObservation) DeepCopy() *WorkloadIdentityBindingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBindingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBindingParameters) DeepCopyInto(out *WorkloadIdentityBindingParameters) {
+	*out = *in
+	in.ServiceAccountReferer.DeepCopyInto(&out.ServiceAccountReferer)
+	if in.WorkloadPool != nil {
+		in, out := &in.WorkloadPool, &out.WorkloadPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClusterRef != nil {
+		in, out := &in.ClusterRef, &out.ClusterRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBindingParameters.
+func (in *WorkloadIdentityBindingParameters) DeepCopy() *WorkloadIdentityBindingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBindingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBindingSpec) DeepCopyInto(out *WorkloadIdentityBindingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBindingSpec.
+func (in *WorkloadIdentityBindingSpec) DeepCopy() *WorkloadIdentityBindingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBindingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadIdentityBindingStatus) DeepCopyInto(out *WorkloadIdentityBindingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadIdentityBindingStatus.
+func (in *WorkloadIdentityBindingStatus) DeepCopy() *WorkloadIdentityBindingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadIdentityBindingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -187,3 +187,59 @@ func (mg *ServiceAccountPolicy) SetProviderReference(r *xpv1.Reference) {
 func (mg *ServiceAccountPolicy) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this WorkloadIdentityBinding.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *WorkloadIdentityBinding) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetWriteConnectionSecretToReference of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this WorkloadIdentityBinding.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *WorkloadIdentityBinding) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetWriteConnectionSecretToReference of this WorkloadIdentityBinding.
+func (mg *WorkloadIdentityBinding) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -3,26 +3,26 @@ module github.com/crossplane/provider-gcp
 go 1.16
 
 require (
-	cloud.google.com/go v0.82.0 // indirect
-	cloud.google.com/go/storage v1.15.0
+	cloud.google.com/go/storage v1.30.1
 	github.com/crossplane/crossplane-runtime v0.13.1-0.20210531122928-ded177829557
 	github.com/crossplane/crossplane-tools v0.0.0-20210320162312-1baca298c527
-	github.com/google/go-cmp v0.5.5
+	github.com/google/go-cmp v0.5.9
 	github.com/imdario/mergo v0.3.10
 	github.com/kr/text v0.2.0 // indirect
 	github.com/mitchellh/copystructure v1.0.0
 	github.com/niemeyer/pretty v0.0.0-20200227124842-a10e7caefd8e // indirect
 	github.com/pkg/errors v0.9.1
-	golang.org/x/time v0.0.0-20210220033141-f8bda1e9f3ba // indirect
-	google.golang.org/api v0.47.0
-	google.golang.org/genproto v0.0.0-20210524142926-3e3a6030be83 // indirect
-	google.golang.org/grpc v1.38.0
+	golang.org/x/oauth2 v0.12.0
+	google.golang.org/api v0.140.0
+	google.golang.org/grpc v1.57.0
 	gopkg.in/alecthomas/kingpin.v2 v2.2.6
-	gopkg.in/check.v1 v1.0.0-20200227125254-8fa46927fb4f // indirect
-	honnef.co/go/tools v0.0.1-2020.1.5 // indirect
 	k8s.io/api v0.20.1
 	k8s.io/apimachinery v0.20.1
 	k8s.io/client-go v0.20.1
 	sigs.k8s.io/controller-runtime v0.8.0
 	sigs.k8s.io/controller-tools v0.3.0
 )
+
+// controller-tools v0.3.0 panics while loading the ifreq type of newer
+// golang.org/x/sys/unix releases, which the storage client pulls in.
+replace golang.org/x/sys => golang.org/x/sys v0.0.0-20210514084401-e8d321eab015
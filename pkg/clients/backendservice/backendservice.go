@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendservice
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateBackendService populates the supplied compute.BackendService with
+// the supplied BackendServiceParameters. The security policy is not part of
+// the generated BackendService; it is set using a distinct API call.
+func GenerateBackendService(name string, in v1alpha1.BackendServiceParameters, bs *compute.BackendService) {
+	bs.Name = name
+	bs.AffinityCookieTtlSec = gcp.Int64Value(in.AffinityCookieTTLSec)
+	bs.CustomRequestHeaders = in.CustomRequestHeaders
+	bs.CustomResponseHeaders = in.CustomResponseHeaders
+	bs.Description = gcp.StringValue(in.Description)
+	bs.EnableCDN = gcp.BoolValue(in.EnableCDN)
+	bs.HealthChecks = in.HealthChecks
+	bs.LoadBalancingScheme = gcp.StringValue(in.LoadBalancingScheme)
+	bs.PortName = gcp.StringValue(in.PortName)
+	bs.Protocol = gcp.StringValue(in.Protocol)
+	bs.SessionAffinity = gcp.StringValue(in.SessionAffinity)
+	bs.TimeoutSec = gcp.Int64Value(in.TimeoutSec)
+
+	if len(in.Backends) > 0 {
+		bs.Backends = make([]*compute.Backend, len(in.Backends))
+	}
+	for i, b := range in.Backends {
+		bs.Backends[i] = &compute.Backend{
+			BalancingMode:             gcp.StringValue(b.BalancingMode),
+			Description:               gcp.StringValue(b.Description),
+			Group:                     b.Group,
+			MaxConnections:            gcp.Int64Value(b.MaxConnections),
+			MaxConnectionsPerEndpoint: gcp.Int64Value(b.MaxConnectionsPerEndpoint),
+			MaxConnectionsPerInstance: gcp.Int64Value(b.MaxConnectionsPerInstance),
+			MaxRate:                   gcp.Int64Value(b.MaxRate),
+		}
+	}
+	if in.ConnectionDraining != nil {
+		bs.ConnectionDraining = &compute.ConnectionDraining{
+			DrainingTimeoutSec: in.ConnectionDraining.DrainingTimeoutSec,
+		}
+	}
+	if in.LogConfig != nil {
+		if bs.LogConfig == nil {
+			bs.LogConfig = &compute.BackendServiceLogConfig{}
+		}
+		bs.LogConfig.Enable = gcp.BoolValue(in.LogConfig.Enable)
+	}
+}
+
+// GenerateBackendServiceObservation takes a compute.BackendService and returns
+// *BackendServiceObservation.
+func GenerateBackendServiceObservation(observed compute.BackendService) v1alpha1.BackendServiceObservation {
+	return v1alpha1.BackendServiceObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		Fingerprint:       observed.Fingerprint,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.BackendService object. The security policy is not late initialized,
+// so that omitting it detaches the policy.
+func LateInitializeSpec(spec *v1alpha1.BackendServiceParameters, in compute.BackendService) {
+	spec.AffinityCookieTTLSec = gcp.LateInitializeInt64(spec.AffinityCookieTTLSec, in.AffinityCookieTtlSec)
+	spec.CustomRequestHeaders = gcp.LateInitializeStringSlice(spec.CustomRequestHeaders, in.CustomRequestHeaders)
+	spec.CustomResponseHeaders = gcp.LateInitializeStringSlice(spec.CustomResponseHeaders, in.CustomResponseHeaders)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.EnableCDN = gcp.LateInitializeBool(spec.EnableCDN, in.EnableCDN)
+	spec.HealthChecks = gcp.LateInitializeStringSlice(spec.HealthChecks, in.HealthChecks)
+	spec.LoadBalancingScheme = gcp.LateInitializeString(spec.LoadBalancingScheme, in.LoadBalancingScheme)
+	spec.PortName = gcp.LateInitializeString(spec.PortName, in.PortName)
+	spec.Protocol = gcp.LateInitializeString(spec.Protocol, in.Protocol)
+	spec.SessionAffinity = gcp.LateInitializeString(spec.SessionAffinity, in.SessionAffinity)
+	spec.TimeoutSec = gcp.LateInitializeInt64(spec.TimeoutSec, in.TimeoutSec)
+
+	if len(spec.Backends) == len(in.Backends) {
+		for i, b := range in.Backends {
+			spec.Backends[i].BalancingMode = gcp.LateInitializeString(spec.Backends[i].BalancingMode, b.BalancingMode)
+		}
+	}
+	if in.ConnectionDraining != nil && spec.ConnectionDraining == nil {
+		spec.ConnectionDraining = &v1alpha1.ConnectionDraining{
+			DrainingTimeoutSec: in.ConnectionDraining.DrainingTimeoutSec,
+		}
+	}
+	if in.LogConfig != nil && spec.LogConfig == nil {
+		spec.LogConfig = &v1alpha1.BackendServiceLogConfig{
+			Enable: gcp.BoolPtr(in.LogConfig.Enable),
+		}
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.BackendServiceParameters, observed *compute.BackendService) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.BackendService)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateBackendService(name, *in, desired)
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.BackendService{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.Backend{}, "CapacityScaler", "MaxRatePerEndpoint", "MaxRatePerInstance", "MaxUtilization"),
+	), nil
+}
+
+// IsSecurityPolicyUpToDate returns whether the security policy attached to
+// the observed compute.BackendService matches the supplied parameters.
+func IsSecurityPolicyUpToDate(in *v1alpha1.BackendServiceParameters, observed *compute.BackendService) bool {
+	return cmp.Equal(gcp.StringValue(in.SecurityPolicy), observed.SecurityPolicy, gcp.EquateComputeURLs())
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backendservice
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	name               = "coolName"
+	description        = "coolDescription"
+	protocol           = "HTTP"
+	portName           = "http"
+	group              = "https://www.googleapis.com/compute/v1/projects/cool/zones/us-central1-a/instanceGroups/coolGroup"
+	healthChecks       = []string{"https://www.googleapis.com/compute/v1/projects/cool/global/healthChecks/coolCheck"}
+	timeout      int64 = 30
+
+	timestamp          = "coolTime"
+	link               = "coolLink"
+	fingerprint        = "coolFingerprint"
+	id          uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.BackendServiceParameters)) *v1alpha1.BackendServiceParameters {
+	o := &v1alpha1.BackendServiceParameters{
+		Backends: []*v1alpha1.Backend{{
+			BalancingMode: gcp.StringPtr("UTILIZATION"),
+			Group:         group,
+		}},
+		Description:  &description,
+		HealthChecks: healthChecks,
+		PortName:     &portName,
+		Protocol:     &protocol,
+		TimeoutSec:   &timeout,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func backendService(m ...func(*compute.BackendService)) *compute.BackendService {
+	o := &compute.BackendService{
+		Name: name,
+		Backends: []*compute.Backend{{
+			BalancingMode: "UTILIZATION",
+			Group:         group,
+		}},
+		Description:  description,
+		HealthChecks: healthChecks,
+		PortName:     portName,
+		Protocol:     protocol,
+		TimeoutSec:   timeout,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateBackendService(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.BackendServiceParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.BackendService
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: backendService(),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.BackendServiceParameters) {
+					p.Backends = nil
+					p.PortName = nil
+				}),
+			},
+			want: backendService(func(bs *compute.BackendService) {
+				bs.Backends = nil
+				bs.PortName = ""
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.BackendService{}
+			GenerateBackendService(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateBackendService(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateBackendServiceObservation(t *testing.T) {
+	in := backendService(func(bs *compute.BackendService) {
+		bs.CreationTimestamp = timestamp
+		bs.Fingerprint = fingerprint
+		bs.Id = id
+		bs.SelfLink = link
+	})
+	want := v1alpha1.BackendServiceObservation{
+		CreationTimestamp: timestamp,
+		Fingerprint:       fingerprint,
+		ID:                id,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateBackendServiceObservation(*in)); diff != "" {
+		t.Errorf("GenerateBackendServiceObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.BackendServiceParameters
+		in   compute.BackendService
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.BackendServiceParameters
+	}{
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *backendService(func(bs *compute.BackendService) {
+					bs.Description = "some other description"
+				}),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.BackendServiceParameters) {
+					p.Backends[0].BalancingMode = nil
+					p.TimeoutSec = nil
+				}),
+				in: *backendService(func(bs *compute.BackendService) {
+					bs.ConnectionDraining = &compute.ConnectionDraining{DrainingTimeoutSec: 300}
+				}),
+			},
+			want: params(func(p *v1alpha1.BackendServiceParameters) {
+				p.ConnectionDraining = &v1alpha1.ConnectionDraining{DrainingTimeoutSec: 300}
+			}),
+		},
+		"SecurityPolicyNotLateInitialized": {
+			args: args{
+				spec: params(),
+				in: *backendService(func(bs *compute.BackendService) {
+					bs.SecurityPolicy = "projects/cool/global/securityPolicies/coolPolicy"
+				}),
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		name     string
+		in       *v1alpha1.BackendServiceParameters
+		observed *compute.BackendService
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				name: name,
+				in:   params(),
+				observed: backendService(func(bs *compute.BackendService) {
+					bs.Backends[0].CapacityScaler = 1
+					bs.Fingerprint = fingerprint
+				}),
+			},
+			want: true,
+		},
+		"NotUpToDate": {
+			args: args{
+				name: name,
+				in:   params(),
+				observed: backendService(func(bs *compute.BackendService) {
+					bs.HealthChecks = nil
+				}),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.name, tc.args.in, tc.args.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSecurityPolicyUpToDate(t *testing.T) {
+	policy := "projects/cool/global/securityPolicies/coolPolicy"
+	cases := map[string]struct {
+		in       *v1alpha1.BackendServiceParameters
+		observed *compute.BackendService
+		want     bool
+	}{
+		"NoPolicy": {
+			in:       params(),
+			observed: backendService(),
+			want:     true,
+		},
+		"SamePolicy": {
+			in: params(func(p *v1alpha1.BackendServiceParameters) {
+				p.SecurityPolicy = &policy
+			}),
+			observed: backendService(func(bs *compute.BackendService) {
+				bs.SecurityPolicy = "https://www.googleapis.com/compute/v1/" + policy
+			}),
+			want: true,
+		},
+		"Detach": {
+			in: params(),
+			observed: backendService(func(bs *compute.BackendService) {
+				bs.SecurityPolicy = policy
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsSecurityPolicyUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsSecurityPolicyUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
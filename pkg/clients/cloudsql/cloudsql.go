@@ -17,20 +17,42 @@ limitations under the License.
 package cloudsql
 
 import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
+	monitoring "google.golang.org/api/monitoring/v3"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 )
 
-const errCheckUpToDate = "unable to determine if external resource is up to date"
+const (
+	errCheckUpToDate     = "unable to determine if external resource is up to date"
+	errParseBackupRunID  = "cannot parse the ID of the backup run"
+	errGetPasswordSecret = "cannot get password secret"
+)
 
 // Cyclomatic complexity test is disabled for translation methods
 // because all they do is simple comparison & assignment without
@@ -41,13 +63,22 @@ const errCheckUpToDate = "unable to determine if external resource is up to date
 func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters, db *sqladmin.DatabaseInstance) { // nolint:gocyclo
 	db.DatabaseVersion = gcp.StringValue(in.DatabaseVersion)
 	db.GceZone = gcp.StringValue(in.GceZone)
-	db.InstanceType = gcp.StringValue(in.InstanceType)
-	db.MasterInstanceName = gcp.StringValue(in.MasterInstanceName)
 	db.MaxDiskSize = gcp.Int64Value(in.MaxDiskSize)
 	db.Name = name
 	db.Region = in.Region
-	db.ReplicaNames = in.ReplicaNames
 	db.SuspensionReason = in.SuspensionReason
+	// The replication fields of a promoted replica reflect the promotion
+	// rather than its spec.
+	if !gcp.BoolValue(in.PromoteReplica) {
+		db.InstanceType = gcp.StringValue(in.InstanceType)
+		db.MasterInstanceName = gcp.StringValue(in.MasterInstanceName)
+		if in.ReplicaConfiguration != nil {
+			if db.ReplicaConfiguration == nil {
+				db.ReplicaConfiguration = &sqladmin.ReplicaConfiguration{}
+			}
+			db.ReplicaConfiguration.FailoverTarget = gcp.BoolValue(in.ReplicaConfiguration.FailoverTarget)
+		}
+	}
 	if in.DiskEncryptionConfiguration != nil {
 		if db.DiskEncryptionConfiguration == nil {
 			db.DiskEncryptionConfiguration = &sqladmin.DiskEncryptionConfiguration{}
@@ -92,6 +123,8 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 		db.Settings.BackupConfiguration.Location = gcp.StringValue(in.Settings.BackupConfiguration.Location)
 		db.Settings.BackupConfiguration.ReplicationLogArchivingEnabled = gcp.BoolValue(in.Settings.BackupConfiguration.ReplicationLogArchivingEnabled)
 		db.Settings.BackupConfiguration.StartTime = gcp.StringValue(in.Settings.BackupConfiguration.StartTime)
+		db.Settings.BackupConfiguration.PointInTimeRecoveryEnabled = gcp.BoolValue(in.Settings.BackupConfiguration.PointInTimeRecoveryEnabled)
+		db.Settings.BackupConfiguration.TransactionLogRetentionDays = gcp.Int64Value(in.Settings.BackupConfiguration.TransactionLogRetentionDays)
 	}
 	if in.Settings.IPConfiguration != nil {
 		if db.Settings.IpConfiguration == nil {
@@ -138,6 +171,54 @@ func GenerateDatabaseInstance(name string, in v1beta1.CloudSQLInstanceParameters
 			Value: val.Value,
 		}
 	}
+	generateSettingsPolicies(in.Settings, db.Settings)
+}
+
+// generateSettingsPolicies fills the maintenance, insights, password and audit
+// policies of the supplied settings. Their boolean fields are always sent so
+// that they can be disabled.
+func generateSettingsPolicies(in v1beta1.Settings, db *sqladmin.Settings) {
+	if len(in.DenyMaintenancePeriods) > 0 {
+		db.DenyMaintenancePeriods = make([]*sqladmin.DenyMaintenancePeriod, len(in.DenyMaintenancePeriods))
+	}
+	for i, val := range in.DenyMaintenancePeriods {
+		db.DenyMaintenancePeriods[i] = &sqladmin.DenyMaintenancePeriod{
+			StartDate: val.StartDate,
+			EndDate:   val.EndDate,
+			Time:      gcp.StringValue(val.Time),
+		}
+	}
+	if in.InsightsConfig != nil {
+		if db.InsightsConfig == nil {
+			db.InsightsConfig = &sqladmin.InsightsConfig{}
+		}
+		db.InsightsConfig.QueryInsightsEnabled = gcp.BoolValue(in.InsightsConfig.QueryInsightsEnabled)
+		db.InsightsConfig.QueryPlansPerMinute = gcp.Int64Value(in.InsightsConfig.QueryPlansPerMinute)
+		db.InsightsConfig.QueryStringLength = gcp.Int64Value(in.InsightsConfig.QueryStringLength)
+		db.InsightsConfig.RecordApplicationTags = gcp.BoolValue(in.InsightsConfig.RecordApplicationTags)
+		db.InsightsConfig.RecordClientAddress = gcp.BoolValue(in.InsightsConfig.RecordClientAddress)
+		db.InsightsConfig.ForceSendFields = []string{"QueryInsightsEnabled", "RecordApplicationTags", "RecordClientAddress"}
+	}
+	if in.PasswordValidationPolicy != nil {
+		if db.PasswordValidationPolicy == nil {
+			db.PasswordValidationPolicy = &sqladmin.PasswordValidationPolicy{}
+		}
+		db.PasswordValidationPolicy.Complexity = gcp.StringValue(in.PasswordValidationPolicy.Complexity)
+		db.PasswordValidationPolicy.DisallowUsernameSubstring = gcp.BoolValue(in.PasswordValidationPolicy.DisallowUsernameSubstring)
+		db.PasswordValidationPolicy.EnablePasswordPolicy = gcp.BoolValue(in.PasswordValidationPolicy.EnablePasswordPolicy)
+		db.PasswordValidationPolicy.MinLength = gcp.Int64Value(in.PasswordValidationPolicy.MinLength)
+		db.PasswordValidationPolicy.PasswordChangeInterval = gcp.StringValue(in.PasswordValidationPolicy.PasswordChangeInterval)
+		db.PasswordValidationPolicy.ReuseInterval = gcp.Int64Value(in.PasswordValidationPolicy.ReuseInterval)
+		db.PasswordValidationPolicy.ForceSendFields = []string{"DisallowUsernameSubstring", "EnablePasswordPolicy"}
+	}
+	if in.SQLServerAuditConfig != nil {
+		if db.SqlServerAuditConfig == nil {
+			db.SqlServerAuditConfig = &sqladmin.SqlServerAuditConfig{}
+		}
+		db.SqlServerAuditConfig.Bucket = gcp.StringValue(in.SQLServerAuditConfig.Bucket)
+		db.SqlServerAuditConfig.RetentionInterval = gcp.StringValue(in.SQLServerAuditConfig.RetentionInterval)
+		db.SqlServerAuditConfig.UploadInterval = gcp.StringValue(in.SQLServerAuditConfig.UploadInterval)
+	}
 }
 
 // GenerateObservation produces CloudSQLInstanceObservation object from *sqladmin.DatabaseInstance object.
@@ -153,6 +234,16 @@ func GenerateObservation(in sqladmin.DatabaseInstance) v1beta1.CloudSQLInstanceO
 		ServiceAccountEmailAddress: in.ServiceAccountEmailAddress,
 		State:                      in.State,
 		SettingsVersion:            in.Settings.SettingsVersion,
+		MasterInstanceName:         in.MasterInstanceName,
+		ReplicaNames:               in.ReplicaNames,
+	}
+	if in.MasterInstanceName != "" {
+		o.Replication = &v1beta1.ReplicationStatus{
+			Enabled: in.Settings.DatabaseReplicationEnabled,
+		}
+		if in.ReplicaConfiguration != nil {
+			o.Replication.FailoverTarget = in.ReplicaConfiguration.FailoverTarget
+		}
 	}
 	if in.DiskEncryptionStatus != nil {
 		o.DiskEncryptionStatus = &v1beta1.DiskEncryptionStatus{
@@ -188,7 +279,6 @@ func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.Da
 	spec.GceZone = gcp.LateInitializeString(spec.GceZone, in.GceZone)
 	spec.InstanceType = gcp.LateInitializeString(spec.InstanceType, in.InstanceType)
 	spec.MaxDiskSize = gcp.LateInitializeInt64(spec.MaxDiskSize, in.MaxDiskSize)
-	spec.ReplicaNames = gcp.LateInitializeStringSlice(spec.ReplicaNames, in.ReplicaNames)
 	spec.SuspensionReason = gcp.LateInitializeStringSlice(spec.SuspensionReason, in.SuspensionReason)
 	if in.Settings != nil {
 		if spec.Settings.Tier == "" {
@@ -237,6 +327,12 @@ func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.Da
 			spec.Settings.BackupConfiguration.StartTime = gcp.LateInitializeString(
 				spec.Settings.BackupConfiguration.StartTime,
 				in.Settings.BackupConfiguration.StartTime)
+			spec.Settings.BackupConfiguration.PointInTimeRecoveryEnabled = gcp.LateInitializeBool(
+				spec.Settings.BackupConfiguration.PointInTimeRecoveryEnabled,
+				in.Settings.BackupConfiguration.PointInTimeRecoveryEnabled)
+			spec.Settings.BackupConfiguration.TransactionLogRetentionDays = gcp.LateInitializeInt64(
+				spec.Settings.BackupConfiguration.TransactionLogRetentionDays,
+				in.Settings.BackupConfiguration.TransactionLogRetentionDays)
 		}
 		if in.Settings.IpConfiguration != nil {
 			if spec.Settings.IPConfiguration == nil {
@@ -272,6 +368,7 @@ func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.Da
 			spec.Settings.MaintenanceWindow.Day = gcp.LateInitializeInt64(spec.Settings.MaintenanceWindow.Day, in.Settings.MaintenanceWindow.Day)
 			spec.Settings.MaintenanceWindow.Hour = gcp.LateInitializeInt64(spec.Settings.MaintenanceWindow.Hour, in.Settings.MaintenanceWindow.Hour)
 		}
+		lateInitializeSettingsPolicies(&spec.Settings, *in.Settings)
 	}
 	if in.DiskEncryptionConfiguration != nil {
 		if spec.DiskEncryptionConfiguration == nil {
@@ -295,21 +392,414 @@ func LateInitializeSpec(spec *v1beta1.CloudSQLInstanceParameters, in sqladmin.Da
 			}
 		}
 	}
+	if in.ReplicaConfiguration != nil {
+		if spec.ReplicaConfiguration == nil {
+			spec.ReplicaConfiguration = &v1beta1.ReplicaConfiguration{}
+		}
+		spec.ReplicaConfiguration.FailoverTarget = gcp.LateInitializeBool(spec.ReplicaConfiguration.FailoverTarget, in.ReplicaConfiguration.FailoverTarget)
+	}
+}
+
+// lateInitializeSettingsPolicies fills the unassigned maintenance, insights,
+// password and audit policies of the supplied settings.
+func lateInitializeSettingsPolicies(spec *v1beta1.Settings, in sqladmin.Settings) {
+	if len(spec.DenyMaintenancePeriods) == 0 && len(in.DenyMaintenancePeriods) != 0 {
+		spec.DenyMaintenancePeriods = make([]*v1beta1.DenyMaintenancePeriod, len(in.DenyMaintenancePeriods))
+		for i, val := range in.DenyMaintenancePeriods {
+			spec.DenyMaintenancePeriods[i] = &v1beta1.DenyMaintenancePeriod{
+				StartDate: val.StartDate,
+				EndDate:   val.EndDate,
+				Time:      gcp.LateInitializeString(nil, val.Time),
+			}
+		}
+	}
+	if in.InsightsConfig != nil {
+		if spec.InsightsConfig == nil {
+			spec.InsightsConfig = &v1beta1.InsightsConfig{}
+		}
+		spec.InsightsConfig.QueryInsightsEnabled = gcp.LateInitializeBool(spec.InsightsConfig.QueryInsightsEnabled, in.InsightsConfig.QueryInsightsEnabled)
+		spec.InsightsConfig.QueryPlansPerMinute = gcp.LateInitializeInt64(spec.InsightsConfig.QueryPlansPerMinute, in.InsightsConfig.QueryPlansPerMinute)
+		spec.InsightsConfig.QueryStringLength = gcp.LateInitializeInt64(spec.InsightsConfig.QueryStringLength, in.InsightsConfig.QueryStringLength)
+		spec.InsightsConfig.RecordApplicationTags = gcp.LateInitializeBool(spec.InsightsConfig.RecordApplicationTags, in.InsightsConfig.RecordApplicationTags)
+		spec.InsightsConfig.RecordClientAddress = gcp.LateInitializeBool(spec.InsightsConfig.RecordClientAddress, in.InsightsConfig.RecordClientAddress)
+	}
+	if in.PasswordValidationPolicy != nil {
+		if spec.PasswordValidationPolicy == nil {
+			spec.PasswordValidationPolicy = &v1beta1.PasswordValidationPolicy{}
+		}
+		p := spec.PasswordValidationPolicy
+		p.Complexity = gcp.LateInitializeString(p.Complexity, in.PasswordValidationPolicy.Complexity)
+		p.DisallowUsernameSubstring = gcp.LateInitializeBool(p.DisallowUsernameSubstring, in.PasswordValidationPolicy.DisallowUsernameSubstring)
+		p.EnablePasswordPolicy = gcp.LateInitializeBool(p.EnablePasswordPolicy, in.PasswordValidationPolicy.EnablePasswordPolicy)
+		p.MinLength = gcp.LateInitializeInt64(p.MinLength, in.PasswordValidationPolicy.MinLength)
+		p.PasswordChangeInterval = gcp.LateInitializeString(p.PasswordChangeInterval, in.PasswordValidationPolicy.PasswordChangeInterval)
+		p.ReuseInterval = gcp.LateInitializeInt64(p.ReuseInterval, in.PasswordValidationPolicy.ReuseInterval)
+	}
+	if in.SqlServerAuditConfig != nil {
+		if spec.SQLServerAuditConfig == nil {
+			spec.SQLServerAuditConfig = &v1beta1.SQLServerAuditConfig{}
+		}
+		a := spec.SQLServerAuditConfig
+		a.Bucket = gcp.LateInitializeString(a.Bucket, in.SqlServerAuditConfig.Bucket)
+		a.RetentionInterval = gcp.LateInitializeString(a.RetentionInterval, in.SqlServerAuditConfig.RetentionInterval)
+		a.UploadInterval = gcp.LateInitializeString(a.UploadInterval, in.SqlServerAuditConfig.UploadInterval)
+	}
+}
+
+// NeedsPromotion returns true if the supplied instance is a read replica that
+// should be promoted to a stand-alone instance.
+func NeedsPromotion(in v1beta1.CloudSQLInstanceParameters, observed sqladmin.DatabaseInstance) bool {
+	return gcp.BoolValue(in.PromoteReplica) && observed.MasterInstanceName != ""
+}
+
+// IsClone returns true if the supplied instance is cloned from its source
+// rather than created empty.
+func IsClone(in v1beta1.CloudSQLInstanceParameters) bool {
+	return in.Source != nil && in.Source.BackupRunID == nil
+}
+
+// IsRestore returns true if the supplied instance is restored from a backup
+// run once it has been created.
+func IsRestore(in v1beta1.CloudSQLInstanceParameters) bool {
+	return in.Source != nil && in.Source.BackupRunID != nil
+}
+
+// GenerateCloneRequest generates *sqladmin.InstancesCloneRequest that clones
+// the source of the supplied instance into an instance with the supplied
+// name.
+func GenerateCloneRequest(name string, in v1beta1.InstanceSource) *sqladmin.InstancesCloneRequest {
+	return &sqladmin.InstancesCloneRequest{CloneContext: &sqladmin.CloneContext{
+		DestinationInstanceName: name,
+		PointInTime:             gcp.StringValue(in.PointInTime),
+	}}
+}
+
+// GenerateRestoreRequest generates *sqladmin.InstancesRestoreBackupRequest
+// that restores the backup run of the supplied source.
+func GenerateRestoreRequest(projectID string, in v1beta1.InstanceSource) (*sqladmin.InstancesRestoreBackupRequest, error) {
+	id, err := strconv.ParseInt(gcp.StringValue(in.BackupRunID), 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseBackupRunID)
+	}
+	return &sqladmin.InstancesRestoreBackupRequest{RestoreBackupContext: &sqladmin.RestoreBackupContext{
+		BackupRunId: id,
+		InstanceId:  gcp.StringValue(in.Instance),
+		Project:     projectID,
+	}}, nil
+}
+
+// GenerateSourceStatus produces the InstanceSourceStatus of the supplied
+// instance. The operation that restores its backup run is reported if it is
+// supplied.
+func GenerateSourceStatus(in v1beta1.CloudSQLInstanceParameters, op *sqladmin.Operation) *v1beta1.InstanceSourceStatus {
+	if in.Source == nil {
+		return nil
+	}
+	s := &v1beta1.InstanceSourceStatus{
+		Instance:    gcp.StringValue(in.Source.Instance),
+		BackupRunID: gcp.StringValue(in.Source.BackupRunID),
+		PointInTime: gcp.StringValue(in.Source.PointInTime),
+	}
+	if op != nil {
+		s.Operation = op.Name
+		s.Status = op.Status
+		s.Error = OperationError(op)
+	}
+	return s
+}
+
+// OperationError returns the errors of the supplied operation as a single
+// message, or an empty string if it did not fail.
+func OperationError(op *sqladmin.Operation) string {
+	if op.Error == nil {
+		return ""
+	}
+	msgs := make([]string, 0, len(op.Error.Errors))
+	for _, e := range op.Error.Errors {
+		msgs = append(msgs, e.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// OperationDone is the status of a Cloud SQL operation that has completed,
+// whether or not it succeeded.
+const OperationDone = "DONE"
+
+// GenerateFinalExportRequest generates *sqladmin.InstancesExportRequest that
+// exports the instance with the supplied name to the bucket of its final
+// backup before it is deleted. The export is named after the instance and the
+// supplied time.
+func GenerateFinalExportRequest(name string, in v1beta1.FinalBackup, now time.Time) *sqladmin.InstancesExportRequest {
+	return &sqladmin.InstancesExportRequest{ExportContext: &sqladmin.ExportContext{
+		FileType:  v1beta1.FileTypeSQL,
+		Uri:       fmt.Sprintf("gs://%s/%s-final-%s.sql.gz", gcp.StringValue(in.Bucket), name, now.UTC().Format("20060102T150405Z")),
+		Databases: in.Databases,
+	}}
+}
+
+// FinalBackupName returns the identity of the final backup that was taken by
+// the supplied operation; the URI of its export.
+func FinalBackupName(op *sqladmin.Operation) string {
+	if op.ExportContext != nil {
+		return op.ExportContext.Uri
+	}
+	return op.Name
+}
+
+// ReplicaLagMetric is the Cloud Monitoring metric that reports the number of
+// seconds a read replica is behind its master.
+const ReplicaLagMetric = "cloudsql.googleapis.com/database/replication/replica_lag"
+
+// ReplicaLagFilter returns the Cloud Monitoring filter that selects the
+// replication lag of the supplied instance.
+func ReplicaLagFilter(projectID, name string) string {
+	return fmt.Sprintf("metric.type=%q AND resource.labels.database_id=%q", ReplicaLagMetric, projectID+":"+name)
+}
+
+// GetReplicaLag returns the most recent replication lag in the supplied time
+// series, in seconds, or nil if none was reported.
+func GetReplicaLag(in *monitoring.ListTimeSeriesResponse) *int64 {
+	// Points are returned in reverse time order.
+	for _, ts := range in.TimeSeries {
+		if len(ts.Points) == 0 || ts.Points[0].Value == nil || ts.Points[0].Value.DoubleValue == nil {
+			continue
+		}
+		lag := int64(math.Round(*ts.Points[0].Value.DoubleValue))
+		return &lag
+	}
+	return nil
 }
 
 // IsUpToDate checks whether current state is up-to-date compared to the given
 // set of parameters.
 func IsUpToDate(name string, in *v1beta1.CloudSQLInstanceParameters, observed *sqladmin.DatabaseInstance) (bool, error) {
+	desired, err := GenerateDesiredInstance(name, *in, observed)
+	if err != nil {
+		return true, err
+	}
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), ignoreForceSendFields), nil
+}
+
+// GenerateDesiredInstance returns a copy of the observed instance with the
+// given set of parameters applied.
+func GenerateDesiredInstance(name string, in v1beta1.CloudSQLInstanceParameters, observed *sqladmin.DatabaseInstance) (*sqladmin.DatabaseInstance, error) {
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
-		return true, errors.Wrap(err, errCheckUpToDate)
+		return nil, errors.Wrap(err, errCheckUpToDate)
 	}
 	desired, ok := generated.(*sqladmin.DatabaseInstance)
 	if !ok {
-		return true, errors.New(errCheckUpToDate)
+		return nil, errors.New(errCheckUpToDate)
+	}
+	GenerateDatabaseInstance(name, in, desired)
+	return desired, nil
+}
+
+// ignoreForceSendFields ignores the fields that are only set on the desired
+// instance so that its false values are sent to the API.
+var ignoreForceSendFields = cmp.Options{
+	cmpopts.IgnoreFields(sqladmin.IpConfiguration{}, "ForceSendFields"),
+	cmpopts.IgnoreFields(sqladmin.InsightsConfig{}, "ForceSendFields"),
+	cmpopts.IgnoreFields(sqladmin.PasswordValidationPolicy{}, "ForceSendFields"),
+}
+
+// Paths of the fields of an instance that may need special handling when
+// they are changed.
+const (
+	settingsPath        = "settings"
+	ipConfigurationPath = "settings.ipConfiguration"
+	databaseFlagsPath   = "settings.databaseFlags"
+	insightsConfigPath  = "settings.insightsConfig"
+)
+
+// restartPaths are the paths of the fields whose changes always restart the
+// instance. Changes to the IP configuration and to database flags only
+// restart the instance in some cases.
+var restartPaths = map[string]bool{
+	"settings.tier":               true,
+	"settings.activationPolicy":   true,
+	"settings.availabilityType":   true,
+	"settings.locationPreference": true,
+}
+
+// A Change is a field of an instance, or of its settings, whose desired value
+// differs from its observed value.
+type Change struct {
+	// Path of the field, e.g. settings.tier.
+	Path string
+
+	// RequiresRestart is true if applying the change restarts the instance.
+	RequiresRestart bool
+}
+
+// GetChanges returns the fields of the observed instance that differ from the
+// desired instance. restartFlags holds the names of the database flags whose
+// changes restart the instance.
+func GetChanges(desired, observed *sqladmin.DatabaseInstance, restartFlags map[string]bool) []Change {
+	var changes []Change
+	for _, p := range changedFields(reflect.ValueOf(desired).Elem(), reflect.ValueOf(observed).Elem(), "") {
+		c := Change{Path: p, RequiresRestart: restartPaths[p]}
+		switch p {
+		case ipConfigurationPath:
+			// Enabling or changing the private network restarts the instance.
+			c.RequiresRestart = privateNetwork(desired) != privateNetwork(observed)
+		case insightsConfigPath:
+			// Changing the maximum query length restarts the instance.
+			c.RequiresRestart = queryStringLength(desired) != queryStringLength(observed)
+		case databaseFlagsPath:
+			for _, f := range ChangedFlags(desired, observed) {
+				c.RequiresRestart = c.RequiresRestart || restartFlags[f]
+			}
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+// changedFields returns the JSON paths of the fields of the supplied structs
+// that differ. The fields of the settings are compared one by one; all other
+// fields are compared as a whole.
+func changedFields(desired, observed reflect.Value, prefix string) []string {
+	var paths []string
+	for i := 0; i < desired.NumField(); i++ {
+		name := jsonName(desired.Type().Field(i))
+		if name == "" {
+			continue
+		}
+		d, o := desired.Field(i), observed.Field(i)
+		if prefix+name == settingsPath && !d.IsNil() && !o.IsNil() {
+			paths = append(paths, changedFields(d.Elem(), o.Elem(), settingsPath+".")...)
+			continue
+		}
+		if !cmp.Equal(d.Interface(), o.Interface(), cmpopts.EquateEmpty(), ignoreForceSendFields) {
+			paths = append(paths, prefix+name)
+		}
+	}
+	return paths
+}
+
+// jsonName returns the name of the supplied field in JSON, or an empty string
+// if the field is not serialized.
+func jsonName(f reflect.StructField) string {
+	name := strings.Split(f.Tag.Get("json"), ",")[0]
+	if name == "-" {
+		return ""
+	}
+	return name
+}
+
+func privateNetwork(in *sqladmin.DatabaseInstance) string {
+	if in.Settings == nil || in.Settings.IpConfiguration == nil {
+		return ""
+	}
+	return in.Settings.IpConfiguration.PrivateNetwork
+}
+
+func queryStringLength(in *sqladmin.DatabaseInstance) int64 {
+	if in.Settings == nil || in.Settings.InsightsConfig == nil {
+		return 0
+	}
+	return in.Settings.InsightsConfig.QueryStringLength
+}
+
+// ChangedFlags returns the names of the database flags that are added,
+// removed or changed in the desired instance.
+func ChangedFlags(desired, observed *sqladmin.DatabaseInstance) []string {
+	d, o := databaseFlags(desired), databaseFlags(observed)
+	var changed []string
+	for name, v := range d {
+		if ov, ok := o[name]; !ok || ov != v {
+			changed = append(changed, name)
+		}
+	}
+	for name := range o {
+		if _, ok := d[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+func databaseFlags(in *sqladmin.DatabaseInstance) map[string]string {
+	flags := map[string]string{}
+	if in.Settings == nil {
+		return flags
+	}
+	for _, f := range in.Settings.DatabaseFlags {
+		flags[f.Name] = f.Value
+	}
+	return flags
+}
+
+// FilterChanges splits the supplied changes into those that may be applied at
+// the supplied time according to the supplied restart policy, and the paths
+// of those that are withheld. Changes are not withheld until a maintenance
+// window that does not exist, so the MaintenanceWindow policy applies them
+// right away if the supplied window is not configured.
+func FilterChanges(policy *string, window *sqladmin.MaintenanceWindow, changes []Change, now time.Time) (apply []Change, pending []string) {
+	for _, c := range changes {
+		withhold := false
+		if c.RequiresRestart {
+			switch gcp.StringValue(policy) {
+			case v1beta1.RestartPolicyBlock:
+				withhold = true
+			case v1beta1.RestartPolicyMaintenanceWindow:
+				withhold = window != nil && window.Day != 0 && !InMaintenanceWindow(window, now)
+			}
+		}
+		if withhold {
+			pending = append(pending, c.Path)
+			continue
+		}
+		apply = append(apply, c)
+	}
+	return apply, pending
+}
+
+// InMaintenanceWindow returns true if the supplied time is within the supplied
+// maintenance window. A window lasts one hour, starting at the configured
+// hour (UTC) of the configured day of the week, where 1 is Monday.
+func InMaintenanceWindow(w *sqladmin.MaintenanceWindow, now time.Time) bool {
+	if w == nil || w.Day == 0 {
+		return false
+	}
+	now = now.UTC()
+	day := int64(now.Weekday())
+	if day == 0 {
+		day = 7
+	}
+	return day == w.Day && int64(now.Hour()) == w.Hour
+}
+
+// GeneratePatch returns an instance that contains only the changed fields of
+// the desired instance. The settings version is included in order to detect
+// concurrent updates.
+func GeneratePatch(desired *sqladmin.DatabaseInstance, changes []Change) *sqladmin.DatabaseInstance {
+	patch := &sqladmin.DatabaseInstance{}
+	pv, dv := reflect.ValueOf(patch).Elem(), reflect.ValueOf(desired).Elem()
+	for _, c := range changes {
+		if !strings.HasPrefix(c.Path, settingsPath+".") {
+			copyField(pv, dv, c.Path)
+			continue
+		}
+		if patch.Settings == nil {
+			patch.Settings = &sqladmin.Settings{SettingsVersion: desired.Settings.SettingsVersion}
+		}
+		copyField(reflect.ValueOf(patch.Settings).Elem(), reflect.ValueOf(desired.Settings).Elem(), strings.TrimPrefix(c.Path, settingsPath+"."))
+	}
+	return patch
+}
+
+// copyField copies the field with the supplied JSON name from one struct to
+// another of the same type.
+func copyField(to, from reflect.Value, name string) {
+	for i := 0; i < from.NumField(); i++ {
+		if jsonName(from.Type().Field(i)) == name {
+			to.Field(i).Set(from.Field(i))
+			return
+		}
 	}
-	GenerateDatabaseInstance(name, *in, desired)
-	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(sqladmin.DatabaseInstance{}, "Settings.IpConfiguration.ForceSendFields")), nil
 }
 
 // DatabaseUserName returns default database user name base on database version
@@ -320,6 +810,15 @@ func DatabaseUserName(p v1beta1.CloudSQLInstanceParameters) string {
 	return v1beta1.MysqlDefaultUser
 }
 
+// DatabaseUserHost returns the host of the default database user based on
+// database version. Only MySQL users have a host.
+func DatabaseUserHost(p v1beta1.CloudSQLInstanceParameters) string {
+	if strings.HasPrefix(gcp.StringValue(p.DatabaseVersion), v1beta1.PostgresqlDBVersionPrefix) {
+		return ""
+	}
+	return v1beta1.MysqlDefaultUserHost
+}
+
 // GetServerCACertificate takes sqladmin.DatabaseInstance and returns the server CA certificate
 // in a form that can be embedded directly into a connection secret.
 func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
@@ -336,3 +835,45 @@ func GetServerCACertificate(in sqladmin.DatabaseInstance) map[string][]byte {
 		v1beta1.CloudSQLSecretServerCACertificateSha1FingerprintKey:  []byte(in.ServerCaCert.Sha1Fingerprint),
 	}
 }
+
+// IsPSCEnabled returns whether the supplied instance has Private Service
+// Connect enabled and a DNS name through which it can be reached.
+func IsPSCEnabled(in *sqladmin.DatabaseInstance) bool {
+	if in.DnsName == "" || in.Settings == nil || in.Settings.IpConfiguration == nil || in.Settings.IpConfiguration.PscConfig == nil {
+		return false
+	}
+	return in.Settings.IpConfiguration.PscConfig.PscEnabled
+}
+
+// GetPassword returns the password stored in the Secret key referenced by in,
+// and whether it differs from the password last applied to o as recorded by
+// SetPasswordHash. It returns an empty password if in is nil.
+func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, o metav1.Object) (pw string, changed bool, err error) {
+	if in == nil {
+		return "", false, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: in.Name, Namespace: in.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecret)
+	}
+	pw = string(s.Data[in.Key])
+	if o == nil {
+		return pw, false, nil
+	}
+	return pw, pw != "" && PasswordHash(o, pw) != o.GetAnnotations()[v1beta1.AnnotationKeyPasswordHash], nil
+}
+
+// SetPasswordHash records the supplied password as the password last applied
+// to o.
+func SetPasswordHash(o metav1.Object, pw string) {
+	meta.AddAnnotations(o, map[string]string{v1beta1.AnnotationKeyPasswordHash: PasswordHash(o, pw)})
+}
+
+// PasswordHash returns a hash of the supplied password. The hash is keyed with
+// the UID of o so that equal passwords of different resources cannot be told
+// apart.
+func PasswordHash(o metav1.Object, pw string) string {
+	h := hmac.New(sha256.New, []byte(o.GetUID()))
+	_, _ = h.Write([]byte(pw))
+	return hex.EncodeToString(h.Sum(nil))
+}
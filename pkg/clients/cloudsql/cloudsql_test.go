@@ -17,10 +17,20 @@ limitations under the License.
 package cloudsql
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	monitoring "google.golang.org/api/monitoring/v3"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
@@ -58,6 +68,8 @@ func params(m ...func(*v1beta1.CloudSQLInstanceParameters)) *v1beta1.CloudSQLIns
 				Location:                       gcp.StringPtr("us-west1"),
 				ReplicationLogArchivingEnabled: gcp.BoolPtr(true),
 				StartTime:                      gcp.StringPtr("20191018"),
+				PointInTimeRecoveryEnabled:     gcp.BoolPtr(true),
+				TransactionLogRetentionDays:    gcp.Int64Ptr(7),
 			},
 			IPConfiguration: &v1beta1.IPConfiguration{
 				AuthorizedNetworks: []*v1beta1.ACLEntry{
@@ -80,6 +92,33 @@ func params(m ...func(*v1beta1.CloudSQLInstanceParameters)) *v1beta1.CloudSQLIns
 			DataDiskSizeGb:             gcp.Int64Ptr(2),
 			DatabaseReplicationEnabled: gcp.BoolPtr(true),
 			StorageAutoResizeLimit:     gcp.Int64Ptr(3),
+			DenyMaintenancePeriods: []*v1beta1.DenyMaintenancePeriod{
+				{
+					StartDate: "12-20",
+					EndDate:   "01-05",
+					Time:      gcp.StringPtr("00:00:00"),
+				},
+			},
+			InsightsConfig: &v1beta1.InsightsConfig{
+				QueryInsightsEnabled:  gcp.BoolPtr(true),
+				QueryPlansPerMinute:   gcp.Int64Ptr(5),
+				QueryStringLength:     gcp.Int64Ptr(1024),
+				RecordApplicationTags: gcp.BoolPtr(true),
+				RecordClientAddress:   gcp.BoolPtr(true),
+			},
+			PasswordValidationPolicy: &v1beta1.PasswordValidationPolicy{
+				EnablePasswordPolicy:      gcp.BoolPtr(true),
+				Complexity:                gcp.StringPtr("COMPLEXITY_DEFAULT"),
+				DisallowUsernameSubstring: gcp.BoolPtr(true),
+				MinLength:                 gcp.Int64Ptr(12),
+				PasswordChangeInterval:    gcp.StringPtr("86400s"),
+				ReuseInterval:             gcp.Int64Ptr(5),
+			},
+			SQLServerAuditConfig: &v1beta1.SQLServerAuditConfig{
+				Bucket:            gcp.StringPtr("gs://audit"),
+				RetentionInterval: gcp.StringPtr("604800s"),
+				UploadInterval:    gcp.StringPtr("3600s"),
+			},
 		},
 		DatabaseVersion:    gcp.StringPtr("3.2"),
 		MasterInstanceName: gcp.StringPtr("myFunnyMaster"),
@@ -95,7 +134,9 @@ func params(m ...func(*v1beta1.CloudSQLInstanceParameters)) *v1beta1.CloudSQLIns
 		OnPremisesConfiguration: &v1beta1.OnPremisesConfiguration{
 			HostPort: "3306",
 		},
-		ReplicaNames:     []string{"my-replica1", "and2"},
+		ReplicaConfiguration: &v1beta1.ReplicaConfiguration{
+			FailoverTarget: gcp.BoolPtr(true),
+		},
 		SuspensionReason: []string{"gotta play nice with others", "or go"},
 	}
 	for _, f := range m {
@@ -129,6 +170,12 @@ func observation(m ...func(*v1beta1.CloudSQLInstanceObservation)) *v1beta1.Cloud
 		State:                      "RUNNABLE",
 		SettingsVersion:            23142,
 		SelfLink:                   "/projects/crossplane-eats-the-cloud/database/test-sql",
+		MasterInstanceName:         "myFunnyMaster",
+		ReplicaNames:               []string{"my-replica1", "and2"},
+		Replication: &v1beta1.ReplicationStatus{
+			Enabled:        true,
+			FailoverTarget: true,
+		},
 	}
 	for _, f := range m {
 		f(o)
@@ -165,6 +212,8 @@ func db(m ...func(*sqladmin.DatabaseInstance)) *sqladmin.DatabaseInstance {
 				Location:                       "us-west1",
 				ReplicationLogArchivingEnabled: true,
 				StartTime:                      "20191018",
+				PointInTimeRecoveryEnabled:     true,
+				TransactionLogRetentionDays:    7,
 			},
 			IpConfiguration: &sqladmin.IpConfiguration{
 				AuthorizedNetworks: []*sqladmin.AclEntry{
@@ -189,6 +238,35 @@ func db(m ...func(*sqladmin.DatabaseInstance)) *sqladmin.DatabaseInstance {
 			DataDiskSizeGb:             2,
 			DatabaseReplicationEnabled: true,
 			StorageAutoResizeLimit:     3,
+			DenyMaintenancePeriods: []*sqladmin.DenyMaintenancePeriod{
+				{
+					StartDate: "12-20",
+					EndDate:   "01-05",
+					Time:      "00:00:00",
+				},
+			},
+			InsightsConfig: &sqladmin.InsightsConfig{
+				QueryInsightsEnabled:  true,
+				QueryPlansPerMinute:   5,
+				QueryStringLength:     1024,
+				RecordApplicationTags: true,
+				RecordClientAddress:   true,
+				ForceSendFields:       []string{"QueryInsightsEnabled", "RecordApplicationTags", "RecordClientAddress"},
+			},
+			PasswordValidationPolicy: &sqladmin.PasswordValidationPolicy{
+				EnablePasswordPolicy:      true,
+				Complexity:                "COMPLEXITY_DEFAULT",
+				DisallowUsernameSubstring: true,
+				MinLength:                 12,
+				PasswordChangeInterval:    "86400s",
+				ReuseInterval:             5,
+				ForceSendFields:           []string{"DisallowUsernameSubstring", "EnablePasswordPolicy"},
+			},
+			SqlServerAuditConfig: &sqladmin.SqlServerAuditConfig{
+				Bucket:            "gs://audit",
+				RetentionInterval: "604800s",
+				UploadInterval:    "3600s",
+			},
 		},
 		DatabaseVersion:    "3.2",
 		MasterInstanceName: "myFunnyMaster",
@@ -204,7 +282,9 @@ func db(m ...func(*sqladmin.DatabaseInstance)) *sqladmin.DatabaseInstance {
 		OnPremisesConfiguration: &sqladmin.OnPremisesConfiguration{
 			HostPort: "3306",
 		},
-		ReplicaNames:     []string{"my-replica1", "and2"},
+		ReplicaConfiguration: &sqladmin.ReplicaConfiguration{
+			FailoverTarget: true,
+		},
 		SuspensionReason: []string{"gotta play nice with others", "or go"},
 	}
 	for _, f := range m {
@@ -234,6 +314,7 @@ func addOutputFields(db *sqladmin.DatabaseInstance) {
 	}
 	db.Ipv6Address = "2.19sd920.2"
 	db.Project = "crossplane-eats-the-cloud"
+	db.ReplicaNames = []string{"my-replica1", "and2"}
 	db.SelfLink = "/projects/crossplane-eats-the-cloud/database/test-sql"
 	db.ServiceAccountEmailAddress = "john@dontparseme.com"
 	db.State = "RUNNABLE"
@@ -268,6 +349,18 @@ func TestGenerateDatabaseInstance(t *testing.T) {
 				db.GceZone = ""
 			})},
 		},
+		"PromoteReplica": {
+			args: args{
+				name: name,
+				params: *params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.PromoteReplica = gcp.BoolPtr(true)
+				})},
+			want: want{db: db(func(db *sqladmin.DatabaseInstance) {
+				db.InstanceType = ""
+				db.MasterInstanceName = ""
+				db.ReplicaConfiguration = nil
+			})},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -306,6 +399,20 @@ func TestLateInitializeSpec(t *testing.T) {
 				p.GceZone = gcp.StringPtr("us-different-2")
 			})},
 		},
+		"SettingsPolicies": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.Settings.BackupConfiguration.PointInTimeRecoveryEnabled = nil
+					p.Settings.BackupConfiguration.TransactionLogRetentionDays = nil
+					p.Settings.DenyMaintenancePeriods = nil
+					p.Settings.InsightsConfig = nil
+					p.Settings.PasswordValidationPolicy = nil
+					p.Settings.SQLServerAuditConfig = nil
+				}),
+				db: db(),
+			},
+			want: want{params: params()},
+		},
 		"AllFilledAlready": {
 			args: args{
 				params: params(),
@@ -354,6 +461,201 @@ func TestGenerateObservation(t *testing.T) {
 	}
 }
 
+func TestNeedsPromotion(t *testing.T) {
+	promote := func(p *v1beta1.CloudSQLInstanceParameters) { p.PromoteReplica = gcp.BoolPtr(true) }
+	promoted := func(db *sqladmin.DatabaseInstance) { db.MasterInstanceName = "" }
+	cases := map[string]struct {
+		params *v1beta1.CloudSQLInstanceParameters
+		db     *sqladmin.DatabaseInstance
+		want   bool
+	}{
+		"NotRequested": {
+			params: params(),
+			db:     db(),
+		},
+		"Requested": {
+			params: params(promote),
+			db:     db(),
+			want:   true,
+		},
+		"AlreadyPromoted": {
+			params: params(promote),
+			db:     db(promoted),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsPromotion(*tc.params, *tc.db)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsPromotion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetReplicaLag(t *testing.T) {
+	point := func(v float64) *monitoring.Point {
+		return &monitoring.Point{Value: &monitoring.TypedValue{DoubleValue: &v}}
+	}
+	cases := map[string]struct {
+		rsp  *monitoring.ListTimeSeriesResponse
+		want *int64
+	}{
+		"NoTimeSeries": {
+			rsp: &monitoring.ListTimeSeriesResponse{},
+		},
+		"MostRecentPoint": {
+			rsp: &monitoring.ListTimeSeriesResponse{
+				TimeSeries: []*monitoring.TimeSeries{
+					{Points: []*monitoring.Point{point(2.6), point(10)}},
+				},
+			},
+			want: gcp.Int64Ptr(3),
+		},
+		"NoPoints": {
+			rsp: &monitoring.ListTimeSeriesResponse{
+				TimeSeries: []*monitoring.TimeSeries{
+					{},
+					{Points: []*monitoring.Point{point(1)}},
+				},
+			},
+			want: gcp.Int64Ptr(1),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetReplicaLag(tc.rsp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetReplicaLag(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRestoreRequest(t *testing.T) {
+	type want struct {
+		req *sqladmin.InstancesRestoreBackupRequest
+		err bool
+	}
+	cases := map[string]struct {
+		in   v1beta1.InstanceSource
+		want want
+	}{
+		"Successful": {
+			in: v1beta1.InstanceSource{Instance: gcp.StringPtr("source"), BackupRunID: gcp.StringPtr("1234")},
+			want: want{req: &sqladmin.InstancesRestoreBackupRequest{RestoreBackupContext: &sqladmin.RestoreBackupContext{
+				BackupRunId: 1234,
+				InstanceId:  "source",
+				Project:     "project",
+			}}},
+		},
+		"InvalidBackupRunID": {
+			in:   v1beta1.InstanceSource{Instance: gcp.StringPtr("source"), BackupRunID: gcp.StringPtr("latest")},
+			want: want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			req, err := GenerateRestoreRequest("project", tc.in)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("GenerateRestoreRequest(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.req, req); diff != "" {
+				t.Errorf("GenerateRestoreRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSourceStatus(t *testing.T) {
+	type args struct {
+		in v1beta1.CloudSQLInstanceParameters
+		op *sqladmin.Operation
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta1.InstanceSourceStatus
+	}{
+		"NoSource": {},
+		"Clone": {
+			args: args{in: v1beta1.CloudSQLInstanceParameters{Source: &v1beta1.InstanceSource{
+				Instance:    gcp.StringPtr("source"),
+				PointInTime: gcp.StringPtr("2021-06-01T00:00:00Z"),
+			}}},
+			want: &v1beta1.InstanceSourceStatus{Instance: "source", PointInTime: "2021-06-01T00:00:00Z"},
+		},
+		"FailedRestore": {
+			args: args{
+				in: v1beta1.CloudSQLInstanceParameters{Source: &v1beta1.InstanceSource{
+					Instance:    gcp.StringPtr("source"),
+					BackupRunID: gcp.StringPtr("1234"),
+				}},
+				op: &sqladmin.Operation{
+					Name:   "op",
+					Status: "DONE",
+					Error: &sqladmin.OperationErrors{Errors: []*sqladmin.OperationError{
+						{Message: "boom"},
+						{Message: "bang"},
+					}},
+				},
+			},
+			want: &v1beta1.InstanceSourceStatus{
+				Instance:    "source",
+				BackupRunID: "1234",
+				Operation:   "op",
+				Status:      "DONE",
+				Error:       "boom; bang",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSourceStatus(tc.args.in, tc.args.op)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateSourceStatus(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateFinalExportRequest(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 30, 0, 0, time.UTC)
+	in := v1beta1.FinalBackup{Bucket: gcp.StringPtr("bucket"), Databases: []string{"db"}}
+	want := &sqladmin.InstancesExportRequest{ExportContext: &sqladmin.ExportContext{
+		FileType:  "SQL",
+		Uri:       "gs://bucket/test-sql-final-20210601T123000Z.sql.gz",
+		Databases: []string{"db"},
+	}}
+	got := GenerateFinalExportRequest("test-sql", in, now)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateFinalExportRequest(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestFinalBackupName(t *testing.T) {
+	cases := map[string]struct {
+		op   *sqladmin.Operation
+		want string
+	}{
+		"Export": {
+			op:   &sqladmin.Operation{Name: "op", ExportContext: &sqladmin.ExportContext{Uri: "gs://bucket/test-sql.sql.gz"}},
+			want: "gs://bucket/test-sql.sql.gz",
+		},
+		"Unknown": {
+			op:   &sqladmin.Operation{Name: "op"},
+			want: "op",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FinalBackupName(tc.op)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FinalBackupName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestDatabaseUserName(t *testing.T) {
 	p := v1beta1.CloudSQLInstanceParameters{
 		DatabaseVersion: gcp.StringPtr("POSTGRES_3.2"),
@@ -367,6 +669,25 @@ func TestDatabaseUserName(t *testing.T) {
 	}
 }
 
+func TestDatabaseUserHost(t *testing.T) {
+	cases := map[string]struct {
+		version *string
+		want    string
+	}{
+		"Default":  {want: v1beta1.MysqlDefaultUserHost},
+		"MySQL":    {version: gcp.StringPtr("MYSQL_8_0"), want: v1beta1.MysqlDefaultUserHost},
+		"Postgres": {version: gcp.StringPtr("POSTGRES_14"), want: ""},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DatabaseUserHost(v1beta1.CloudSQLInstanceParameters{DatabaseVersion: tc.version})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DatabaseUserHost(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetServerCACertificate(t *testing.T) {
 	cert := &sqladmin.SslCert{
 		Cert:             "my-cert",
@@ -449,6 +770,30 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: true, isErr: false},
 		},
+		"PromotedReplica": {
+			args: args{
+				params: params(func(p *v1beta1.CloudSQLInstanceParameters) {
+					p.PromoteReplica = gcp.BoolPtr(true)
+				}),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.InstanceType = v1beta1.InstanceTypeCloudSQL
+					db.MasterInstanceName = ""
+					db.ReplicaConfiguration = nil
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
+		"IsUpToDateWithoutForceSendFields": {
+			args: args{
+				params: params(),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.IpConfiguration.ForceSendFields = nil
+					db.Settings.InsightsConfig.ForceSendFields = nil
+					db.Settings.PasswordValidationPolicy.ForceSendFields = nil
+				}),
+			},
+			want: want{upToDate: true, isErr: false},
+		},
 		"NeedsUpdate": {
 			args: args{
 				params: params(),
@@ -458,6 +803,15 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: want{upToDate: false, isErr: false},
 		},
+		"DenyMaintenancePeriodChanged": {
+			args: args{
+				params: params(),
+				db: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.DenyMaintenancePeriods[0].EndDate = "01-10"
+				}),
+			},
+			want: want{upToDate: false, isErr: false},
+		},
 	}
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
@@ -471,3 +825,316 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetChanges(t *testing.T) {
+	type args struct {
+		desired      *sqladmin.DatabaseInstance
+		observed     *sqladmin.DatabaseInstance
+		restartFlags map[string]bool
+	}
+	cases := map[string]struct {
+		args args
+		want []Change
+	}{
+		"NoChanges": {
+			args: args{
+				desired:  db(),
+				observed: db(),
+			},
+		},
+		"SettingsChanges": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.Tier = "db-new"
+					db.Settings.UserLabels = map[string]string{"new": "label"}
+				}),
+				observed: db(),
+			},
+			want: []Change{
+				{Path: "settings.tier", RequiresRestart: true},
+				{Path: "settings.userLabels"},
+			},
+		},
+		"PrivateNetworkChanged": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.IpConfiguration = &sqladmin.IpConfiguration{PrivateNetwork: "new-network"}
+				}),
+				observed: db(),
+			},
+			want: []Change{{Path: "settings.ipConfiguration", RequiresRestart: true}},
+		},
+		"QueryStringLengthChanged": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.InsightsConfig.QueryStringLength = 4500
+				}),
+				observed: db(),
+			},
+			want: []Change{{Path: "settings.insightsConfig", RequiresRestart: true}},
+		},
+		"InsightsDisabled": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.InsightsConfig.QueryInsightsEnabled = false
+				}),
+				observed: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.InsightsConfig.ForceSendFields = nil
+				}),
+			},
+			want: []Change{{Path: "settings.insightsConfig"}},
+		},
+		"RestartFlagChanged": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.DatabaseFlags = []*sqladmin.DatabaseFlags{{Name: "max_connections", Value: "100"}}
+				}),
+				observed:     db(),
+				restartFlags: map[string]bool{"max_connections": true},
+			},
+			want: []Change{{Path: "settings.databaseFlags", RequiresRestart: true}},
+		},
+		"FlagChanged": {
+			args: args{
+				desired: db(func(db *sqladmin.DatabaseInstance) {
+					db.Settings.DatabaseFlags = []*sqladmin.DatabaseFlags{{Name: "log_connections", Value: "on"}}
+				}),
+				observed:     db(),
+				restartFlags: map[string]bool{"max_connections": true},
+			},
+			want: []Change{{Path: "settings.databaseFlags"}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetChanges(tc.args.desired, tc.args.observed, tc.args.restartFlags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetChanges(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterChanges(t *testing.T) {
+	// Monday, 2 November 2020 at 04:30 UTC.
+	now := time.Date(2020, time.November, 2, 4, 30, 0, 0, time.UTC)
+	changes := []Change{
+		{Path: "settings.tier", RequiresRestart: true},
+		{Path: "settings.userLabels"},
+	}
+
+	type args struct {
+		policy *string
+		window *sqladmin.MaintenanceWindow
+	}
+	type want struct {
+		apply   []Change
+		pending []string
+	}
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NoPolicy": {
+			want: want{apply: changes},
+		},
+		"Immediate": {
+			args: args{policy: gcp.StringPtr(v1beta1.RestartPolicyImmediate)},
+			want: want{apply: changes},
+		},
+		"Block": {
+			args: args{policy: gcp.StringPtr(v1beta1.RestartPolicyBlock)},
+			want: want{
+				apply:   []Change{{Path: "settings.userLabels"}},
+				pending: []string{"settings.tier"},
+			},
+		},
+		"OutsideMaintenanceWindow": {
+			args: args{
+				policy: gcp.StringPtr(v1beta1.RestartPolicyMaintenanceWindow),
+				window: &sqladmin.MaintenanceWindow{Day: 1, Hour: 5},
+			},
+			want: want{
+				apply:   []Change{{Path: "settings.userLabels"}},
+				pending: []string{"settings.tier"},
+			},
+		},
+		"InMaintenanceWindow": {
+			args: args{
+				policy: gcp.StringPtr(v1beta1.RestartPolicyMaintenanceWindow),
+				window: &sqladmin.MaintenanceWindow{Day: 1, Hour: 4},
+			},
+			want: want{apply: changes},
+		},
+		"NoMaintenanceWindow": {
+			args: args{policy: gcp.StringPtr(v1beta1.RestartPolicyMaintenanceWindow)},
+			want: want{apply: changes},
+		},
+		"NoMaintenanceWindowDay": {
+			args: args{
+				policy: gcp.StringPtr(v1beta1.RestartPolicyMaintenanceWindow),
+				window: &sqladmin.MaintenanceWindow{Hour: 5},
+			},
+			want: want{apply: changes},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			apply, pending := FilterChanges(tc.args.policy, tc.args.window, changes, now)
+			if diff := cmp.Diff(tc.want.apply, apply); diff != "" {
+				t.Errorf("FilterChanges(...): -want apply, +got apply:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pending, pending); diff != "" {
+				t.Errorf("FilterChanges(...): -want pending, +got pending:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInMaintenanceWindow(t *testing.T) {
+	// Sunday, 8 November 2020 at 23:59 UTC.
+	now := time.Date(2020, time.November, 8, 23, 59, 0, 0, time.UTC)
+	cases := map[string]struct {
+		window *sqladmin.MaintenanceWindow
+		want   bool
+	}{
+		"NoWindow": {},
+		"AnyDay": {
+			window: &sqladmin.MaintenanceWindow{Hour: 23},
+		},
+		"InWindow": {
+			window: &sqladmin.MaintenanceWindow{Day: 7, Hour: 23},
+			want:   true,
+		},
+		"WrongDay": {
+			window: &sqladmin.MaintenanceWindow{Day: 1, Hour: 23},
+		},
+		"WrongHour": {
+			window: &sqladmin.MaintenanceWindow{Day: 7, Hour: 22},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := InMaintenanceWindow(tc.window, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("InMaintenanceWindow(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGeneratePatch(t *testing.T) {
+	desired := db(func(db *sqladmin.DatabaseInstance) {
+		db.Settings.SettingsVersion = 3
+		db.Settings.Tier = "db-new"
+		db.MaxDiskSize = 20
+	})
+	cases := map[string]struct {
+		changes []Change
+		want    *sqladmin.DatabaseInstance
+	}{
+		"NoChanges": {
+			want: &sqladmin.DatabaseInstance{},
+		},
+		"SettingsChange": {
+			changes: []Change{{Path: "settings.tier", RequiresRestart: true}},
+			want: &sqladmin.DatabaseInstance{
+				Settings: &sqladmin.Settings{Tier: "db-new", SettingsVersion: 3},
+			},
+		},
+		"InstanceChange": {
+			changes: []Change{{Path: "maxDiskSize"}},
+			want:    &sqladmin.DatabaseInstance{MaxDiskSize: 20},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePatch(desired, tc.changes)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GeneratePatch(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetPassword(t *testing.T) {
+	errBoom := errors.New("boom")
+	in := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+		Key:             "password",
+	}
+	secret := func(desired string) test.MockGetFn {
+		return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.(*corev1.Secret).Data = map[string][]byte{in.Key: []byte(desired)}
+			return nil
+		}
+	}
+	applied := func(pw string) *v1beta1.CloudSQLUser {
+		u := &v1beta1.CloudSQLUser{ObjectMeta: metav1.ObjectMeta{UID: "cool-uid"}}
+		SetPasswordHash(u, pw)
+		return u
+	}
+
+	type want struct {
+		pw      string
+		changed bool
+		err     error
+	}
+	cases := map[string]struct {
+		kube client.Client
+		in   *xpv1.SecretKeySelector
+		o    metav1.Object
+		want want
+	}{
+		"NoReference": {},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			in:   in,
+			o:    applied("old"),
+			want: want{err: errors.Wrap(errBoom, errGetPasswordSecret)},
+		},
+		"NoObject": {
+			kube: &test.MockClient{MockGet: secret("new")},
+			in:   in,
+			want: want{pw: "new"},
+		},
+		"NotYetApplied": {
+			kube: &test.MockClient{MockGet: secret("new")},
+			in:   in,
+			o:    &v1beta1.CloudSQLUser{},
+			want: want{pw: "new", changed: true},
+		},
+		"Changed": {
+			kube: &test.MockClient{MockGet: secret("new")},
+			in:   in,
+			o:    applied("old"),
+			want: want{pw: "new", changed: true},
+		},
+		"Unchanged": {
+			kube: &test.MockClient{MockGet: secret("old")},
+			in:   in,
+			o:    applied("old"),
+			want: want{pw: "old"},
+		},
+		"Empty": {
+			kube: &test.MockClient{MockGet: secret("")},
+			in:   in,
+			o:    applied("old"),
+			want: want{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pw, changed, err := GetPassword(context.Background(), tc.kube, tc.in, tc.o)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetPassword(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pw, pw); diff != "" {
+				t.Errorf("GetPassword(...): -want password, +got password:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("GetPassword(...): -want changed, +got changed:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlbackuprun
+
+import (
+	"time"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateBackupRun generates *sqladmin.BackupRun instance from
+// CloudSQLBackupRunParameters.
+func GenerateBackupRun(in v1beta1.CloudSQLBackupRunParameters) *sqladmin.BackupRun {
+	return &sqladmin.BackupRun{
+		Description: gcp.StringValue(in.Description),
+		Location:    gcp.StringValue(in.Location),
+	}
+}
+
+// GenerateObservation produces CloudSQLBackupRunObservation object from
+// sqladmin.BackupRun object.
+func GenerateObservation(in v1beta1.CloudSQLBackupRunParameters, run sqladmin.BackupRun) v1beta1.CloudSQLBackupRunObservation {
+	o := v1beta1.CloudSQLBackupRunObservation{
+		Instance:     run.Instance,
+		Status:       run.Status,
+		Type:         run.Type,
+		BackupKind:   run.BackupKind,
+		Location:     run.Location,
+		EnqueuedTime: run.EnqueuedTime,
+		StartTime:    run.StartTime,
+		EndTime:      run.EndTime,
+		SelfLink:     run.SelfLink,
+	}
+	if run.Error != nil {
+		o.Error = run.Error.Message
+	}
+	if end, err := time.Parse(time.RFC3339, run.EndTime); err == nil && in.RetentionDays != nil {
+		o.ExpirationTime = end.Add(time.Duration(*in.RetentionDays) * 24 * time.Hour).Format(time.RFC3339)
+	}
+	return o
+}
+
+// IsExpired returns true if the backup run of the supplied observation has
+// reached the end of its retention period at the supplied time. Backup runs
+// without an expiration time never expire.
+func IsExpired(o v1beta1.CloudSQLBackupRunObservation, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, o.ExpirationTime)
+	if err != nil {
+		return false
+	}
+	return !now.Before(exp)
+}
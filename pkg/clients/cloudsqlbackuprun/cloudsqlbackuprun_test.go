@@ -0,0 +1,129 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlbackuprun
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	run := func(m ...func(*sqladmin.BackupRun)) sqladmin.BackupRun {
+		r := sqladmin.BackupRun{
+			Instance:     "test-sql",
+			Status:       v1beta1.BackupRunStatusSuccessful,
+			Type:         "ON_DEMAND",
+			BackupKind:   "SNAPSHOT",
+			Location:     "us",
+			EnqueuedTime: "2021-06-01T00:00:00Z",
+			StartTime:    "2021-06-01T00:01:00Z",
+			EndTime:      "2021-06-01T00:10:00Z",
+			SelfLink:     "/projects/test/instances/test-sql/backupRuns/1",
+		}
+		for _, f := range m {
+			f(&r)
+		}
+		return r
+	}
+	obs := func(m ...func(*v1beta1.CloudSQLBackupRunObservation)) v1beta1.CloudSQLBackupRunObservation {
+		o := v1beta1.CloudSQLBackupRunObservation{
+			Instance:     "test-sql",
+			Status:       v1beta1.BackupRunStatusSuccessful,
+			Type:         "ON_DEMAND",
+			BackupKind:   "SNAPSHOT",
+			Location:     "us",
+			EnqueuedTime: "2021-06-01T00:00:00Z",
+			StartTime:    "2021-06-01T00:01:00Z",
+			EndTime:      "2021-06-01T00:10:00Z",
+			SelfLink:     "/projects/test/instances/test-sql/backupRuns/1",
+		}
+		for _, f := range m {
+			f(&o)
+		}
+		return o
+	}
+
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLBackupRunParameters
+		run  sqladmin.BackupRun
+		want v1beta1.CloudSQLBackupRunObservation
+	}{
+		"Successful": {
+			run:  run(),
+			want: obs(),
+		},
+		"Retention": {
+			in:   v1beta1.CloudSQLBackupRunParameters{RetentionDays: gcp.Int64Ptr(7)},
+			run:  run(),
+			want: obs(func(o *v1beta1.CloudSQLBackupRunObservation) { o.ExpirationTime = "2021-06-08T00:10:00Z" }),
+		},
+		"RetentionNotCompleted": {
+			in:   v1beta1.CloudSQLBackupRunParameters{RetentionDays: gcp.Int64Ptr(7)},
+			run:  run(func(r *sqladmin.BackupRun) { r.Status, r.EndTime = "RUNNING", "" }),
+			want: obs(func(o *v1beta1.CloudSQLBackupRunObservation) { o.Status, o.EndTime = "RUNNING", "" }),
+		},
+		"Failed": {
+			run: run(func(r *sqladmin.BackupRun) {
+				r.Status = v1beta1.BackupRunStatusFailed
+				r.Error = &sqladmin.OperationError{Message: "boom"}
+			}),
+			want: obs(func(o *v1beta1.CloudSQLBackupRunObservation) {
+				o.Status = v1beta1.BackupRunStatusFailed
+				o.Error = "boom"
+			}),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateObservation(tc.in, tc.run)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	now := time.Date(2021, 6, 8, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		exp  string
+		want bool
+	}{
+		"NoRetention": {},
+		"NotExpired": {
+			exp: "2021-06-08T00:10:00Z",
+		},
+		"Expired": {
+			exp:  "2021-06-07T00:10:00Z",
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsExpired(v1beta1.CloudSQLBackupRunObservation{ExpirationTime: tc.exp}, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsExpired(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
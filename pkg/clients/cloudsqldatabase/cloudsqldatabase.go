@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqldatabase
+
+import (
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateDatabase generates *sqladmin.Database instance from CloudSQLDatabaseParameters.
+func GenerateDatabase(name string, in v1beta1.CloudSQLDatabaseParameters, db *sqladmin.Database) {
+	db.Name = name
+	db.Instance = gcp.StringValue(in.Instance)
+	db.Charset = gcp.StringValue(in.Charset)
+	db.Collation = gcp.StringValue(in.Collation)
+}
+
+// GenerateObservation produces CloudSQLDatabaseObservation object from *sqladmin.Database object.
+func GenerateObservation(in sqladmin.Database) v1beta1.CloudSQLDatabaseObservation {
+	return v1beta1.CloudSQLDatabaseObservation{
+		SelfLink: in.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in sqladmin.Database object.
+func LateInitializeSpec(spec *v1beta1.CloudSQLDatabaseParameters, in sqladmin.Database) {
+	spec.Charset = gcp.LateInitializeString(spec.Charset, in.Charset)
+	spec.Collation = gcp.LateInitializeString(spec.Collation, in.Collation)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1beta1.CloudSQLDatabaseParameters, observed sqladmin.Database) bool {
+	if in.Charset != nil && *in.Charset != observed.Charset {
+		return false
+	}
+	if in.Collation != nil && *in.Collation != observed.Collation {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqldatabase
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	name      = "test-database"
+	instance  = "test-sql"
+	charset   = "utf8mb4"
+	collation = "utf8mb4_general_ci"
+)
+
+func params(m ...func(*v1beta1.CloudSQLDatabaseParameters)) *v1beta1.CloudSQLDatabaseParameters {
+	p := &v1beta1.CloudSQLDatabaseParameters{
+		Instance:  gcp.StringPtr(instance),
+		Charset:   gcp.StringPtr(charset),
+		Collation: gcp.StringPtr(collation),
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func db(m ...func(*sqladmin.Database)) *sqladmin.Database {
+	d := &sqladmin.Database{
+		Name:      name,
+		Instance:  instance,
+		Charset:   charset,
+		Collation: collation,
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestGenerateDatabase(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1beta1.CloudSQLDatabaseParameters
+		want *sqladmin.Database
+	}{
+		"Full": {
+			in:   params(),
+			want: db(),
+		},
+		"NoCharset": {
+			in:   params(func(p *v1beta1.CloudSQLDatabaseParameters) { p.Charset = nil }),
+			want: db(func(d *sqladmin.Database) { d.Charset = "" }),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &sqladmin.Database{}
+			GenerateDatabase(tc.want.Name, *tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateDatabase(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1beta1.CloudSQLDatabaseParameters
+		in   *sqladmin.Database
+		want *v1beta1.CloudSQLDatabaseParameters
+	}{
+		"AllFilled": {
+			spec: params(),
+			in:   db(func(d *sqladmin.Database) { d.Charset = "latin1" }),
+			want: params(),
+		},
+		"Empty": {
+			spec: params(func(p *v1beta1.CloudSQLDatabaseParameters) {
+				p.Charset = nil
+				p.Collation = nil
+			}),
+			in:   db(),
+			want: params(),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, *tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1beta1.CloudSQLDatabaseParameters
+		observed *sqladmin.Database
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: db(),
+			want:     true,
+		},
+		"Unset": {
+			in:       &v1beta1.CloudSQLDatabaseParameters{},
+			observed: db(),
+			want:     true,
+		},
+		"CharsetChanged": {
+			in:       params(func(p *v1beta1.CloudSQLDatabaseParameters) { p.Charset = gcp.StringPtr("latin1") }),
+			observed: db(),
+			want:     false,
+		},
+		"CollationChanged": {
+			in:       params(func(p *v1beta1.CloudSQLDatabaseParameters) { p.Collation = gcp.StringPtr("utf8mb4_bin") }),
+			observed: db(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, *tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
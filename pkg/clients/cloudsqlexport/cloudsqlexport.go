@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlexport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+)
+
+// GenerateExportRequest generates *sqladmin.InstancesExportRequest instance
+// from CloudSQLExportParameters.
+func GenerateExportRequest(in v1beta1.CloudSQLExportParameters) *sqladmin.InstancesExportRequest {
+	ctx := &sqladmin.ExportContext{
+		Uri:       fmt.Sprintf("gs://%s/%s", gcp.StringValue(in.Bucket), in.Object),
+		FileType:  v1beta1.FileTypeSQL,
+		Databases: in.Databases,
+		Offload:   gcp.BoolValue(in.Offload),
+	}
+	if in.FileType != nil {
+		ctx.FileType = *in.FileType
+	}
+	if in.CSVExportOptions != nil {
+		ctx.CsvExportOptions = &sqladmin.ExportContextCsvExportOptions{
+			SelectQuery: in.CSVExportOptions.SelectQuery,
+		}
+	}
+	return &sqladmin.InstancesExportRequest{ExportContext: ctx}
+}
+
+// GenerateObservation produces CloudSQLExportObservation object from the
+// supplied export operation.
+func GenerateObservation(op sqladmin.Operation) v1beta1.CloudSQLExportObservation {
+	o := v1beta1.CloudSQLExportObservation{
+		Status:    op.Status,
+		Error:     cloudsql.OperationError(&op),
+		StartTime: op.StartTime,
+		EndTime:   op.EndTime,
+		SelfLink:  op.SelfLink,
+	}
+	if op.ExportContext != nil {
+		o.URI = op.ExportContext.Uri
+	}
+	return o
+}
+
+// Hash returns a hash of the export described by the supplied parameters. It
+// is recorded when the export is started so that later changes to the
+// parameters can be detected without relying on Cloud SQL to echo back an
+// identical ExportContext, or to remember the operation at all.
+func Hash(in v1beta1.CloudSQLExportParameters) string {
+	// Marshalling structs of strings, booleans and slices cannot fail.
+	b, _ := json.Marshal(struct {
+		Instance string
+		Context  *sqladmin.ExportContext
+	}{
+		Instance: gcp.StringValue(in.Instance),
+		Context:  GenerateExportRequest(in).ExportContext,
+	})
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// IsUpToDate returns true if the export whose parameters hashed to the
+// supplied hash is the export described by the supplied parameters.
+func IsUpToDate(in v1beta1.CloudSQLExportParameters, hash string) bool {
+	return Hash(in) == hash
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlexport
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1beta1.CloudSQLExportParameters)) v1beta1.CloudSQLExportParameters {
+	p := v1beta1.CloudSQLExportParameters{
+		Instance:  gcp.StringPtr("test-sql"),
+		Bucket:    gcp.StringPtr("bucket"),
+		Object:    "exports/nightly.sql.gz",
+		Databases: []string{"db"},
+		Offload:   gcp.BoolPtr(true),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func exportContext(m ...func(*sqladmin.ExportContext)) *sqladmin.ExportContext {
+	c := &sqladmin.ExportContext{
+		Uri:       "gs://bucket/exports/nightly.sql.gz",
+		FileType:  v1beta1.FileTypeSQL,
+		Databases: []string{"db"},
+		Offload:   true,
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateExportRequest(t *testing.T) {
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLExportParameters
+		want *sqladmin.InstancesExportRequest
+	}{
+		"DefaultsToSQL": {
+			in:   params(),
+			want: &sqladmin.InstancesExportRequest{ExportContext: exportContext()},
+		},
+		"CSV": {
+			in: params(func(p *v1beta1.CloudSQLExportParameters) {
+				p.Object = "exports/users.csv"
+				p.FileType = gcp.StringPtr(v1beta1.FileTypeCSV)
+				p.Offload = nil
+				p.CSVExportOptions = &v1beta1.CSVExportOptions{SelectQuery: "SELECT * FROM users"}
+			}),
+			want: &sqladmin.InstancesExportRequest{ExportContext: &sqladmin.ExportContext{
+				Uri:              "gs://bucket/exports/users.csv",
+				FileType:         v1beta1.FileTypeCSV,
+				Databases:        []string{"db"},
+				CsvExportOptions: &sqladmin.ExportContextCsvExportOptions{SelectQuery: "SELECT * FROM users"},
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateExportRequest(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateExportRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	hash := Hash(params())
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLExportParameters
+		hash string
+		want bool
+	}{
+		"UpToDate": {
+			in:   params(),
+			hash: hash,
+			want: true,
+		},
+		"DatabasesChanged": {
+			in:   params(func(p *v1beta1.CloudSQLExportParameters) { p.Databases = []string{"db", "other"} }),
+			hash: hash,
+			want: false,
+		},
+		"OffloadChanged": {
+			in:   params(func(p *v1beta1.CloudSQLExportParameters) { p.Offload = nil }),
+			hash: hash,
+			want: false,
+		},
+		"InstanceChanged": {
+			in:   params(func(p *v1beta1.CloudSQLExportParameters) { p.Instance = gcp.StringPtr("other-sql") }),
+			hash: hash,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.hash)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
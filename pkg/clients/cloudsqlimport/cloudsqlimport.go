@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlimport
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+)
+
+// GenerateImportRequest generates *sqladmin.InstancesImportRequest instance
+// from CloudSQLImportParameters.
+func GenerateImportRequest(in v1beta1.CloudSQLImportParameters) *sqladmin.InstancesImportRequest {
+	ctx := &sqladmin.ImportContext{
+		Uri:        fmt.Sprintf("gs://%s/%s", gcp.StringValue(in.Bucket), in.Object),
+		FileType:   v1beta1.FileTypeSQL,
+		Database:   gcp.StringValue(in.Database),
+		ImportUser: gcp.StringValue(in.ImportUser),
+	}
+	if in.FileType != nil {
+		ctx.FileType = *in.FileType
+	}
+	if in.CSVImportOptions != nil {
+		ctx.CsvImportOptions = &sqladmin.ImportContextCsvImportOptions{
+			Table:   in.CSVImportOptions.Table,
+			Columns: in.CSVImportOptions.Columns,
+		}
+	}
+	return &sqladmin.InstancesImportRequest{ImportContext: ctx}
+}
+
+// GenerateObservation produces CloudSQLImportObservation object from the
+// supplied import operation.
+func GenerateObservation(op sqladmin.Operation) v1beta1.CloudSQLImportObservation {
+	o := v1beta1.CloudSQLImportObservation{
+		Status:    op.Status,
+		Error:     cloudsql.OperationError(&op),
+		StartTime: op.StartTime,
+		EndTime:   op.EndTime,
+		SelfLink:  op.SelfLink,
+	}
+	if op.ImportContext != nil {
+		o.URI = op.ImportContext.Uri
+	}
+	return o
+}
+
+// Hash returns a hash of the import described by the supplied parameters. It
+// is recorded when the import is started so that later changes to the
+// parameters can be detected without relying on Cloud SQL to echo back an
+// identical ImportContext, or to remember the operation at all.
+func Hash(in v1beta1.CloudSQLImportParameters) string {
+	// Marshalling structs of strings, booleans and slices cannot fail.
+	b, _ := json.Marshal(struct {
+		Instance string
+		Context  *sqladmin.ImportContext
+	}{
+		Instance: gcp.StringValue(in.Instance),
+		Context:  GenerateImportRequest(in).ImportContext,
+	})
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// IsUpToDate returns true if the import whose parameters hashed to the
+// supplied hash is the import described by the supplied parameters.
+func IsUpToDate(in v1beta1.CloudSQLImportParameters, hash string) bool {
+	return Hash(in) == hash
+}
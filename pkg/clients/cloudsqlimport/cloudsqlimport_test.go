@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlimport
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func params(m ...func(*v1beta1.CloudSQLImportParameters)) v1beta1.CloudSQLImportParameters {
+	p := v1beta1.CloudSQLImportParameters{
+		Instance: gcp.StringPtr("test-sql"),
+		Bucket:   gcp.StringPtr("bucket"),
+		Object:   "dumps/users.csv",
+		FileType: gcp.StringPtr(v1beta1.FileTypeCSV),
+		Database: gcp.StringPtr("db"),
+		CSVImportOptions: &v1beta1.CSVImportOptions{
+			Table:   "users",
+			Columns: []string{"id", "name"},
+		},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func importContext(m ...func(*sqladmin.ImportContext)) *sqladmin.ImportContext {
+	c := &sqladmin.ImportContext{
+		Uri:      "gs://bucket/dumps/users.csv",
+		FileType: v1beta1.FileTypeCSV,
+		Database: "db",
+		CsvImportOptions: &sqladmin.ImportContextCsvImportOptions{
+			Table:   "users",
+			Columns: []string{"id", "name"},
+		},
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGenerateImportRequest(t *testing.T) {
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLImportParameters
+		want *sqladmin.InstancesImportRequest
+	}{
+		"CSV": {
+			in:   params(),
+			want: &sqladmin.InstancesImportRequest{ImportContext: importContext()},
+		},
+		"DefaultsToSQL": {
+			in: params(func(p *v1beta1.CloudSQLImportParameters) {
+				p.Object = "dumps/prod.sql.gz"
+				p.FileType = nil
+				p.CSVImportOptions = nil
+				p.ImportUser = gcp.StringPtr("postgres")
+			}),
+			want: &sqladmin.InstancesImportRequest{ImportContext: &sqladmin.ImportContext{
+				Uri:        "gs://bucket/dumps/prod.sql.gz",
+				FileType:   v1beta1.FileTypeSQL,
+				Database:   "db",
+				ImportUser: "postgres",
+			}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateImportRequest(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateImportRequest(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	op := sqladmin.Operation{
+		Status:        "DONE",
+		StartTime:     "2021-06-01T00:00:00Z",
+		EndTime:       "2021-06-01T00:10:00Z",
+		SelfLink:      "https://sqladmin.googleapis.com/sql/v1beta4/projects/p/operations/op",
+		ImportContext: importContext(),
+		Error: &sqladmin.OperationErrors{Errors: []*sqladmin.OperationError{
+			{Message: "boom"},
+		}},
+	}
+	want := v1beta1.CloudSQLImportObservation{
+		URI:       "gs://bucket/dumps/users.csv",
+		Status:    "DONE",
+		Error:     "boom",
+		StartTime: "2021-06-01T00:00:00Z",
+		EndTime:   "2021-06-01T00:10:00Z",
+		SelfLink:  "https://sqladmin.googleapis.com/sql/v1beta4/projects/p/operations/op",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(op)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	hash := Hash(params())
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLImportParameters
+		hash string
+		want bool
+	}{
+		"UpToDate": {
+			in:   params(),
+			hash: hash,
+			want: true,
+		},
+		"InstanceChanged": {
+			in:   params(func(p *v1beta1.CloudSQLImportParameters) { p.Instance = gcp.StringPtr("other-sql") }),
+			hash: hash,
+			want: false,
+		},
+		"ObjectChanged": {
+			in:   params(func(p *v1beta1.CloudSQLImportParameters) { p.Object = "dumps/other.csv" }),
+			hash: hash,
+			want: false,
+		},
+		"ColumnsChanged": {
+			in:   params(func(p *v1beta1.CloudSQLImportParameters) { p.CSVImportOptions.Columns = nil }),
+			hash: hash,
+			want: false,
+		},
+		"DefaultFileType": {
+			in:   params(func(p *v1beta1.CloudSQLImportParameters) { p.FileType = nil }),
+			hash: Hash(params(func(p *v1beta1.CloudSQLImportParameters) { p.FileType = gcp.StringPtr(v1beta1.FileTypeSQL) })),
+			want: true,
+		},
+		"NoHash": {
+			in:   params(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.hash)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlsslcert
+
+import (
+	"fmt"
+	"time"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+)
+
+// DefaultRenewBeforeDays is the number of days before its expiration at
+// which a certificate is renewed if RenewBeforeDays is not set.
+const DefaultRenewBeforeDays = 30
+
+// GenerateInsertRequest generates *sqladmin.SslCertsInsertRequest instance from
+// CloudSQLSSLCertParameters.
+func GenerateInsertRequest(in v1beta1.CloudSQLSSLCertParameters) *sqladmin.SslCertsInsertRequest {
+	return &sqladmin.SslCertsInsertRequest{CommonName: in.CommonName}
+}
+
+// GenerateRenewalRequest generates the *sqladmin.SslCertsInsertRequest that
+// renews a certificate at the supplied time. The common name of a certificate
+// must be unique within its instance, so it is suffixed with the Unix time of
+// the renewal.
+func GenerateRenewalRequest(in v1beta1.CloudSQLSSLCertParameters, now time.Time) *sqladmin.SslCertsInsertRequest {
+	return &sqladmin.SslCertsInsertRequest{CommonName: fmt.Sprintf("%s-%d", in.CommonName, now.Unix())}
+}
+
+// GenerateObservation produces CloudSQLSSLCertObservation object from
+// sqladmin.SslCert object.
+func GenerateObservation(in sqladmin.SslCert) v1beta1.CloudSQLSSLCertObservation {
+	return v1beta1.CloudSQLSSLCertObservation{
+		CertSerialNumber: in.CertSerialNumber,
+		CreateTime:       in.CreateTime,
+		ExpirationTime:   in.ExpirationTime,
+		SelfLink:         in.SelfLink,
+		Sha1Fingerprint:  in.Sha1Fingerprint,
+	}
+}
+
+// NeedsRenewal returns true if the supplied certificate expires within the
+// renewal window of the supplied parameters at the supplied time. A
+// certificate whose expiration time cannot be parsed is never renewed.
+func NeedsRenewal(in v1beta1.CloudSQLSSLCertParameters, cert sqladmin.SslCert, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, cert.ExpirationTime)
+	if err != nil {
+		return false
+	}
+	days := int64(DefaultRenewBeforeDays)
+	if in.RenewBeforeDays != nil {
+		days = *in.RenewBeforeDays
+	}
+	return !now.Add(time.Duration(days) * 24 * time.Hour).Before(exp)
+}
+
+// GetConnectionDetails returns the client certificate and private key of the
+// supplied insert response along with the server CA certificate in a form
+// that can be embedded directly into a connection secret. The private key is
+// only returned when a certificate is inserted.
+func GetConnectionDetails(in sqladmin.SslCertsInsertResponse) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if in.ClientCert != nil {
+		cd[v1beta1.CloudSQLSecretClientPrivateKeyKey] = []byte(in.ClientCert.CertPrivateKey)
+		if in.ClientCert.CertInfo != nil {
+			cd[v1beta1.CloudSQLSecretClientCertificateKey] = []byte(in.ClientCert.CertInfo.Cert)
+		}
+	}
+	for k, v := range cloudsql.GetServerCACertificate(sqladmin.DatabaseInstance{ServerCaCert: in.ServerCaCert}) {
+		cd[k] = v
+	}
+	return cd
+}
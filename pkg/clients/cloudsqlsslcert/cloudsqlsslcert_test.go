@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqlsslcert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestNeedsRenewal(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		in   v1beta1.CloudSQLSSLCertParameters
+		exp  string
+		want bool
+	}{
+		"NotExpiring": {
+			exp:  "2031-06-01T00:00:00Z",
+			want: false,
+		},
+		"WithinDefaultWindow": {
+			exp:  "2021-06-20T00:00:00Z",
+			want: true,
+		},
+		"OutsideCustomWindow": {
+			in:   v1beta1.CloudSQLSSLCertParameters{RenewBeforeDays: gcp.Int64Ptr(7)},
+			exp:  "2021-06-20T00:00:00Z",
+			want: false,
+		},
+		"Expired": {
+			exp:  "2021-05-01T00:00:00Z",
+			want: true,
+		},
+		"Unparseable": {
+			exp:  "soon",
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NeedsRenewal(tc.in, sqladmin.SslCert{ExpirationTime: tc.exp}, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("NeedsRenewal(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateRenewalRequest(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	want := &sqladmin.SslCertsInsertRequest{CommonName: "client-1622505600"}
+	got := GenerateRenewalRequest(v1beta1.CloudSQLSSLCertParameters{CommonName: "client"}, now)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateRenewalRequest(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   sqladmin.SslCertsInsertResponse
+		want managed.ConnectionDetails
+	}{
+		"Empty": {
+			want: managed.ConnectionDetails{},
+		},
+		"Full": {
+			in: sqladmin.SslCertsInsertResponse{
+				ClientCert: &sqladmin.SslCertDetail{
+					CertInfo:       &sqladmin.SslCert{Cert: "cert"},
+					CertPrivateKey: "key",
+				},
+				ServerCaCert: &sqladmin.SslCert{Cert: "ca", Sha1Fingerprint: "fp"},
+			},
+			want: managed.ConnectionDetails{
+				v1beta1.CloudSQLSecretClientCertificateKey:                   []byte("cert"),
+				v1beta1.CloudSQLSecretClientPrivateKeyKey:                    []byte("key"),
+				v1beta1.CloudSQLSecretServerCACertificateCertKey:             []byte("ca"),
+				v1beta1.CloudSQLSecretServerCACertificateCertSerialNumberKey: []byte(""),
+				v1beta1.CloudSQLSecretServerCACertificateCommonNameKey:       []byte(""),
+				v1beta1.CloudSQLSecretServerCACertificateCreateTimeKey:       []byte(""),
+				v1beta1.CloudSQLSecretServerCACertificateExpirationTimeKey:   []byte(""),
+				v1beta1.CloudSQLSecretServerCACertificateInstanceKey:         []byte(""),
+				v1beta1.CloudSQLSecretServerCACertificateSha1FingerprintKey:  []byte("fp"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	in := sqladmin.SslCert{
+		CertSerialNumber: "1",
+		CreateTime:       "2021-06-01T00:00:00Z",
+		ExpirationTime:   "2031-06-01T00:00:00Z",
+		SelfLink:         "link",
+		Sha1Fingerprint:  "fp",
+		Cert:             "cert",
+	}
+	want := v1beta1.CloudSQLSSLCertObservation{
+		CertSerialNumber: "1",
+		CreateTime:       "2021-06-01T00:00:00Z",
+		ExpirationTime:   "2031-06-01T00:00:00Z",
+		SelfLink:         "link",
+		Sha1Fingerprint:  "fp",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateUser generates *sqladmin.User instance from CloudSQLUserParameters.
+func GenerateUser(name, password string, in v1beta1.CloudSQLUserParameters, user *sqladmin.User) {
+	user.Name = name
+	user.Instance = gcp.StringValue(in.Instance)
+	user.Host = gcp.StringValue(in.Host)
+	user.Password = password
+}
+
+// LateInitializeSpec fills unassigned fields with the values in sqladmin.User object.
+func LateInitializeSpec(spec *v1beta1.CloudSQLUserParameters, in sqladmin.User) {
+	spec.Host = gcp.LateInitializeString(spec.Host, in.Host)
+}
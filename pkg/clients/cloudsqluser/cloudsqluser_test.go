@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudsqluser
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestGenerateUser(t *testing.T) {
+	in := v1beta1.CloudSQLUserParameters{
+		Instance: gcp.StringPtr("test-sql"),
+		Host:     gcp.StringPtr("%"),
+	}
+	want := &sqladmin.User{
+		Name:     "test-user",
+		Instance: "test-sql",
+		Host:     "%",
+		Password: "sup3rs3cr3t",
+	}
+	got := &sqladmin.User{}
+	GenerateUser("test-user", "sup3rs3cr3t", in, got)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateUser(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec *v1beta1.CloudSQLUserParameters
+		in   sqladmin.User
+		want *v1beta1.CloudSQLUserParameters
+	}{
+		"HostUnset": {
+			spec: &v1beta1.CloudSQLUserParameters{},
+			in:   sqladmin.User{Host: "%"},
+			want: &v1beta1.CloudSQLUserParameters{Host: gcp.StringPtr("%")},
+		},
+		"HostSet": {
+			spec: &v1beta1.CloudSQLUserParameters{Host: gcp.StringPtr("10.0.0.1")},
+			in:   sqladmin.User{Host: "%"},
+			want: &v1beta1.CloudSQLUserParameters{Host: gcp.StringPtr("10.0.0.1")},
+		},
+		"NoHost": {
+			spec: &v1beta1.CloudSQLUserParameters{},
+			in:   sqladmin.User{},
+			want: &v1beta1.CloudSQLUserParameters{},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, tc.in)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,6 +30,7 @@ import (
 
 	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
 	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	np "github.com/crossplane/provider-gcp/pkg/clients/nodepool"
 )
 
 const (
@@ -45,21 +46,91 @@ const (
 
 	// ClusterNameFormat is the format for the fully qualified name of a cluster.
 	ClusterNameFormat = "projects/%s/locations/%s/clusters/%s"
+
+	// OperationNameFormat is the format for the fully qualified name of an
+	// operation.
+	OperationNameFormat = "projects/%s/locations/%s/operations/%s"
 )
 
+// autoscalingProfileUnspecified is reported when no autoscaling profile is
+// configured.
+const autoscalingProfileUnspecified = "PROFILE_UNSPECIFIED"
+
+// equateAutoscalingProfile treats an unset autoscaling profile as equal to
+// PROFILE_UNSPECIFIED, which is never late initialized into the spec.
+func equateAutoscalingProfile() cmp.Option {
+	return cmp.FilterPath(func(p cmp.Path) bool {
+		return p.Last().String() == ".AutoscalingProfile"
+	}, cmp.Comparer(func(a, b string) bool {
+		if a == autoscalingProfileUnspecified {
+			a = ""
+		}
+		if b == autoscalingProfileUnspecified {
+			b = ""
+		}
+		return a == b
+	}))
+}
+
 const (
 	errNoSecretInfo  = "missing secret information for GKE cluster"
 	errCheckUpToDate = "unable to determine if external resource is up to date"
 )
 
-// AddNodePoolForCreate inserts the default node pool into *container.Cluster so
-// that it can be provisioned successfully.
-func AddNodePoolForCreate(in *container.Cluster) {
+// AddNodePoolForCreate inserts the bootstrap node pool into *container.Cluster
+// so that it can be provisioned successfully.
+func AddNodePoolForCreate(in *v1beta2.BootstrapNodePool, cluster *container.Cluster) {
+	cluster.NodePools = []*container.NodePool{GenerateBootstrapNodePool(in)}
+}
+
+// GenerateBootstrapNodePool generates the *container.NodePool a cluster is
+// created with from the given bootstrap node pool configuration.
+func GenerateBootstrapNodePool(in *v1beta2.BootstrapNodePool) *container.NodePool {
 	pool := &container.NodePool{
-		Name:             BootstrapNodePoolName,
+		Name:             GetBootstrapNodePoolName(in),
 		InitialNodeCount: 0,
 	}
-	in.NodePools = []*container.NodePool{pool}
+	if in == nil {
+		return pool
+	}
+	if GetBootstrapNodePoolPolicy(in) == v1beta2.BootstrapNodePoolPolicyInline {
+		pool.InitialNodeCount = gcp.Int64Value(in.InitialNodeCount)
+		if in.Autoscaling != nil {
+			pool.Autoscaling = &container.NodePoolAutoscaling{
+				Enabled:      in.Autoscaling.Enabled,
+				MaxNodeCount: gcp.Int64Value(in.Autoscaling.MaxNodeCount),
+				MinNodeCount: gcp.Int64Value(in.Autoscaling.MinNodeCount),
+			}
+		}
+	}
+	if in.MachineType != nil || in.DiskSizeGb != nil || in.DiskType != nil ||
+		in.ImageType != nil || in.ServiceAccount != nil || len(in.OauthScopes) != 0 {
+		pool.Config = &container.NodeConfig{
+			MachineType:    gcp.StringValue(in.MachineType),
+			DiskSizeGb:     gcp.Int64Value(in.DiskSizeGb),
+			DiskType:       gcp.StringValue(in.DiskType),
+			ImageType:      gcp.StringValue(in.ImageType),
+			ServiceAccount: gcp.StringValue(in.ServiceAccount),
+			OauthScopes:    in.OauthScopes,
+		}
+	}
+	return pool
+}
+
+// GetBootstrapNodePoolName returns the name of the bootstrap node pool.
+func GetBootstrapNodePoolName(in *v1beta2.BootstrapNodePool) string {
+	if in == nil || in.Name == nil {
+		return BootstrapNodePoolName
+	}
+	return *in.Name
+}
+
+// GetBootstrapNodePoolPolicy returns the policy of the bootstrap node pool.
+func GetBootstrapNodePoolPolicy(in *v1beta2.BootstrapNodePool) string {
+	if in == nil || in.Policy == nil {
+		return v1beta2.BootstrapNodePoolPolicyDelete
+	}
+	return *in.Policy
 }
 
 // GenerateCluster generates *container.Cluster instance from ClusterParameters.
@@ -69,6 +140,11 @@ func GenerateCluster(name string, in v1beta2.ClusterParameters, cluster *contain
 	cluster.EnableKubernetesAlpha = gcp.BoolValue(in.EnableKubernetesAlpha)
 	cluster.EnableTpu = gcp.BoolValue(in.EnableTpu)
 	cluster.InitialClusterVersion = gcp.StringValue(in.InitialClusterVersion)
+	if in.InitialClusterVersion == nil {
+		// A cluster is created with the desired master version, rather than
+		// upgraded to it right after creation.
+		cluster.InitialClusterVersion = gcp.StringValue(in.MasterVersion)
+	}
 	cluster.LabelFingerprint = gcp.StringValue(in.LabelFingerprint)
 	cluster.Locations = in.Locations
 	cluster.LoggingService = gcp.StringValue(in.LoggingService)
@@ -195,6 +271,7 @@ func GenerateAutoscaling(in *v1beta2.ClusterAutoscaling, cluster *container.Clus
 			cluster.Autoscaling = &container.ClusterAutoscaling{}
 		}
 		cluster.Autoscaling.AutoprovisioningLocations = in.AutoprovisioningLocations
+		cluster.Autoscaling.AutoscalingProfile = gcp.StringValue(in.AutoscalingProfile)
 		cluster.Autoscaling.EnableNodeAutoprovisioning = gcp.BoolValue(in.EnableNodeAutoprovisioning)
 
 		if in.AutoprovisioningNodePoolDefaults != nil {
@@ -203,6 +280,8 @@ func GenerateAutoscaling(in *v1beta2.ClusterAutoscaling, cluster *container.Clus
 			}
 			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.BootDiskKmsKey = gcp.StringValue(in.AutoprovisioningNodePoolDefaults.BootDiskKMSKey)
 			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.DiskSizeGb = gcp.Int64Value(in.AutoprovisioningNodePoolDefaults.DiskSizeGb)
+			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.DiskType = gcp.StringValue(in.AutoprovisioningNodePoolDefaults.DiskType)
+			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.ImageType = gcp.StringValue(in.AutoprovisioningNodePoolDefaults.ImageType)
 			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.MinCpuPlatform = gcp.StringValue(in.AutoprovisioningNodePoolDefaults.MinCPUPlatform)
 			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.OauthScopes = in.AutoprovisioningNodePoolDefaults.OauthScopes
 			cluster.Autoscaling.AutoprovisioningNodePoolDefaults.ServiceAccount = gcp.StringValue(in.AutoprovisioningNodePoolDefaults.ServiceAccount)
@@ -224,8 +303,7 @@ func GenerateAutoscaling(in *v1beta2.ClusterAutoscaling, cluster *container.Clus
 				if cluster.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings == nil {
 					cluster.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings = &container.UpgradeSettings{}
 				}
-				cluster.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxSurge = gcp.Int64Value(in.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxSurge)
-				cluster.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxUnavailable = gcp.Int64Value(in.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxUnavailable)
+				np.FillUpgradeSettings(in.AutoprovisioningNodePoolDefaults.UpgradeSettings, cluster.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings)
 			}
 		}
 
@@ -707,6 +785,10 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 			spec.Autoscaling = &v1beta2.ClusterAutoscaling{}
 		}
 		spec.Autoscaling.AutoprovisioningLocations = gcp.LateInitializeStringSlice(spec.Autoscaling.AutoprovisioningLocations, in.Autoscaling.AutoprovisioningLocations)
+		// PROFILE_UNSPECIFIED is not a valid value for the spec.
+		if in.Autoscaling.AutoscalingProfile != autoscalingProfileUnspecified {
+			spec.Autoscaling.AutoscalingProfile = gcp.LateInitializeString(spec.Autoscaling.AutoscalingProfile, in.Autoscaling.AutoscalingProfile)
+		}
 		if in.Autoscaling.AutoprovisioningNodePoolDefaults != nil {
 			if spec.Autoscaling.AutoprovisioningNodePoolDefaults == nil {
 				spec.Autoscaling.AutoprovisioningNodePoolDefaults = &v1beta2.AutoprovisioningNodePoolDefaults{}
@@ -714,6 +796,7 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.BootDiskKMSKey = gcp.LateInitializeString(spec.Autoscaling.AutoprovisioningNodePoolDefaults.BootDiskKMSKey, in.Autoscaling.AutoprovisioningNodePoolDefaults.BootDiskKmsKey)
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.DiskSizeGb = gcp.LateInitializeInt64(spec.Autoscaling.AutoprovisioningNodePoolDefaults.DiskSizeGb, in.Autoscaling.AutoprovisioningNodePoolDefaults.DiskSizeGb)
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.DiskType = gcp.LateInitializeString(spec.Autoscaling.AutoprovisioningNodePoolDefaults.DiskType, in.Autoscaling.AutoprovisioningNodePoolDefaults.DiskType)
+			spec.Autoscaling.AutoprovisioningNodePoolDefaults.ImageType = gcp.LateInitializeString(spec.Autoscaling.AutoprovisioningNodePoolDefaults.ImageType, in.Autoscaling.AutoprovisioningNodePoolDefaults.ImageType)
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.MinCPUPlatform = gcp.LateInitializeString(spec.Autoscaling.AutoprovisioningNodePoolDefaults.MinCPUPlatform, in.Autoscaling.AutoprovisioningNodePoolDefaults.MinCpuPlatform)
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.OauthScopes = gcp.LateInitializeStringSlice(spec.Autoscaling.AutoprovisioningNodePoolDefaults.OauthScopes, in.Autoscaling.AutoprovisioningNodePoolDefaults.OauthScopes)
 			spec.Autoscaling.AutoprovisioningNodePoolDefaults.ServiceAccount = gcp.LateInitializeString(spec.Autoscaling.AutoprovisioningNodePoolDefaults.ServiceAccount, in.Autoscaling.AutoprovisioningNodePoolDefaults.ServiceAccount)
@@ -735,8 +818,7 @@ func LateInitializeSpec(spec *v1beta2.ClusterParameters, in container.Cluster) {
 				if spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings == nil {
 					spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings = &v1beta2.UpgradeSettings{}
 				}
-				spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxSurge = gcp.LateInitializeInt64(spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxSurge, in.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxSurge)
-				spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxUnavailable = gcp.LateInitializeInt64(spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxUnavailable, in.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings.MaxUnavailable)
+				np.LateInitializeUpgradeSettings(spec.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings, in.Autoscaling.AutoprovisioningNodePoolDefaults.UpgradeSettings)
 			}
 		}
 		spec.Autoscaling.EnableNodeAutoprovisioning = gcp.LateInitializeBool(spec.Autoscaling.EnableNodeAutoprovisioning, in.Autoscaling.EnableNodeAutoprovisioning)
@@ -996,20 +1078,6 @@ func newBinaryAuthorizationUpdateFn(in *v1beta2.BinaryAuthorization) UpdateFn {
 	}
 }
 
-// newAutopilotUpdateFn returns a function that updates the Autopilot of a cluster.
-func newAutopilotUpdateFn(in *v1beta2.Autopilot) UpdateFn {
-	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		out := &container.Cluster{}
-		GenerateAutopilot(in, out)
-		update := &container.UpdateClusterRequest{
-			Update: &container.ClusterUpdate{
-				DesiredAutopilot: out.Autopilot,
-			},
-		}
-		return s.Projects.Locations.Clusters.Update(name, update).Context(ctx).Do()
-	}
-}
-
 // newDatabaseEncryptionUpdateFn returns a function that updates the DatabaseEncryption of a cluster.
 func newDatabaseEncryptionUpdateFn(in *v1beta2.DatabaseEncryption) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -1233,9 +1301,9 @@ func newWorkloadIdentityConfigUpdateFn(in *v1beta2.WorkloadIdentityConfig) Updat
 }
 
 // deleteBootstrapNodePoolFn returns a function to delete the bootstrap node pool.
-func deleteBootstrapNodePoolFn() UpdateFn {
+func deleteBootstrapNodePoolFn(pool string) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
-		return s.Projects.Locations.Clusters.NodePools.Delete(GetFullyQualifiedBNP(name)).Context(ctx).Do()
+		return s.Projects.Locations.Clusters.NodePools.Delete(GetFullyQualifiedBNP(name, pool)).Context(ctx).Do()
 	}
 }
 
@@ -1248,9 +1316,9 @@ func noOpUpdate(ctx context.Context, s *container.Service, name string) (*contai
 
 // checkForBootstrapNodePool checks if the bootstrap node pool exists for the
 // cluster.
-func checkForBootstrapNodePool(c *container.Cluster) bool {
+func checkForBootstrapNodePool(c *container.Cluster, name string) bool {
 	for _, pool := range c.NodePools {
-		if pool == nil || pool.Name != BootstrapNodePoolName {
+		if pool == nil || pool.Name != name {
 			continue
 		}
 		return true
@@ -1258,23 +1326,88 @@ func checkForBootstrapNodePool(c *container.Cluster) bool {
 	return false
 }
 
+// Names of the pending updates of a cluster.
+const (
+	UpdateBootstrapNodePool              = "bootstrapNodePool"
+	UpdateMasterVersion                  = "masterVersion"
+	UpdateAddonsConfig                   = "addonsConfig"
+	UpdateAutoscaling                    = "autoscaling"
+	UpdateBinaryAuthorization            = "binaryAuthorization"
+	UpdateDatabaseEncryption             = "databaseEncryption"
+	UpdateLegacyAbac                     = "legacyAbac"
+	UpdateLocations                      = "locations"
+	UpdateLoggingService                 = "loggingService"
+	UpdateMaintenancePolicy              = "maintenancePolicy"
+	UpdateMasterAuthorizedNetworksConfig = "masterAuthorizedNetworksConfig"
+	UpdateMonitoringService              = "monitoringService"
+	UpdateIntraNodeVisibility            = "networkConfig.enableIntraNodeVisibility"
+	UpdateDatapathProvider               = "networkConfig.datapathProvider"
+	UpdateNetworkPolicy                  = "networkPolicy"
+	UpdateNotificationConfig             = "notificationConfig"
+	UpdatePrivateClusterConfig           = "privateClusterConfig"
+	UpdateReleaseChannel                 = "releaseChannel"
+	UpdateResourceLabels                 = "resourceLabels"
+	UpdateResourceUsageExportConfig      = "resourceUsageExportConfig"
+	UpdateVerticalPodAutoscaling         = "verticalPodAutoscaling"
+	UpdateWorkloadIdentityConfig         = "workloadIdentityConfig"
+)
+
+// An Update is a pending change of a cluster.
+type Update struct {
+	// Name of the update, e.g. addonsConfig.
+	Name string
+
+	// Fn applies the update.
+	Fn UpdateFn
+}
+
+// UpdateNames returns the names of the supplied updates.
+func UpdateNames(u []Update) []string {
+	if len(u) == 0 {
+		return nil
+	}
+	names := make([]string, len(u))
+	for i := range u {
+		names[i] = u[i].Name
+	}
+	return names
+}
+
 // IsUpToDate checks whether current state is up-to-date compared to the given
-// set of parameters.
-// NOTE(hasheddan): This function is significantly above our cyclomatic
-// complexity limit, but is necessary due to the fact that the GKE API only
-// allows for update of one field at a time.
-func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.Cluster) (bool, UpdateFn, error) { // nolint:gocyclo
+// set of parameters. If it is not, it returns the first pending update.
+func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.Cluster) (bool, UpdateFn, error) {
+	u, err := GetUpdates(name, in, observed)
+	if err != nil {
+		return true, noOpUpdate, err
+	}
+	if len(u) == 0 {
+		return true, noOpUpdate, nil
+	}
+	return false, u[0].Fn, nil
+}
+
+// GetUpdates returns the ordered list of updates that bring the observed
+// cluster to the state described by the given set of parameters.
+// NOTE: The GKE API accepts a single field per update request and runs one
+// operation on a cluster at a time, so the updates cannot be combined and
+// must be applied one after another.
+func GetUpdates(name string, in *v1beta2.ClusterParameters, observed *container.Cluster) ([]Update, error) { // nolint:gocyclo
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
-		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+		return nil, errors.Wrap(err, errCheckUpToDate)
 	}
 	desired, ok := generated.(*container.Cluster)
 	if !ok {
-		return true, noOpUpdate, errors.New(errCheckUpToDate)
+		return nil, errors.New(errCheckUpToDate)
 	}
 	GenerateCluster(name, *in, desired)
-	if checkForBootstrapNodePool(observed) {
-		return false, deleteBootstrapNodePoolFn(), nil
+	u := []Update{}
+	bnp := GetBootstrapNodePoolName(in.BootstrapNodePool)
+	if GetBootstrapNodePoolPolicy(in.BootstrapNodePool) == v1beta2.BootstrapNodePoolPolicyDelete && checkForBootstrapNodePool(observed, bnp) {
+		u = append(u, Update{Name: UpdateBootstrapNodePool, Fn: deleteBootstrapNodePoolFn(bnp)})
+	}
+	if needsMasterUpgrade(in, observed) {
+		u = append(u, Update{Name: UpdateMasterVersion, Fn: newMasterVersionUpdateFn(in.MasterVersion)})
 	}
 	if !cmp.Equal(desired.AddonsConfig, observed.AddonsConfig, cmpopts.EquateEmpty(),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "CloudRunConfig.ForceSendFields"),
@@ -1285,75 +1418,72 @@ func IsUpToDate(name string, in *v1beta2.ClusterParameters, observed *container.
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "HttpLoadBalancing.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "KubernetesDashboard.ForceSendFields"),
 		cmpopts.IgnoreFields(container.AddonsConfig{}, "NetworkPolicyConfig.ForceSendFields")) {
-		return false, newAddonsConfigUpdateFn(in.AddonsConfig), nil
-	}
-	if !cmp.Equal(desired.Autopilot, observed.Autopilot, cmpopts.EquateEmpty()) {
-		return false, newAutopilotUpdateFn(in.Autopilot), nil
+		u = append(u, Update{Name: UpdateAddonsConfig, Fn: newAddonsConfigUpdateFn(in.AddonsConfig)})
 	}
-	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty()) {
-		return false, newAutoscalingUpdateFn(in.Autoscaling), nil
+	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty(), equateAutoscalingProfile()) {
+		u = append(u, Update{Name: UpdateAutoscaling, Fn: newAutoscalingUpdateFn(in.Autoscaling)})
 	}
 	if !cmp.Equal(desired.BinaryAuthorization, observed.BinaryAuthorization, cmpopts.EquateEmpty()) {
-		return false, newBinaryAuthorizationUpdateFn(in.BinaryAuthorization), nil
+		u = append(u, Update{Name: UpdateBinaryAuthorization, Fn: newBinaryAuthorizationUpdateFn(in.BinaryAuthorization)})
 	}
 	if !cmp.Equal(desired.DatabaseEncryption, observed.DatabaseEncryption, cmpopts.EquateEmpty()) {
-		return false, newDatabaseEncryptionUpdateFn(in.DatabaseEncryption), nil
+		u = append(u, Update{Name: UpdateDatabaseEncryption, Fn: newDatabaseEncryptionUpdateFn(in.DatabaseEncryption)})
 	}
 	if !cmp.Equal(desired.LegacyAbac, observed.LegacyAbac, cmpopts.EquateEmpty()) {
-		return false, newLegacyAbacUpdateFn(in.LegacyAbac), nil
+		u = append(u, Update{Name: UpdateLegacyAbac, Fn: newLegacyAbacUpdateFn(in.LegacyAbac)})
 	}
 	if !cmp.Equal(desired.Locations, observed.Locations, cmpopts.EquateEmpty()) {
-		return false, newLocationsUpdateFn(in.Locations), nil
+		u = append(u, Update{Name: UpdateLocations, Fn: newLocationsUpdateFn(in.Locations)})
 	}
 	if !cmp.Equal(desired.LoggingService, observed.LoggingService, cmpopts.EquateEmpty()) {
-		return false, newLoggingServiceUpdateFn(in.LoggingService), nil
+		u = append(u, Update{Name: UpdateLoggingService, Fn: newLoggingServiceUpdateFn(in.LoggingService)})
 	}
 	if !cmp.Equal(desired.MaintenancePolicy, observed.MaintenancePolicy, cmpopts.EquateEmpty()) {
-		return false, newMaintenancePolicyUpdateFn(in.MaintenancePolicy), nil
+		u = append(u, Update{Name: UpdateMaintenancePolicy, Fn: newMaintenancePolicyUpdateFn(in.MaintenancePolicy)})
 	}
 	if !cmp.Equal(desired.MasterAuthorizedNetworksConfig, observed.MasterAuthorizedNetworksConfig, cmpopts.EquateEmpty()) {
-		return false, newMasterAuthorizedNetworksConfigUpdateFn(in.MasterAuthorizedNetworksConfig), nil
+		u = append(u, Update{Name: UpdateMasterAuthorizedNetworksConfig, Fn: newMasterAuthorizedNetworksConfigUpdateFn(in.MasterAuthorizedNetworksConfig)})
 	}
 	if !cmp.Equal(desired.MonitoringService, observed.MonitoringService, cmpopts.EquateEmpty()) {
-		return false, newMonitoringServiceUpdateFn(in.MonitoringService), nil
+		u = append(u, Update{Name: UpdateMonitoringService, Fn: newMonitoringServiceUpdateFn(in.MonitoringService)})
 	}
 	if desired.NetworkConfig != nil {
 		if observed.NetworkConfig == nil {
 			observed.NetworkConfig = &container.NetworkConfig{}
 		}
 		if !cmp.Equal(desired.NetworkConfig.EnableIntraNodeVisibility, observed.NetworkConfig.EnableIntraNodeVisibility, cmpopts.EquateEmpty()) {
-			return false, newIntraNodeVisibilityConfigUpdateFn(in.NetworkConfig.EnableIntraNodeVisibility), nil
+			u = append(u, Update{Name: UpdateIntraNodeVisibility, Fn: newIntraNodeVisibilityConfigUpdateFn(in.NetworkConfig.EnableIntraNodeVisibility)})
 		}
 		if !cmp.Equal(desired.NetworkConfig.DatapathProvider, observed.NetworkConfig.DatapathProvider, cmpopts.EquateEmpty()) {
-			return false, newDatapathProviderUpdateFn(in.NetworkConfig.DatapathProvider), nil
+			u = append(u, Update{Name: UpdateDatapathProvider, Fn: newDatapathProviderUpdateFn(in.NetworkConfig.DatapathProvider)})
 		}
 	}
 
 	if !cmp.Equal(desired.NetworkPolicy, observed.NetworkPolicy, cmpopts.EquateEmpty()) {
-		return false, newNetworkPolicyUpdateFn(in.NetworkPolicy), nil
+		u = append(u, Update{Name: UpdateNetworkPolicy, Fn: newNetworkPolicyUpdateFn(in.NetworkPolicy)})
 	}
 	if !cmp.Equal(desired.NotificationConfig, observed.NotificationConfig, cmpopts.EquateEmpty()) {
-		return false, newNotificationConfigUpdateFn(in.NotificationConfig), nil
+		u = append(u, Update{Name: UpdateNotificationConfig, Fn: newNotificationConfigUpdateFn(in.NotificationConfig)})
 	}
 	if !cmp.Equal(desired.PrivateClusterConfig, observed.PrivateClusterConfig, cmpopts.EquateEmpty()) {
-		return false, newPrivateClusterConfigUpdateFn(in.PrivateClusterConfig), nil
+		u = append(u, Update{Name: UpdatePrivateClusterConfig, Fn: newPrivateClusterConfigUpdateFn(in.PrivateClusterConfig)})
 	}
 	if !cmp.Equal(desired.ReleaseChannel, observed.ReleaseChannel, cmpopts.EquateEmpty()) {
-		return false, newReleaseChannelUpdateFn(in.ReleaseChannel), nil
+		u = append(u, Update{Name: UpdateReleaseChannel, Fn: newReleaseChannelUpdateFn(in.ReleaseChannel)})
 	}
 	if !cmp.Equal(desired.ResourceLabels, observed.ResourceLabels, cmpopts.EquateEmpty()) {
-		return false, newResourceLabelsUpdateFn(in.ResourceLabels), nil
+		u = append(u, Update{Name: UpdateResourceLabels, Fn: newResourceLabelsUpdateFn(in.ResourceLabels)})
 	}
 	if !cmp.Equal(desired.ResourceUsageExportConfig, observed.ResourceUsageExportConfig, cmpopts.EquateEmpty()) {
-		return false, newResourceUsageExportConfigUpdateFn(in.ResourceUsageExportConfig), nil
+		u = append(u, Update{Name: UpdateResourceUsageExportConfig, Fn: newResourceUsageExportConfigUpdateFn(in.ResourceUsageExportConfig)})
 	}
 	if !cmp.Equal(desired.VerticalPodAutoscaling, observed.VerticalPodAutoscaling, cmpopts.EquateEmpty()) {
-		return false, newVerticalPodAutoscalingUpdateFn(in.VerticalPodAutoscaling), nil
+		u = append(u, Update{Name: UpdateVerticalPodAutoscaling, Fn: newVerticalPodAutoscalingUpdateFn(in.VerticalPodAutoscaling)})
 	}
 	if !cmp.Equal(desired.WorkloadIdentityConfig, observed.WorkloadIdentityConfig, cmpopts.EquateEmpty()) {
-		return false, newWorkloadIdentityConfigUpdateFn(in.WorkloadIdentityConfig), nil
+		u = append(u, Update{Name: UpdateWorkloadIdentityConfig, Fn: newWorkloadIdentityConfigUpdateFn(in.WorkloadIdentityConfig)})
 	}
-	return true, noOpUpdate, nil
+	return u, nil
 }
 
 // GetFullyQualifiedParent builds the fully qualified name of the cluster
@@ -1367,22 +1497,98 @@ func GetFullyQualifiedName(project string, p v1beta2.ClusterParameters, name str
 	return fmt.Sprintf(ClusterNameFormat, project, p.Location, name)
 }
 
+// GetFullyQualifiedOperation builds the fully qualified name of an operation
+// on the cluster.
+func GetFullyQualifiedOperation(project string, p v1beta2.ClusterParameters, name string) string {
+	return fmt.Sprintf(OperationNameFormat, project, p.Location, name)
+}
+
+// GenerateOperation produces a ClusterOperation from the supplied operation
+// that applies the supplied update.
+func GenerateOperation(update string, in *container.Operation) *v1beta2.ClusterOperation {
+	if in == nil {
+		return nil
+	}
+	o := &v1beta2.ClusterOperation{
+		Name:          in.Name,
+		OperationType: in.OperationType,
+		Update:        update,
+		Status:        in.Status,
+		StatusMessage: in.StatusMessage,
+		StartTime:     in.StartTime,
+		EndTime:       in.EndTime,
+	}
+	if in.Error != nil && in.Error.Message != "" {
+		o.StatusMessage = in.Error.Message
+	}
+	return o
+}
+
+// IsOperationActive returns true if the supplied operation is not done yet.
+func IsOperationActive(o *v1beta2.ClusterOperation) bool {
+	return o != nil && o.Status != v1beta2.OperationStateDone
+}
+
 // GetFullyQualifiedBNP build the fully qualified name of the bootstrap node
 // pool.
-func GetFullyQualifiedBNP(clusterName string) string {
-	return fmt.Sprintf(BNPNameFormat, clusterName, BootstrapNodePoolName)
+func GetFullyQualifiedBNP(clusterName, pool string) string {
+	return fmt.Sprintf(BNPNameFormat, clusterName, pool)
+}
+
+// ConnectionEndpoints returns the endpoint of the supplied cluster that the
+// kubeconfigs of its connection secret use, and the private endpoint that is
+// published in addition to it, if any. Clusters without a private endpoint
+// always use the public one.
+func ConnectionEndpoints(in *v1beta2.ClusterParameters, cluster *container.Cluster) (endpoint, private string) {
+	endpoint = cluster.Endpoint
+	if cluster.PrivateClusterConfig == nil || cluster.PrivateClusterConfig.PrivateEndpoint == "" {
+		return endpoint, ""
+	}
+	switch gcp.StringValue(in.ConnectionEndpoint) {
+	case v1beta2.ConnectionEndpointPrivate:
+		return cluster.PrivateClusterConfig.PrivateEndpoint, ""
+	case v1beta2.ConnectionEndpointBoth:
+		return endpoint, cluster.PrivateClusterConfig.PrivateEndpoint
+	}
+	return endpoint, ""
+}
+
+// GenerateClientConfig generates a kubeconfig for the supplied endpoint of the
+// supplied cluster that authenticates with the supplied OAuth2 access token.
+// Basic authentication and client certificates are disabled on modern GKE
+// clusters, so they are not used.
+func GenerateClientConfig(cluster *container.Cluster, endpoint, token string) (clientcmdapi.Config, error) {
+	return generateClientConfig(cluster, endpoint, &clientcmdapi.AuthInfo{Token: token})
+}
+
+// GenerateExecClientConfig generates a kubeconfig for the supplied endpoint of
+// the supplied cluster that obtains credentials by running
+// gke-gcloud-auth-plugin, which must be installed wherever the kubeconfig is
+// used.
+func GenerateExecClientConfig(cluster *container.Cluster, endpoint string) (clientcmdapi.Config, error) {
+	return generateClientConfig(cluster, endpoint, &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:         AuthPluginAPIVersion,
+			Command:            AuthPluginCommand,
+			InstallHint:        AuthPluginInstallHint,
+			ProvideClusterInfo: true,
+		},
+	})
 }
 
-// GenerateClientConfig generates a clientcmdapi.Config that can be used by any
-// kubernetes client.
-func GenerateClientConfig(cluster *container.Cluster) (clientcmdapi.Config, error) {
+func generateClientConfig(cluster *container.Cluster, endpoint string, auth *clientcmdapi.AuthInfo) (clientcmdapi.Config, error) {
 	if cluster.MasterAuth == nil {
 		return clientcmdapi.Config{}, errors.New(errNoSecretInfo)
 	}
-	c := clientcmdapi.Config{
+	ca, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCaCertificate)
+	if err != nil {
+		return clientcmdapi.Config{}, err
+	}
+	return clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			cluster.Name: {
-				Server: fmt.Sprintf("https://%s", cluster.Endpoint),
+				Server:                   fmt.Sprintf("https://%s", endpoint),
+				CertificateAuthorityData: ca,
 			},
 		},
 		Contexts: map[string]*clientcmdapi.Context{
@@ -1392,31 +1598,8 @@ func GenerateClientConfig(cluster *container.Cluster) (clientcmdapi.Config, erro
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			cluster.Name: {
-				Username: cluster.MasterAuth.Username,
-				Password: cluster.MasterAuth.Password,
-			},
+			cluster.Name: auth,
 		},
 		CurrentContext: cluster.Name,
-	}
-
-	val, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCaCertificate)
-	if err != nil {
-		return clientcmdapi.Config{}, err
-	}
-	c.Clusters[cluster.Name].CertificateAuthorityData = val
-
-	val, err = base64.StdEncoding.DecodeString(cluster.MasterAuth.ClientCertificate)
-	if err != nil {
-		return clientcmdapi.Config{}, err
-	}
-	c.AuthInfos[cluster.Name].ClientCertificateData = val
-
-	val, err = base64.StdEncoding.DecodeString(cluster.MasterAuth.ClientKey)
-	if err != nil {
-		return clientcmdapi.Config{}, err
-	}
-	c.AuthInfos[cluster.Name].ClientKeyData = val
-
-	return c, nil
+	}, nil
 }
@@ -305,12 +305,18 @@ func TestAddNodePoolForCreate(t *testing.T) {
 		Name:             BootstrapNodePoolName,
 		InitialNodeCount: 0,
 	}
+	type args struct {
+		in      *v1beta2.BootstrapNodePool
+		cluster *container.Cluster
+	}
 	tests := map[string]struct {
-		args *container.Cluster
+		args args
 		want *container.Cluster
 	}{
 		"Successful": {
-			args: cluster(),
+			args: args{
+				cluster: cluster(),
+			},
 			want: cluster(func(c *container.Cluster) {
 				c.NodePools = []*container.NodePool{pool}
 			}),
@@ -318,14 +324,76 @@ func TestAddNodePoolForCreate(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			AddNodePoolForCreate(tc.args)
-			if diff := cmp.Diff(tc.want, tc.args); diff != "" {
+			AddNodePoolForCreate(tc.args.in, tc.args.cluster)
+			if diff := cmp.Diff(tc.want, tc.args.cluster); diff != "" {
 				t.Errorf("AddNodePoolForCreate(...): -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
 
+func TestGenerateBootstrapNodePool(t *testing.T) {
+	poolName := "cool-pool"
+	machineType := "e2-medium"
+	serviceAccount := "cool-sa@cool-project.iam.gserviceaccount.com"
+	tests := map[string]struct {
+		in   *v1beta2.BootstrapNodePool
+		want *container.NodePool
+	}{
+		"Default": {
+			in: nil,
+			want: &container.NodePool{
+				Name: BootstrapNodePoolName,
+			},
+		},
+		"DeleteIgnoresNodeCount": {
+			in: &v1beta2.BootstrapNodePool{
+				InitialNodeCount: gcp.Int64Ptr(3),
+				MachineType:      &machineType,
+				DiskSizeGb:       gcp.Int64Ptr(20),
+				ServiceAccount:   &serviceAccount,
+			},
+			want: &container.NodePool{
+				Name: BootstrapNodePoolName,
+				Config: &container.NodeConfig{
+					MachineType:    machineType,
+					DiskSizeGb:     20,
+					ServiceAccount: serviceAccount,
+				},
+			},
+		},
+		"Inline": {
+			in: &v1beta2.BootstrapNodePool{
+				Policy:           gcp.StringPtr(v1beta2.BootstrapNodePoolPolicyInline),
+				Name:             &poolName,
+				InitialNodeCount: gcp.Int64Ptr(3),
+				Autoscaling: &v1beta2.BootstrapNodePoolAutoscaling{
+					Enabled:      true,
+					MinNodeCount: gcp.Int64Ptr(1),
+					MaxNodeCount: gcp.Int64Ptr(5),
+				},
+			},
+			want: &container.NodePool{
+				Name:             poolName,
+				InitialNodeCount: 3,
+				Autoscaling: &container.NodePoolAutoscaling{
+					Enabled:      true,
+					MinNodeCount: 1,
+					MaxNodeCount: 5,
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateBootstrapNodePool(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateBootstrapNodePool(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateAddonsConfig(t *testing.T) {
 	type args struct {
 		cluster *container.Cluster
@@ -476,6 +544,69 @@ func TestGenerateAutoscaling(t *testing.T) {
 				}
 			}),
 		},
+		"SuccessfulWithProfileAndDefaults": {
+			args: args{
+				cluster: cluster(),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						AutoscalingProfile:         gcp.StringPtr("OPTIMIZE_UTILIZATION"),
+						EnableNodeAutoprovisioning: gcp.BoolPtr(true),
+						AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{
+							BootDiskKMSKey: gcp.StringPtr("cool-key"),
+							DiskType:       gcp.StringPtr("pd-balanced"),
+							ImageType:      gcp.StringPtr("COS_CONTAINERD"),
+							ServiceAccount: gcp.StringPtr("nodes@cool-project.iam.gserviceaccount.com"),
+							OauthScopes:    []string{"https://www.googleapis.com/auth/cloud-platform"},
+							Management: &v1beta2.NodeManagement{
+								AutoRepair:  gcp.BoolPtr(true),
+								AutoUpgrade: gcp.BoolPtr(true),
+							},
+							ShieldedInstanceConfig: &v1beta2.ShieldedInstanceConfig{
+								EnableSecureBoot: gcp.BoolPtr(true),
+							},
+							UpgradeSettings: &v1beta2.UpgradeSettings{
+								Strategy: gcp.StringPtr("BLUE_GREEN"),
+								BlueGreenSettings: &v1beta2.BlueGreenSettings{
+									NodePoolSoakDuration: gcp.StringPtr("3600s"),
+									StandardRolloutPolicy: &v1beta2.StandardRolloutPolicy{
+										BatchPercentage: gcp.Int64Ptr(25),
+									},
+								},
+							},
+						},
+					}
+				}),
+			},
+			want: cluster(func(c *container.Cluster) {
+				c.Autoscaling = &container.ClusterAutoscaling{
+					AutoscalingProfile:         "OPTIMIZE_UTILIZATION",
+					EnableNodeAutoprovisioning: true,
+					AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+						BootDiskKmsKey: "cool-key",
+						DiskType:       "pd-balanced",
+						ImageType:      "COS_CONTAINERD",
+						ServiceAccount: "nodes@cool-project.iam.gserviceaccount.com",
+						OauthScopes:    []string{"https://www.googleapis.com/auth/cloud-platform"},
+						Management: &container.NodeManagement{
+							AutoRepair:  true,
+							AutoUpgrade: true,
+						},
+						ShieldedInstanceConfig: &container.ShieldedInstanceConfig{
+							EnableSecureBoot: true,
+						},
+						UpgradeSettings: &container.UpgradeSettings{
+							Strategy: "BLUE_GREEN",
+							BlueGreenSettings: &container.BlueGreenSettings{
+								NodePoolSoakDuration: "3600s",
+								StandardRolloutPolicy: &container.StandardRolloutPolicy{
+									BatchPercentage: 0.25,
+								},
+							},
+						},
+					},
+				}
+			}),
+		},
 		"SuccessfulNil": {
 			args: args{
 				cluster: cluster(),
@@ -1467,6 +1598,44 @@ func TestLateInitializeSpec(t *testing.T) {
 				}),
 			},
 		},
+		"Autoscaling": {
+			args: args{
+				cluster: cluster(func(c *container.Cluster) {
+					c.Autoscaling = &container.ClusterAutoscaling{
+						AutoscalingProfile: "PROFILE_UNSPECIFIED",
+						AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+							ImageType:      "COS_CONTAINERD",
+							ServiceAccount: "default",
+							UpgradeSettings: &container.UpgradeSettings{
+								MaxSurge: 1,
+								Strategy: "SURGE",
+							},
+						},
+					}
+				}),
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{
+							ServiceAccount: gcp.StringPtr("nodes@cool-project.iam.gserviceaccount.com"),
+						},
+					}
+				}),
+			},
+			want: want{
+				params: params(func(p *v1beta2.ClusterParameters) {
+					p.Autoscaling = &v1beta2.ClusterAutoscaling{
+						AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{
+							ImageType:      gcp.StringPtr("COS_CONTAINERD"),
+							ServiceAccount: gcp.StringPtr("nodes@cool-project.iam.gserviceaccount.com"),
+							UpgradeSettings: &v1beta2.UpgradeSettings{
+								MaxSurge: gcp.Int64Ptr(1),
+								Strategy: gcp.StringPtr("SURGE"),
+							},
+						},
+					}
+				}),
+			},
+		},
 		"NoneFilled": {
 			args: args{
 				cluster: cluster(),
@@ -1697,7 +1866,7 @@ func TestGetFullyQualifiedBNP(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			s := GetFullyQualifiedBNP(tc.name)
+			s := GetFullyQualifiedBNP(tc.name, BootstrapNodePoolName)
 			if diff := cmp.Diff(tc.want, s); diff != "" {
 				t.Errorf("GetFullyQualifiedBNP(...): -want, +got:\n%s", diff)
 			}
@@ -1705,11 +1874,265 @@ func TestGetFullyQualifiedBNP(t *testing.T) {
 	}
 }
 
+func TestGetUpdates(t *testing.T) {
+	type want struct {
+		names []string
+		err   error
+	}
+	cases := map[string]struct {
+		cluster *container.Cluster
+		params  *v1beta2.ClusterParameters
+		want    want
+	}{
+		"UpToDate": {
+			cluster: cluster(),
+			params:  params(),
+			want:    want{},
+		},
+		"MultipleInOrder": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.NodePools = []*container.NodePool{{Name: BootstrapNodePoolName}}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ResourceLabels = map[string]string{"cool": "label"}
+				p.Locations = []string{"us-central1-a"}
+				p.AddonsConfig = &v1beta2.AddonsConfig{
+					HTTPLoadBalancing: &v1beta2.HTTPLoadBalancing{Disabled: true},
+				}
+			}),
+			want: want{
+				names: []string{UpdateBootstrapNodePool, UpdateAddonsConfig, UpdateLocations, UpdateResourceLabels},
+			},
+		},
+		"MasterVersion": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.CurrentMasterVersion = "1.20.8-gke.900"
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.MasterVersion = gcp.StringPtr("1.21")
+			}),
+			want: want{
+				names: []string{UpdateMasterVersion},
+			},
+		},
+		"MasterVersionRefused": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.CurrentMasterVersion = "1.20.8-gke.900"
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.MasterVersion = gcp.StringPtr("1.22")
+			}),
+			want: want{},
+		},
+		"MasterVersionAlias": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.CurrentMasterVersion = "1.20.8-gke.900"
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.MasterVersion = gcp.StringPtr(VersionLatest)
+			}),
+			want: want{
+				names: []string{UpdateMasterVersion},
+			},
+		},
+		"AutoprovisioningDefaults": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.Autoscaling = &container.ClusterAutoscaling{
+					EnableNodeAutoprovisioning: true,
+					AutoprovisioningNodePoolDefaults: &container.AutoprovisioningNodePoolDefaults{
+						ServiceAccount: "default",
+					},
+				}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.Autoscaling = &v1beta2.ClusterAutoscaling{
+					EnableNodeAutoprovisioning: gcp.BoolPtr(true),
+					AutoprovisioningNodePoolDefaults: &v1beta2.AutoprovisioningNodePoolDefaults{
+						ServiceAccount: gcp.StringPtr("nodes@cool-project.iam.gserviceaccount.com"),
+					},
+				}
+			}),
+			want: want{
+				names: []string{UpdateAutoscaling},
+			},
+		},
+		"AutoscalingProfileUnspecified": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.Autoscaling = &container.ClusterAutoscaling{
+					EnableNodeAutoprovisioning: true,
+					AutoscalingProfile:         autoscalingProfileUnspecified,
+				}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.Autoscaling = &v1beta2.ClusterAutoscaling{
+					EnableNodeAutoprovisioning: gcp.BoolPtr(true),
+				}
+			}),
+			want: want{},
+		},
+		"AutoscalingProfileChanged": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.Autoscaling = &container.ClusterAutoscaling{
+					AutoscalingProfile: autoscalingProfileUnspecified,
+				}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.Autoscaling = &v1beta2.ClusterAutoscaling{
+					AutoscalingProfile: gcp.StringPtr("OPTIMIZE_UTILIZATION"),
+				}
+			}),
+			want: want{
+				names: []string{UpdateAutoscaling},
+			},
+		},
+		"KeepBootstrapNodePool": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.NodePools = []*container.NodePool{{Name: BootstrapNodePoolName}}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.BootstrapNodePool = &v1beta2.BootstrapNodePool{
+					Policy: gcp.StringPtr(v1beta2.BootstrapNodePoolPolicyKeep),
+				}
+			}),
+			want: want{},
+		},
+		"DeleteNamedBootstrapNodePool": {
+			cluster: cluster(func(c *container.Cluster) {
+				c.NodePools = []*container.NodePool{{Name: "cool-pool"}}
+			}),
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.BootstrapNodePool = &v1beta2.BootstrapNodePool{
+					Name: gcp.StringPtr("cool-pool"),
+				}
+			}),
+			want: want{
+				names: []string{UpdateBootstrapNodePool},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u, err := GetUpdates(tc.cluster.Name, tc.params, tc.cluster)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("GetUpdates(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.names, UpdateNames(u)); diff != "" {
+				t.Errorf("GetUpdates(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateOperation(t *testing.T) {
+	cases := map[string]struct {
+		in   *container.Operation
+		want *v1beta2.ClusterOperation
+	}{
+		"Nil": {
+			in:   nil,
+			want: nil,
+		},
+		"Running": {
+			in: &container.Operation{
+				Name:          "op",
+				OperationType: "UPDATE_CLUSTER",
+				Status:        v1beta2.OperationStateRunning,
+				StartTime:     "2021-06-01T12:00:00Z",
+			},
+			want: &v1beta2.ClusterOperation{
+				Name:          "op",
+				OperationType: "UPDATE_CLUSTER",
+				Update:        UpdateLocations,
+				Status:        v1beta2.OperationStateRunning,
+				StartTime:     "2021-06-01T12:00:00Z",
+			},
+		},
+		"Failed": {
+			in: &container.Operation{
+				Name:   "op",
+				Status: v1beta2.OperationStateDone,
+				Error:  &container.Status{Message: "boom"},
+			},
+			want: &v1beta2.ClusterOperation{
+				Name:          "op",
+				Update:        UpdateLocations,
+				Status:        v1beta2.OperationStateDone,
+				StatusMessage: "boom",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateOperation(UpdateLocations, tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateOperation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConnectionEndpoints(t *testing.T) {
+	private := cluster(func(c *container.Cluster) {
+		c.Endpoint = "35.0.0.1"
+		c.PrivateClusterConfig = &container.PrivateClusterConfig{PrivateEndpoint: "10.0.0.2"}
+	})
+	type want struct {
+		endpoint string
+		private  string
+	}
+	cases := map[string]struct {
+		params  *v1beta2.ClusterParameters
+		cluster *container.Cluster
+		want    want
+	}{
+		"DefaultPublic": {
+			params:  params(),
+			cluster: private,
+			want:    want{endpoint: "35.0.0.1"},
+		},
+		"Private": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ConnectionEndpoint = gcp.StringPtr(v1beta2.ConnectionEndpointPrivate)
+			}),
+			cluster: private,
+			want:    want{endpoint: "10.0.0.2"},
+		},
+		"Both": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ConnectionEndpoint = gcp.StringPtr(v1beta2.ConnectionEndpointBoth)
+			}),
+			cluster: private,
+			want:    want{endpoint: "35.0.0.1", private: "10.0.0.2"},
+		},
+		"NoPrivateEndpoint": {
+			params: params(func(p *v1beta2.ClusterParameters) {
+				p.ConnectionEndpoint = gcp.StringPtr(v1beta2.ConnectionEndpointPrivate)
+			}),
+			cluster: cluster(func(c *container.Cluster) {
+				c.Endpoint = "35.0.0.1"
+			}),
+			want: want{endpoint: "35.0.0.1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			endpoint, private := ConnectionEndpoints(tc.params, tc.cluster)
+			if diff := cmp.Diff(tc.want, want{endpoint: endpoint, private: private}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("ConnectionEndpoints(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateClientConfig(t *testing.T) {
 	name := "gke-cluster"
 	endpoint := "endpoint"
 	username := "username"
 	password := "password"
+	token := "token"
 	clusterCA, _ := base64.StdEncoding.DecodeString("clusterCA")
 	clientCert, _ := base64.StdEncoding.DecodeString("clientCert")
 	clientKey, _ := base64.StdEncoding.DecodeString("clientKey")
@@ -1750,10 +2173,7 @@ func TestGenerateClientConfig(t *testing.T) {
 					},
 					AuthInfos: map[string]*clientcmdapi.AuthInfo{
 						name: {
-							Username:              username,
-							Password:              password,
-							ClientKeyData:         clientKey,
-							ClientCertificateData: clientCert,
+							Token: token,
 						},
 					},
 					CurrentContext: name,
@@ -1771,7 +2191,7 @@ func TestGenerateClientConfig(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got, err := GenerateClientConfig(tc.in)
+			got, err := GenerateClientConfig(tc.in, tc.in.Endpoint, token)
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
 				t.Errorf("GenerateClientConfig(...): -want error, +got error:\n%s", diff)
 				return
@@ -1783,3 +2203,39 @@ func TestGenerateClientConfig(t *testing.T) {
 	}
 
 }
+
+func TestGenerateExecClientConfig(t *testing.T) {
+	name := "gke-cluster"
+	endpoint := "endpoint"
+	clusterCA, _ := base64.StdEncoding.DecodeString("clusterCA")
+
+	in := &container.Cluster{
+		Name:     name,
+		Endpoint: endpoint,
+		MasterAuth: &container.MasterAuth{
+			ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+		},
+	}
+	want := &clientcmdapi.AuthInfo{
+		Exec: &clientcmdapi.ExecConfig{
+			APIVersion:         AuthPluginAPIVersion,
+			Command:            AuthPluginCommand,
+			InstallHint:        AuthPluginInstallHint,
+			ProvideClusterInfo: true,
+		},
+	}
+
+	got, err := GenerateExecClientConfig(in, "10.0.0.2")
+	if err != nil {
+		t.Fatalf("GenerateExecClientConfig(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff("https://10.0.0.2", got.Clusters[name].Server); diff != "" {
+		t.Errorf("GenerateExecClientConfig(...): -want server, +got server:\n%s", diff)
+	}
+	if diff := cmp.Diff(want, got.AuthInfos[name]); diff != "" {
+		t.Errorf("GenerateExecClientConfig(...): -want auth info, +got auth info:\n%s", diff)
+	}
+	if diff := cmp.Diff(clusterCA, got.Clusters[name].CertificateAuthorityData); diff != "" {
+		t.Errorf("GenerateExecClientConfig(...): -want CA, +got CA:\n%s", diff)
+	}
+}
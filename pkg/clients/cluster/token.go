@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const (
+	// AuthPluginCommand is the command of the kubectl credential plugin for
+	// GKE.
+	AuthPluginCommand = "gke-gcloud-auth-plugin"
+
+	// AuthPluginAPIVersion is the client authentication API version the
+	// GKE credential plugin speaks.
+	AuthPluginAPIVersion = "client.authentication.k8s.io/v1beta1"
+
+	// AuthPluginInstallHint is shown by kubectl when the GKE credential
+	// plugin is missing.
+	AuthPluginInstallHint = "Install gke-gcloud-auth-plugin to use this kubeconfig"
+
+	// TokenRefreshWindow is how long before its expiry a cached access
+	// token is replaced, so that a published token stays valid until the
+	// next time it is observed.
+	TokenRefreshWindow = 15 * time.Minute
+)
+
+// TokenScopes are the OAuth2 scopes of the access tokens used to
+// authenticate to GKE clusters. GKE only needs the identity of the caller, so
+// the published tokens cannot be used to call other Google Cloud APIs.
+var TokenScopes = []string{
+	"https://www.googleapis.com/auth/userinfo.email",
+}
+
+const errMintToken = "cannot mint access token"
+
+// A TokenCache caches OAuth2 access tokens per set of credentials. A new token
+// is only minted once the cached one is about to expire.
+type TokenCache struct {
+	mu     sync.Mutex
+	tokens map[[sha256.Size]byte]*oauth2.Token
+
+	// newSource returns a token source that mints a new access token from
+	// the supplied credentials.
+	newSource func(ctx context.Context, credentials []byte) (oauth2.TokenSource, error)
+
+	now func() time.Time
+}
+
+// NewTokenCache returns a TokenCache that mints access tokens with
+// TokenScopes.
+func NewTokenCache() *TokenCache {
+	return &TokenCache{
+		tokens: map[[sha256.Size]byte]*oauth2.Token{},
+		newSource: func(ctx context.Context, credentials []byte) (oauth2.TokenSource, error) {
+			creds, err := google.CredentialsFromJSON(ctx, credentials, TokenScopes...)
+			if err != nil {
+				return nil, err
+			}
+			return creds.TokenSource, nil
+		},
+		now: time.Now,
+	}
+}
+
+// TokenSource returns an oauth2.TokenSource that returns the cached access
+// token for the supplied credentials, minting a new one if the cached token
+// expires within TokenRefreshWindow.
+func (c *TokenCache) TokenSource(ctx context.Context, credentials []byte) oauth2.TokenSource {
+	return &cachedTokenSource{ctx: ctx, cache: c, credentials: credentials}
+}
+
+func (c *TokenCache) token(ctx context.Context, credentials []byte) (*oauth2.Token, error) {
+	key := sha256.Sum256(credentials)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if t, ok := c.tokens[key]; ok && t.Expiry.After(c.now().Add(TokenRefreshWindow)) {
+		return t, nil
+	}
+	// A fresh token source is used every time because Google token sources
+	// reuse their token until it is about to expire.
+	src, err := c.newSource(ctx, credentials)
+	if err != nil {
+		return nil, errors.Wrap(err, errMintToken)
+	}
+	t, err := src.Token()
+	if err != nil {
+		return nil, errors.Wrap(err, errMintToken)
+	}
+	c.tokens[key] = t
+	return t, nil
+}
+
+type cachedTokenSource struct {
+	ctx         context.Context
+	cache       *TokenCache
+	credentials []byte
+}
+
+func (s *cachedTokenSource) Token() (*oauth2.Token, error) {
+	return s.cache.token(s.ctx, s.credentials)
+}
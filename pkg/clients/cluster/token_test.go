@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestTokenCacheToken(t *testing.T) {
+	errBoom := errors.New("boom")
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	credentials := []byte("credentials")
+	fresh := &oauth2.Token{AccessToken: "fresh", Expiry: now.Add(time.Hour)}
+
+	type want struct {
+		token *oauth2.Token
+		err   error
+	}
+	cases := map[string]struct {
+		cached *oauth2.Token
+		mint   func() (*oauth2.Token, error)
+		want   want
+	}{
+		"NotCached": {
+			mint: func() (*oauth2.Token, error) { return fresh, nil },
+			want: want{token: fresh},
+		},
+		"CachedStillValid": {
+			cached: &oauth2.Token{AccessToken: "cached", Expiry: now.Add(TokenRefreshWindow + time.Minute)},
+			mint: func() (*oauth2.Token, error) {
+				t.Errorf("token must not be minted while the cached one is valid")
+				return nil, nil
+			},
+			want: want{token: &oauth2.Token{AccessToken: "cached", Expiry: now.Add(TokenRefreshWindow + time.Minute)}},
+		},
+		"CachedAboutToExpire": {
+			cached: &oauth2.Token{AccessToken: "cached", Expiry: now.Add(TokenRefreshWindow - time.Minute)},
+			mint:   func() (*oauth2.Token, error) { return fresh, nil },
+			want:   want{token: fresh},
+		},
+		"MintFailed": {
+			mint: func() (*oauth2.Token, error) { return nil, errBoom },
+			want: want{err: errors.Wrap(errBoom, errMintToken)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewTokenCache()
+			c.now = func() time.Time { return now }
+			c.newSource = func(_ context.Context, _ []byte) (oauth2.TokenSource, error) {
+				return tokenSourceFn(tc.mint), nil
+			}
+			if tc.cached != nil {
+				c.tokens[sha256.Sum256(credentials)] = tc.cached
+			}
+			got, err := c.TokenSource(context.Background(), credentials).Token()
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Token(): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.token, got, cmp.AllowUnexported(oauth2.Token{})); diff != "" {
+				t.Errorf("Token(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type tokenSourceFn func() (*oauth2.Token, error)
+
+func (fn tokenSourceFn) Token() (*oauth2.Token, error) {
+	return fn()
+}
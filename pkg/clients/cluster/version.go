@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	fmtErrParseVersion    = "cannot parse Kubernetes version %q"
+	fmtErrMajorVersion    = "cannot change the major version of the control plane from %s to %s"
+	fmtErrMasterDowngrade = "cannot downgrade the control plane from %s to %s"
+	fmtErrMasterSkipMinor = "cannot upgrade the control plane from %s to %s, it can only be upgraded one minor version at a time"
+	fmtErrNodeVersionSkew = "node pool %s at version %s would be more than %d minor versions behind the control plane at version %s"
+)
+
+// Version aliases that GKE resolves to a version when the control plane is
+// created or upgraded.
+const (
+	VersionLatest  = "latest"
+	VersionDefault = "-"
+)
+
+// IsVersionAlias returns true if the supplied version is an alias that only
+// GKE can resolve, i.e. latest or - for the default version. Version prefixes
+// such as 1.20 are not aliases.
+func IsVersionAlias(v string) bool {
+	return v == VersionLatest || v == VersionDefault
+}
+
+// kubernetesVersion is the major and minor version of a Kubernetes version
+// such as 1.20.8-gke.900.
+type kubernetesVersion struct {
+	major int
+	minor int
+}
+
+func parseVersion(v string) (kubernetesVersion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(v, "v"), ".", 3)
+	if len(parts) < 2 {
+		return kubernetesVersion{}, errors.Errorf(fmtErrParseVersion, v)
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return kubernetesVersion{}, errors.Errorf(fmtErrParseVersion, v)
+	}
+	minor, err := strconv.Atoi(strings.SplitN(parts[1], "-", 2)[0])
+	if err != nil {
+		return kubernetesVersion{}, errors.Errorf(fmtErrParseVersion, v)
+	}
+	return kubernetesVersion{major: major, minor: minor}, nil
+}
+
+// VersionMatches returns true if the current version is the desired version,
+// or starts with it when the desired version is a prefix such as 1.20.
+func VersionMatches(desired, current string) bool {
+	return current == desired || strings.HasPrefix(current, desired+".") || strings.HasPrefix(current, desired+"-")
+}
+
+// CheckVersionSkew returns an error if upgrading the control plane of the
+// observed cluster to the desired version would violate the supplied version
+// skew policy, or the one minor version at a time rule of GKE.
+func CheckVersionSkew(desired string, policy *v1beta2.VersionSkewPolicy, observed *container.Cluster) error {
+	want, err := parseVersion(desired)
+	if err != nil {
+		return err
+	}
+	current, err := parseVersion(observed.CurrentMasterVersion)
+	if err != nil {
+		return err
+	}
+	switch {
+	case want.major != current.major:
+		return errors.Errorf(fmtErrMajorVersion, observed.CurrentMasterVersion, desired)
+	case want.minor < current.minor:
+		return errors.Errorf(fmtErrMasterDowngrade, observed.CurrentMasterVersion, desired)
+	case want.minor > current.minor+1:
+		return errors.Errorf(fmtErrMasterSkipMinor, observed.CurrentMasterVersion, desired)
+	}
+	skew := v1beta2.DefaultMaxNodeMinorVersionSkew
+	if policy != nil && policy.MaxNodeMinorVersionSkew != nil {
+		skew = *policy.MaxNodeMinorVersionSkew
+	}
+	for _, pool := range observed.NodePools {
+		if pool == nil || pool.Version == "" {
+			continue
+		}
+		v, err := parseVersion(pool.Version)
+		if err != nil {
+			return err
+		}
+		if v.major != want.major || int64(want.minor-v.minor) > skew {
+			return errors.Errorf(fmtErrNodeVersionSkew, pool.Name, pool.Version, skew, desired)
+		}
+	}
+	return nil
+}
+
+// GenerateMasterUpgradeStatus reports the progress of the upgrade of the
+// control plane of the observed cluster to the desired master version. The
+// supplied operation is the last update operation of the cluster, and the
+// supplied status the one previously reported. The version an alias resolves
+// to is not known, so the control plane runs the version of an alias once it
+// has been upgraded to it.
+func GenerateMasterUpgradeStatus(in *v1beta2.ClusterParameters, observed *container.Cluster, op *v1beta2.ClusterOperation, previous *v1beta2.MasterUpgradeStatus) *v1beta2.MasterUpgradeStatus {
+	if in.MasterVersion == nil {
+		return nil
+	}
+	s := &v1beta2.MasterUpgradeStatus{DesiredVersion: *in.MasterVersion}
+	switch {
+	case op != nil && op.Update == UpdateMasterVersion && IsOperationActive(op):
+		s.State = v1beta2.MasterUpgradeStateInProgress
+	case IsVersionAlias(*in.MasterVersion):
+		s.State = v1beta2.MasterUpgradeStatePending
+		if previous != nil && previous.DesiredVersion == *in.MasterVersion &&
+			(previous.State == v1beta2.MasterUpgradeStateInProgress || previous.State == v1beta2.MasterUpgradeStateComplete) {
+			s.State = v1beta2.MasterUpgradeStateComplete
+		}
+	case VersionMatches(*in.MasterVersion, observed.CurrentMasterVersion):
+		s.State = v1beta2.MasterUpgradeStateComplete
+	default:
+		s.State = v1beta2.MasterUpgradeStatePending
+		if err := CheckVersionSkew(*in.MasterVersion, in.VersionSkewPolicy, observed); err != nil {
+			s.State = v1beta2.MasterUpgradeStateRefused
+			s.Message = err.Error()
+		}
+	}
+	return s
+}
+
+// FilterMasterUpgrade returns the supplied updates without the upgrade of the
+// control plane unless the supplied status reports it as pending.
+func FilterMasterUpgrade(updates []Update, s *v1beta2.MasterUpgradeStatus) []Update {
+	if s != nil && s.State == v1beta2.MasterUpgradeStatePending {
+		return updates
+	}
+	out := make([]Update, 0, len(updates))
+	for _, u := range updates {
+		if u.Name != UpdateMasterVersion {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// needsMasterUpgrade returns true if the control plane of the observed
+// cluster should be upgraded to the desired master version. An alias always
+// needs an upgrade; see FilterMasterUpgrade.
+func needsMasterUpgrade(in *v1beta2.ClusterParameters, observed *container.Cluster) bool {
+	switch {
+	case in.MasterVersion == nil:
+		return false
+	case IsVersionAlias(*in.MasterVersion):
+		return true
+	case VersionMatches(*in.MasterVersion, observed.CurrentMasterVersion):
+		return false
+	}
+	return CheckVersionSkew(*in.MasterVersion, in.VersionSkewPolicy, observed) == nil
+}
+
+// newMasterVersionUpdateFn returns a function that upgrades the control plane
+// of a cluster.
+func newMasterVersionUpdateFn(in *string) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		update := &container.UpdateMasterRequest{
+			MasterVersion: gcp.StringValue(in),
+		}
+		return s.Projects.Locations.Clusters.UpdateMaster(name, update).Context(ctx).Do()
+	}
+}
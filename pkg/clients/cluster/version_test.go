@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	container "google.golang.org/api/container/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+func TestVersionMatches(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		current string
+		want    bool
+	}{
+		"Exact": {
+			desired: "1.20.8-gke.900",
+			current: "1.20.8-gke.900",
+			want:    true,
+		},
+		"MinorPrefix": {
+			desired: "1.20",
+			current: "1.20.8-gke.900",
+			want:    true,
+		},
+		"PatchPrefix": {
+			desired: "1.20.8",
+			current: "1.20.8-gke.900",
+			want:    true,
+		},
+		"OtherMinor": {
+			desired: "1.2",
+			current: "1.20.8-gke.900",
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := VersionMatches(tc.desired, tc.current)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("VersionMatches(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckVersionSkew(t *testing.T) {
+	type args struct {
+		desired  string
+		policy   *v1beta2.VersionSkewPolicy
+		observed *container.Cluster
+	}
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"Allowed": {
+			args: args{
+				desired: "1.21",
+				observed: &container.Cluster{
+					CurrentMasterVersion: "1.20.8-gke.900",
+					NodePools:            []*container.NodePool{{Name: "pool", Version: "1.19.9-gke.1900"}},
+				},
+			},
+		},
+		"InvalidVersion": {
+			args: args{
+				desired:  "latest",
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: errors.Errorf(fmtErrParseVersion, "latest"),
+		},
+		"Downgrade": {
+			args: args{
+				desired:  "1.19",
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: errors.Errorf(fmtErrMasterDowngrade, "1.20.8-gke.900", "1.19"),
+		},
+		"SkipMinor": {
+			args: args{
+				desired:  "1.22",
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: errors.Errorf(fmtErrMasterSkipMinor, "1.20.8-gke.900", "1.22"),
+		},
+		"NodeSkew": {
+			args: args{
+				desired: "1.21",
+				policy:  &v1beta2.VersionSkewPolicy{MaxNodeMinorVersionSkew: gcp.Int64Ptr(1)},
+				observed: &container.Cluster{
+					CurrentMasterVersion: "1.20.8-gke.900",
+					NodePools:            []*container.NodePool{{Name: "pool", Version: "1.19.9-gke.1900"}},
+				},
+			},
+			want: errors.Errorf(fmtErrNodeVersionSkew, "pool", "1.19.9-gke.1900", 1, "1.21"),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CheckVersionSkew(tc.args.desired, tc.args.policy, tc.args.observed)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("CheckVersionSkew(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMasterUpgradeStatus(t *testing.T) {
+	type args struct {
+		in       *v1beta2.ClusterParameters
+		observed *container.Cluster
+		op       *v1beta2.ClusterOperation
+		previous *v1beta2.MasterUpgradeStatus
+	}
+	cases := map[string]struct {
+		args args
+		want *v1beta2.MasterUpgradeStatus
+	}{
+		"NoMasterVersion": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+		},
+		"Complete": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr("1.20")},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: "1.20", State: v1beta2.MasterUpgradeStateComplete},
+		},
+		"Pending": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr("1.21")},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: "1.21", State: v1beta2.MasterUpgradeStatePending},
+		},
+		"InProgress": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr("1.21")},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+				op:       &v1beta2.ClusterOperation{Name: "op", Update: UpdateMasterVersion, Status: v1beta2.OperationStateRunning},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: "1.21", State: v1beta2.MasterUpgradeStateInProgress},
+		},
+		"Refused": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr("1.22")},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: &v1beta2.MasterUpgradeStatus{
+				DesiredVersion: "1.22",
+				State:          v1beta2.MasterUpgradeStateRefused,
+				Message:        errors.Errorf(fmtErrMasterSkipMinor, "1.20.8-gke.900", "1.22").Error(),
+			},
+		},
+		"AliasPending": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr(VersionLatest)},
+				observed: &container.Cluster{CurrentMasterVersion: "1.20.8-gke.900"},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: VersionLatest, State: v1beta2.MasterUpgradeStatePending},
+		},
+		"AliasUpgraded": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr(VersionLatest)},
+				observed: &container.Cluster{CurrentMasterVersion: "1.21.1-gke.2200"},
+				op:       &v1beta2.ClusterOperation{Name: "op", Update: UpdateMasterVersion, Status: v1beta2.OperationStateDone},
+				previous: &v1beta2.MasterUpgradeStatus{DesiredVersion: VersionLatest, State: v1beta2.MasterUpgradeStateInProgress},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: VersionLatest, State: v1beta2.MasterUpgradeStateComplete},
+		},
+		"AliasChanged": {
+			args: args{
+				in:       &v1beta2.ClusterParameters{MasterVersion: gcp.StringPtr(VersionDefault)},
+				observed: &container.Cluster{CurrentMasterVersion: "1.21.1-gke.2200"},
+				previous: &v1beta2.MasterUpgradeStatus{DesiredVersion: VersionLatest, State: v1beta2.MasterUpgradeStateComplete},
+			},
+			want: &v1beta2.MasterUpgradeStatus{DesiredVersion: VersionDefault, State: v1beta2.MasterUpgradeStatePending},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateMasterUpgradeStatus(tc.args.in, tc.args.observed, tc.args.op, tc.args.previous)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateMasterUpgradeStatus(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilterMasterUpgrade(t *testing.T) {
+	updates := []Update{{Name: UpdateMasterVersion}, {Name: UpdateLocations}}
+	cases := map[string]struct {
+		status *v1beta2.MasterUpgradeStatus
+		want   []string
+	}{
+		"Pending": {
+			status: &v1beta2.MasterUpgradeStatus{State: v1beta2.MasterUpgradeStatePending},
+			want:   []string{UpdateMasterVersion, UpdateLocations},
+		},
+		"Complete": {
+			status: &v1beta2.MasterUpgradeStatus{State: v1beta2.MasterUpgradeStateComplete},
+			want:   []string{UpdateLocations},
+		},
+		"NoMasterVersion": {
+			want: []string{UpdateLocations},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := UpdateNames(FilterMasterUpgrade(updates, tc.status))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FilterMasterUpgrade(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
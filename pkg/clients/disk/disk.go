@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"path"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	errInvalidDiskURL = "disk URL must be of the form [projects/<project>/](zones/<zone>|regions/<region>)/disks/<disk>"
+)
+
+// GenerateDisk populates the supplied compute.Disk with the supplied
+// DiskParameters.
+func GenerateDisk(name string, in v1alpha1.DiskParameters, d *compute.Disk) {
+	d.Name = name
+	d.Description = gcp.StringValue(in.Description)
+	d.DiskEncryptionKey = GenerateCustomerEncryptionKey(in.DiskEncryptionKey)
+	d.Labels = in.Labels
+	d.PhysicalBlockSizeBytes = gcp.Int64Value(in.PhysicalBlockSizeBytes)
+	d.ReplicaZones = in.ReplicaZones
+	d.ResourcePolicies = in.ResourcePolicies
+	d.SizeGb = gcp.Int64Value(in.SizeGB)
+	d.SourceImage = gcp.StringValue(in.SourceImage)
+	d.SourceSnapshot = gcp.StringValue(in.SourceSnapshot)
+	d.Type = diskTypeURL(in)
+}
+
+// diskTypeURL returns the partially qualified URL of the disk type named in
+// the supplied parameters. Disk types are zonal or regional resources.
+func diskTypeURL(in v1alpha1.DiskParameters) string {
+	if in.Type == nil {
+		return ""
+	}
+	if in.Region != nil {
+		return path.Join("regions", *in.Region, "diskTypes", *in.Type)
+	}
+	return path.Join("zones", gcp.StringValue(in.Zone), "diskTypes", *in.Type)
+}
+
+// GenerateCustomerEncryptionKey returns the compute.CustomerEncryptionKey
+// that corresponds to the supplied CustomerEncryptionKey.
+func GenerateCustomerEncryptionKey(in *v1alpha1.CustomerEncryptionKey) *compute.CustomerEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	return &compute.CustomerEncryptionKey{
+		KmsKeyName:           gcp.StringValue(in.KMSKeyName),
+		KmsKeyServiceAccount: gcp.StringValue(in.KMSKeyServiceAccount),
+	}
+}
+
+// GenerateDiskObservation takes a compute.Disk and returns
+// *DiskObservation.
+func GenerateDiskObservation(observed compute.Disk) v1alpha1.DiskObservation {
+	o := v1alpha1.DiskObservation{
+		CreationTimestamp:   observed.CreationTimestamp,
+		ID:                  observed.Id,
+		LabelFingerprint:    observed.LabelFingerprint,
+		LastAttachTimestamp: observed.LastAttachTimestamp,
+		LastDetachTimestamp: observed.LastDetachTimestamp,
+		SelfLink:            observed.SelfLink,
+		SizeGB:              observed.SizeGb,
+		SourceImageID:       observed.SourceImageId,
+		SourceSnapshotID:    observed.SourceSnapshotId,
+		Status:              observed.Status,
+		Users:               observed.Users,
+	}
+	if observed.DiskEncryptionKey != nil {
+		// The API reports the CryptoKeyVersion in use rather than the
+		// CryptoKey that was requested.
+		o.KMSKeyVersion = observed.DiskEncryptionKey.KmsKeyName
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in compute.Disk
+// object. The encryption key is not late initialized because the API reports
+// a CryptoKeyVersion rather than the requested CryptoKey.
+func LateInitializeSpec(spec *v1alpha1.DiskParameters, in compute.Disk) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.PhysicalBlockSizeBytes = gcp.LateInitializeInt64(spec.PhysicalBlockSizeBytes, in.PhysicalBlockSizeBytes)
+	spec.ReplicaZones = gcp.LateInitializeStringSlice(spec.ReplicaZones, in.ReplicaZones)
+	spec.ResourcePolicies = gcp.LateInitializeStringSlice(spec.ResourcePolicies, in.ResourcePolicies)
+	spec.SizeGB = gcp.LateInitializeInt64(spec.SizeGB, in.SizeGb)
+	spec.SourceImage = gcp.LateInitializeString(spec.SourceImage, in.SourceImage)
+	spec.SourceSnapshot = gcp.LateInitializeString(spec.SourceSnapshot, in.SourceSnapshot)
+	if in.Type != "" {
+		spec.Type = gcp.LateInitializeString(spec.Type, path.Base(in.Type))
+	}
+}
+
+// An Update that must be made to bring a Disk up to date. Disks expose a
+// distinct API call per updatable field.
+type Update struct {
+	// Labels is true if the labels of the disk must be replaced.
+	Labels bool
+
+	// SizeGB is the size the disk must grow to, or zero if the disk need
+	// not grow.
+	SizeGB int64
+
+	// AddResourcePolicies that must be attached to the disk.
+	AddResourcePolicies []string
+
+	// RemoveResourcePolicies that must be detached from the disk.
+	RemoveResourcePolicies []string
+}
+
+// Any returns true if any field requires an update.
+func (u Update) Any() bool {
+	return u.Labels || u.SizeGB != 0 || len(u.AddResourcePolicies)+len(u.RemoveResourcePolicies) > 0
+}
+
+// IsShrink returns true if the supplied parameters would make the observed
+// compute.Disk smaller. Persistent disks can only ever grow.
+func IsShrink(in *v1alpha1.DiskParameters, observed *compute.Disk) bool {
+	return in.SizeGB != nil && *in.SizeGB < observed.SizeGb
+}
+
+// GetUpdate returns which fields of the observed compute.Disk differ from the
+// supplied parameters. Resource policies are compared by name, since a disk
+// may only use resource policies in its own region. A smaller size is not an
+// update; see IsShrink.
+func GetUpdate(in *v1alpha1.DiskParameters, observed *compute.Disk) Update {
+	u := Update{
+		Labels: !cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty()),
+	}
+	if in.SizeGB != nil && *in.SizeGB > observed.SizeGb {
+		u.SizeGB = *in.SizeGB
+	}
+
+	desired := make(map[string]bool, len(in.ResourcePolicies))
+	for _, rp := range in.ResourcePolicies {
+		desired[path.Base(rp)] = true
+	}
+	existing := make(map[string]bool, len(observed.ResourcePolicies))
+	for _, rp := range observed.ResourcePolicies {
+		existing[path.Base(rp)] = true
+		if !desired[path.Base(rp)] {
+			u.RemoveResourcePolicies = append(u.RemoveResourcePolicies, rp)
+		}
+	}
+	for _, rp := range in.ResourcePolicies {
+		if !existing[path.Base(rp)] {
+			u.AddResourcePolicies = append(u.AddResourcePolicies, rp)
+		}
+	}
+	return u
+}
+
+// A URL identifies a zonal or regional persistent disk. Exactly one of Zone
+// or Region is set.
+type URL struct {
+	Project string
+	Zone    string
+	Region  string
+	Disk    string
+}
+
+// ParseURL parses a fully or partially qualified disk URL, such as
+// projects/example/zones/us-central1-a/disks/eg. Project is empty if the URL
+// does not include a project.
+func ParseURL(u string) (URL, error) {
+	parts := strings.Split(strings.TrimPrefix(u, v1beta1.ComputeURIPrefix), "/")
+	d := URL{}
+	if len(parts) == 6 {
+		if parts[0] != "projects" {
+			return URL{}, errors.New(errInvalidDiskURL)
+		}
+		d.Project = parts[1]
+		parts = parts[2:]
+	}
+	if len(parts) != 4 || parts[2] != "disks" || parts[1] == "" || parts[3] == "" {
+		return URL{}, errors.New(errInvalidDiskURL)
+	}
+	switch parts[0] {
+	case "zones":
+		d.Zone = parts[1]
+	case "regions":
+		d.Region = parts[1]
+	default:
+		return URL{}, errors.New(errInvalidDiskURL)
+	}
+	d.Disk = parts[3]
+	return d, nil
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name              = "coolDisk"
+	description       = "coolDescription"
+	zone              = "us-central1-a"
+	region            = "us-central1"
+	diskType          = "pd-ssd"
+	size        int64 = 20
+	image             = "projects/debian-cloud/global/images/family/debian-10"
+	kmsKeyName        = "projects/cool/locations/us-central1/keyRings/cool/cryptoKeys/cool"
+	policy            = "projects/cool/regions/us-central1/resourcePolicies/daily"
+	labels            = map[string]string{"cool": "true"}
+
+	timestamp        = "coolTime"
+	link             = "coolLink"
+	users            = []string{"coolInstance"}
+	id        uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.DiskParameters)) *v1alpha1.DiskParameters {
+	o := &v1alpha1.DiskParameters{
+		Zone:              &zone,
+		Description:       &description,
+		DiskEncryptionKey: &v1alpha1.CustomerEncryptionKey{KMSKeyName: &kmsKeyName},
+		Labels:            labels,
+		ResourcePolicies:  []string{policy},
+		SizeGB:            &size,
+		SourceImage:       &image,
+		Type:              &diskType,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func disk(m ...func(*compute.Disk)) *compute.Disk {
+	o := &compute.Disk{
+		Name:              name,
+		Description:       description,
+		DiskEncryptionKey: &compute.CustomerEncryptionKey{KmsKeyName: kmsKeyName},
+		Labels:            labels,
+		ResourcePolicies:  []string{policy},
+		SizeGb:            size,
+		SourceImage:       image,
+		Type:              "zones/" + zone + "/diskTypes/" + diskType,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateDisk(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.DiskParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.Disk
+	}{
+		"Zonal": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: disk(),
+		},
+		"Regional": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.DiskParameters) {
+					p.Zone = nil
+					p.Region = &region
+					p.ReplicaZones = []string{"zones/us-central1-a", "zones/us-central1-b"}
+				}),
+			},
+			want: disk(func(d *compute.Disk) {
+				d.ReplicaZones = []string{"zones/us-central1-a", "zones/us-central1-b"}
+				d.Type = "regions/" + region + "/diskTypes/" + diskType
+			}),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.DiskParameters) {
+					p.DiskEncryptionKey = nil
+					p.Type = nil
+				}),
+			},
+			want: disk(func(d *compute.Disk) {
+				d.DiskEncryptionKey = nil
+				d.Type = ""
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.Disk{}
+			GenerateDisk(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(r, tc.want); diff != "" {
+				t.Errorf("GenerateDisk(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDiskObservation(t *testing.T) {
+	cases := map[string]struct {
+		in  compute.Disk
+		out v1alpha1.DiskObservation
+	}{
+		"AllFilled": {
+			in: *disk(func(d *compute.Disk) {
+				d.CreationTimestamp = timestamp
+				d.DiskEncryptionKey.KmsKeyName = kmsKeyName + "/cryptoKeyVersions/1"
+				d.Id = id
+				d.SelfLink = link
+				d.Status = v1alpha1.DiskStatusReady
+				d.Users = users
+			}),
+			out: v1alpha1.DiskObservation{
+				CreationTimestamp: timestamp,
+				ID:                id,
+				KMSKeyVersion:     kmsKeyName + "/cryptoKeyVersions/1",
+				SelfLink:          link,
+				SizeGB:            size,
+				Status:            v1alpha1.DiskStatusReady,
+				Users:             users,
+			},
+		},
+		"NoEncryptionKey": {
+			in: *disk(func(d *compute.Disk) {
+				d.DiskEncryptionKey = nil
+			}),
+			out: v1alpha1.DiskObservation{
+				SizeGB: size,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateDiskObservation(tc.in)
+			if diff := cmp.Diff(r, tc.out); diff != "" {
+				t.Errorf("GenerateDiskObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.DiskParameters
+		in   compute.Disk
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.DiskParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: params(),
+				in:   *disk(),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.DiskParameters) {
+					p.SizeGB = nil
+					p.Type = nil
+				}),
+				in: *disk(func(d *compute.Disk) {
+					d.Type = "https://www.googleapis.com/compute/v1/projects/cool/zones/" + zone + "/diskTypes/" + diskType
+				}),
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetUpdate(t *testing.T) {
+	type args struct {
+		in       *v1alpha1.DiskParameters
+		observed *compute.Disk
+	}
+	cases := map[string]struct {
+		args   args
+		want   Update
+		shrink bool
+	}{
+		"UpToDate": {
+			args: args{
+				in: params(),
+				observed: disk(func(d *compute.Disk) {
+					d.ResourcePolicies = []string{"https://www.googleapis.com/compute/v1/" + policy}
+				}),
+			},
+			want: Update{},
+		},
+		"Grow": {
+			args: args{
+				in: params(),
+				observed: disk(func(d *compute.Disk) {
+					d.SizeGb = 10
+				}),
+			},
+			want: Update{SizeGB: size},
+		},
+		"Shrink": {
+			args: args{
+				in: params(),
+				observed: disk(func(d *compute.Disk) {
+					d.SizeGb = 30
+				}),
+			},
+			want:   Update{},
+			shrink: true,
+		},
+		"LabelsAndResourcePolicies": {
+			args: args{
+				in: params(),
+				observed: disk(func(d *compute.Disk) {
+					d.Labels = nil
+					d.ResourcePolicies = []string{"projects/cool/regions/us-central1/resourcePolicies/weekly"}
+				}),
+			},
+			want: Update{
+				Labels:                 true,
+				AddResourcePolicies:    []string{policy},
+				RemoveResourcePolicies: []string{"projects/cool/regions/us-central1/resourcePolicies/weekly"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetUpdate(tc.args.in, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetUpdate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.shrink, IsShrink(tc.args.in, tc.args.observed)); diff != "" {
+				t.Errorf("IsShrink(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseURL(t *testing.T) {
+	type want struct {
+		url URL
+		err error
+	}
+	cases := map[string]struct {
+		in   string
+		want want
+	}{
+		"FullyQualifiedZonal": {
+			in:   "https://www.googleapis.com/compute/v1/projects/cool/zones/us-central1-a/disks/coolDisk",
+			want: want{url: URL{Project: "cool", Zone: "us-central1-a", Disk: "coolDisk"}},
+		},
+		"PartiallyQualifiedRegional": {
+			in:   "projects/cool/regions/us-central1/disks/coolDisk",
+			want: want{url: URL{Project: "cool", Region: "us-central1", Disk: "coolDisk"}},
+		},
+		"NoProject": {
+			in:   "zones/us-central1-a/disks/coolDisk",
+			want: want{url: URL{Zone: "us-central1-a", Disk: "coolDisk"}},
+		},
+		"Unqualified": {
+			in:   "coolDisk",
+			want: want{err: errors.New(errInvalidDiskURL)},
+		},
+		"NotADisk": {
+			in:   "projects/cool/zones/us-central1-a/instances/coolInstance",
+			want: want{err: errors.New(errInvalidDiskURL)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseURL(tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseURL(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.url, got); diff != "" {
+				t.Errorf("ParseURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
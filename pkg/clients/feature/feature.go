@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	// ParentFormat is the format for the parent of a feature.
+	ParentFormat = "projects/%s/locations/%s"
+
+	// NameFormat is the format for the fully qualified name of a feature.
+	NameFormat = ParentFormat + "/features/%s"
+)
+
+// Client should be satisfied to conduct Feature operations.
+type Client interface {
+	Create(parent string, feature *gkehub.Feature) *gkehub.ProjectsLocationsFeaturesCreateCall
+	Get(name string) *gkehub.ProjectsLocationsFeaturesGetCall
+	Patch(name string, feature *gkehub.Feature) *gkehub.ProjectsLocationsFeaturesPatchCall
+	Delete(name string) *gkehub.ProjectsLocationsFeaturesDeleteCall
+}
+
+// GenerateFeature generates *gkehub.Feature instance from FeatureParameters.
+func GenerateFeature(in v1alpha1.FeatureParameters, f *gkehub.Feature) {
+	f.Labels = in.Labels
+}
+
+// GenerateObservation produces FeatureObservation object from
+// *gkehub.Feature object.
+func GenerateObservation(in gkehub.Feature) v1alpha1.FeatureObservation {
+	o := v1alpha1.FeatureObservation{
+		Name:       in.Name,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+	}
+	if in.ResourceState != nil {
+		o.ResourceState = in.ResourceState.State
+	}
+	if in.State != nil && in.State.State != nil {
+		o.State = &v1alpha1.FeatureState{
+			Code:        in.State.State.Code,
+			Description: in.State.State.Description,
+			UpdateTime:  in.State.State.UpdateTime,
+		}
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// *gkehub.Feature object.
+func LateInitializeSpec(spec *v1alpha1.FeatureParameters, in gkehub.Feature) {
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(in *v1alpha1.FeatureParameters, observed *gkehub.Feature) bool {
+	return cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// feature.
+func GetFullyQualifiedParent(project string, p v1alpha1.FeatureParameters) string {
+	return fmt.Sprintf(ParentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a feature.
+func GetFullyQualifiedName(project string, p v1alpha1.FeatureParameters, name string) string {
+	return fmt.Sprintf(NameFormat, project, p.Location, name)
+}
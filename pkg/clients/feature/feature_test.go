@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+)
+
+func TestGenerateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   gkehub.Feature
+		want v1alpha1.FeatureObservation
+	}{
+		"Empty": {
+			in:   gkehub.Feature{},
+			want: v1alpha1.FeatureObservation{},
+		},
+		"Full": {
+			in: gkehub.Feature{
+				Name:          "projects/cool-project/locations/global/features/configmanagement",
+				CreateTime:    "2021-06-01T00:00:00Z",
+				ResourceState: &gkehub.FeatureResourceState{State: v1alpha1.FeatureResourceStateActive},
+				State: &gkehub.CommonFeatureState{
+					State: &gkehub.FeatureState{Code: "OK", Description: "All good."},
+				},
+			},
+			want: v1alpha1.FeatureObservation{
+				Name:          "projects/cool-project/locations/global/features/configmanagement",
+				CreateTime:    "2021-06-01T00:00:00Z",
+				ResourceState: v1alpha1.FeatureResourceStateActive,
+				State:         &v1alpha1.FeatureState{Code: "OK", Description: "All good."},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateObservation(tc.in)); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.FeatureParameters
+		observed *gkehub.Feature
+		want     bool
+	}{
+		"UpToDate": {
+			in:       &v1alpha1.FeatureParameters{},
+			observed: &gkehub.Feature{Labels: map[string]string{}},
+			want:     true,
+		},
+		"LabelsChanged": {
+			in:       &v1alpha1.FeatureParameters{Labels: map[string]string{"cool": "label"}},
+			observed: &gkehub.Feature{},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(tc.in, tc.observed)); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
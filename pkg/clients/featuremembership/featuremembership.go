@@ -0,0 +1,232 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featuremembership
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	// FeatureNameFormat is the format for the fully qualified name of a
+	// feature.
+	FeatureNameFormat = "projects/%s/locations/%s/features/%s"
+
+	// MembershipNameFormat is the format for the fully qualified name of a
+	// membership.
+	MembershipNameFormat = "projects/%s/locations/%s/memberships/%s"
+
+	// UpdateMask is the update mask used to configure a feature for a
+	// membership. The API merges the supplied membership specs with the
+	// existing ones.
+	UpdateMask = "membershipSpecs"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// Client should be satisfied to conduct FeatureMembership operations.
+type Client interface {
+	Get(name string) *gkehub.ProjectsLocationsFeaturesGetCall
+	Patch(name string, feature *gkehub.Feature) *gkehub.ProjectsLocationsFeaturesPatchCall
+}
+
+// GetFullyQualifiedFeatureName builds the fully qualified name of the feature
+// of a feature membership.
+func GetFullyQualifiedFeatureName(project string, p v1alpha1.FeatureMembershipParameters) string {
+	return fmt.Sprintf(FeatureNameFormat, project, p.Location, gcp.StringValue(p.Feature))
+}
+
+// GetFullyQualifiedMembershipName builds the fully qualified name of the
+// membership of a feature membership.
+func GetFullyQualifiedMembershipName(project string, p v1alpha1.FeatureMembershipParameters) string {
+	return fmt.Sprintf(MembershipNameFormat, project, p.Location, gcp.StringValue(p.Membership))
+}
+
+// isMembershipKey returns true if the supplied key of the membership specs or
+// states of a feature refers to the membership of the supplied parameters.
+// The API returns keys with the project number rather than the project ID, so
+// only the location and membership are compared.
+func isMembershipKey(key string, p v1alpha1.FeatureMembershipParameters) bool {
+	return strings.HasSuffix(key, fmt.Sprintf("/locations/%s/memberships/%s", p.Location, gcp.StringValue(p.Membership)))
+}
+
+// FindMembershipSpec returns the spec of the supplied feature for the
+// membership of the supplied parameters, or nil if the feature is not
+// configured for the membership.
+func FindMembershipSpec(f *gkehub.Feature, p v1alpha1.FeatureMembershipParameters) *gkehub.MembershipFeatureSpec {
+	for k, s := range f.MembershipSpecs {
+		if !isMembershipKey(k, p) {
+			continue
+		}
+		if cmp.Equal(s, gkehub.MembershipFeatureSpec{}, cmpopts.EquateEmpty()) {
+			return nil
+		}
+		s := s
+		return &s
+	}
+	return nil
+}
+
+// FindMembershipState returns the state of the supplied feature for the
+// membership of the supplied parameters, or nil if there is none.
+func FindMembershipState(f *gkehub.Feature, p v1alpha1.FeatureMembershipParameters) *gkehub.MembershipFeatureState {
+	for k, s := range f.MembershipStates {
+		if isMembershipKey(k, p) {
+			s := s
+			return &s
+		}
+	}
+	return nil
+}
+
+// GenerateMembershipSpec generates *gkehub.MembershipFeatureSpec instance from
+// FeatureMembershipParameters.
+func GenerateMembershipSpec(in v1alpha1.FeatureMembershipParameters, spec *gkehub.MembershipFeatureSpec) { // nolint:gocyclo
+	if in.ConfigManagement == nil {
+		return
+	}
+	if spec.Configmanagement == nil {
+		spec.Configmanagement = &gkehub.ConfigManagementMembershipSpec{}
+	}
+	cm := spec.Configmanagement
+	cm.Version = gcp.StringValue(in.ConfigManagement.Version)
+
+	if cs := in.ConfigManagement.ConfigSync; cs != nil {
+		if cm.ConfigSync == nil {
+			cm.ConfigSync = &gkehub.ConfigManagementConfigSync{}
+		}
+		cm.ConfigSync.Enabled = gcp.BoolValue(cs.Enabled)
+		cm.ConfigSync.SourceFormat = gcp.StringValue(cs.SourceFormat)
+		cm.ConfigSync.PreventDrift = gcp.BoolValue(cs.PreventDrift)
+		if cs.Git != nil {
+			if cm.ConfigSync.Git == nil {
+				cm.ConfigSync.Git = &gkehub.ConfigManagementGitConfig{}
+			}
+			cm.ConfigSync.Git.SyncRepo = cs.Git.SyncRepo
+			cm.ConfigSync.Git.SyncBranch = gcp.StringValue(cs.Git.SyncBranch)
+			cm.ConfigSync.Git.SyncRev = gcp.StringValue(cs.Git.SyncRev)
+			cm.ConfigSync.Git.PolicyDir = gcp.StringValue(cs.Git.PolicyDir)
+			cm.ConfigSync.Git.SyncWaitSecs = gcp.Int64Value(cs.Git.SyncWaitSecs)
+			cm.ConfigSync.Git.SecretType = gcp.StringValue(cs.Git.SecretType)
+			cm.ConfigSync.Git.HttpsProxy = gcp.StringValue(cs.Git.HTTPSProxy)
+			cm.ConfigSync.Git.GcpServiceAccountEmail = gcp.StringValue(cs.Git.GCPServiceAccountEmail)
+		}
+	}
+
+	if pc := in.ConfigManagement.PolicyController; pc != nil {
+		if cm.PolicyController == nil {
+			cm.PolicyController = &gkehub.ConfigManagementPolicyController{}
+		}
+		cm.PolicyController.Enabled = gcp.BoolValue(pc.Enabled)
+		cm.PolicyController.TemplateLibraryInstalled = gcp.BoolValue(pc.TemplateLibraryInstalled)
+		cm.PolicyController.AuditIntervalSeconds = gcp.Int64Value(pc.AuditIntervalSeconds)
+		cm.PolicyController.ExemptableNamespaces = pc.ExemptableNamespaces
+		cm.PolicyController.LogDeniesEnabled = gcp.BoolValue(pc.LogDeniesEnabled)
+		cm.PolicyController.ReferentialRulesEnabled = gcp.BoolValue(pc.ReferentialRulesEnabled)
+		cm.PolicyController.MutationEnabled = gcp.BoolValue(pc.MutationEnabled)
+	}
+}
+
+// GenerateObservation produces FeatureMembershipObservation object from the
+// supplied *gkehub.MembershipFeatureState object.
+func GenerateObservation(in *gkehub.MembershipFeatureState) v1alpha1.FeatureMembershipObservation {
+	o := v1alpha1.FeatureMembershipObservation{}
+	if in == nil {
+		return o
+	}
+	if in.State != nil {
+		o.State = &v1alpha1.FeatureState{
+			Code:        in.State.Code,
+			Description: in.State.Description,
+			UpdateTime:  in.State.UpdateTime,
+		}
+	}
+	if cm := in.Configmanagement; cm != nil {
+		if cm.ConfigSyncState != nil && cm.ConfigSyncState.SyncState != nil {
+			o.ConfigSyncState = cm.ConfigSyncState.SyncState.Code
+		}
+		if cm.PolicyControllerState != nil && cm.PolicyControllerState.DeploymentState != nil {
+			o.PolicyControllerState = cm.PolicyControllerState.DeploymentState.GatekeeperControllerManagerState
+		}
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in the supplied
+// *gkehub.MembershipFeatureSpec object.
+func LateInitializeSpec(spec *v1alpha1.FeatureMembershipParameters, in gkehub.MembershipFeatureSpec) { // nolint:gocyclo
+	if spec.ConfigManagement == nil || in.Configmanagement == nil {
+		return
+	}
+	cm := in.Configmanagement
+	spec.ConfigManagement.Version = gcp.LateInitializeString(spec.ConfigManagement.Version, cm.Version)
+
+	if cs := spec.ConfigManagement.ConfigSync; cs != nil && cm.ConfigSync != nil {
+		cs.Enabled = gcp.LateInitializeBool(cs.Enabled, cm.ConfigSync.Enabled)
+		cs.SourceFormat = gcp.LateInitializeString(cs.SourceFormat, cm.ConfigSync.SourceFormat)
+		cs.PreventDrift = gcp.LateInitializeBool(cs.PreventDrift, cm.ConfigSync.PreventDrift)
+		if cs.Git != nil && cm.ConfigSync.Git != nil {
+			cs.Git.SyncBranch = gcp.LateInitializeString(cs.Git.SyncBranch, cm.ConfigSync.Git.SyncBranch)
+			cs.Git.SyncRev = gcp.LateInitializeString(cs.Git.SyncRev, cm.ConfigSync.Git.SyncRev)
+			cs.Git.PolicyDir = gcp.LateInitializeString(cs.Git.PolicyDir, cm.ConfigSync.Git.PolicyDir)
+			cs.Git.SyncWaitSecs = gcp.LateInitializeInt64(cs.Git.SyncWaitSecs, cm.ConfigSync.Git.SyncWaitSecs)
+			cs.Git.SecretType = gcp.LateInitializeString(cs.Git.SecretType, cm.ConfigSync.Git.SecretType)
+		}
+	}
+
+	if pc := spec.ConfigManagement.PolicyController; pc != nil && cm.PolicyController != nil {
+		pc.Enabled = gcp.LateInitializeBool(pc.Enabled, cm.PolicyController.Enabled)
+		pc.TemplateLibraryInstalled = gcp.LateInitializeBool(pc.TemplateLibraryInstalled, cm.PolicyController.TemplateLibraryInstalled)
+		pc.AuditIntervalSeconds = gcp.LateInitializeInt64(pc.AuditIntervalSeconds, cm.PolicyController.AuditIntervalSeconds)
+		pc.ExemptableNamespaces = gcp.LateInitializeStringSlice(pc.ExemptableNamespaces, cm.PolicyController.ExemptableNamespaces)
+	}
+}
+
+// IsUpToDate checks whether the observed membership spec is up-to-date
+// compared to the given set of parameters.
+func IsUpToDate(in *v1alpha1.FeatureMembershipParameters, observed *gkehub.MembershipFeatureSpec) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*gkehub.MembershipFeatureSpec)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateMembershipSpec(*in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty()), nil
+}
+
+// GenerateFeatureUpdate returns a feature that configures the membership of
+// the supplied parameters with the supplied spec when patched with the
+// UpdateMask.
+func GenerateFeatureUpdate(project string, in v1alpha1.FeatureMembershipParameters, spec gkehub.MembershipFeatureSpec) *gkehub.Feature {
+	return &gkehub.Feature{
+		MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+			GetFullyQualifiedMembershipName(project, in): spec,
+		},
+	}
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package featuremembership
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	project = "cool-project"
+
+	// The API returns membership keys with the project number.
+	membershipKey = "projects/123456789/locations/global/memberships/cool-membership"
+	repo          = "https://github.com/cool/repo"
+)
+
+func params(m ...func(*v1alpha1.FeatureMembershipParameters)) *v1alpha1.FeatureMembershipParameters {
+	p := &v1alpha1.FeatureMembershipParameters{
+		Location:   "global",
+		Feature:    gcp.StringPtr("configmanagement"),
+		Membership: gcp.StringPtr("cool-membership"),
+		ConfigManagement: &v1alpha1.ConfigManagement{
+			Version: gcp.StringPtr("1.12.0"),
+			ConfigSync: &v1alpha1.ConfigSync{
+				SourceFormat: gcp.StringPtr("unstructured"),
+				Git: &v1alpha1.GitConfig{
+					SyncRepo:   repo,
+					SecretType: gcp.StringPtr("none"),
+				},
+			},
+			PolicyController: &v1alpha1.PolicyController{
+				Enabled:                  gcp.BoolPtr(true),
+				TemplateLibraryInstalled: gcp.BoolPtr(true),
+			},
+		},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func spec(m ...func(*gkehub.MembershipFeatureSpec)) *gkehub.MembershipFeatureSpec {
+	s := &gkehub.MembershipFeatureSpec{
+		Configmanagement: &gkehub.ConfigManagementMembershipSpec{
+			Version: "1.12.0",
+			ConfigSync: &gkehub.ConfigManagementConfigSync{
+				SourceFormat: "unstructured",
+				Git: &gkehub.ConfigManagementGitConfig{
+					SyncRepo:   repo,
+					SecretType: "none",
+				},
+			},
+			PolicyController: &gkehub.ConfigManagementPolicyController{
+				Enabled:                  true,
+				TemplateLibraryInstalled: true,
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func withDefaults(s *gkehub.MembershipFeatureSpec) {
+	s.Configmanagement.ConfigSync.Git.SyncBranch = "master"
+	s.Configmanagement.ConfigSync.Git.SyncRev = "HEAD"
+	s.Configmanagement.ConfigSync.Git.SyncWaitSecs = 15
+	s.Configmanagement.PolicyController.AuditIntervalSeconds = 60
+}
+
+func withLateInitialized(p *v1alpha1.FeatureMembershipParameters) {
+	p.ConfigManagement.ConfigSync.Git.SyncBranch = gcp.StringPtr("master")
+	p.ConfigManagement.ConfigSync.Git.SyncRev = gcp.StringPtr("HEAD")
+	p.ConfigManagement.ConfigSync.Git.SyncWaitSecs = gcp.Int64Ptr(15)
+	p.ConfigManagement.PolicyController.AuditIntervalSeconds = gcp.Int64Ptr(60)
+}
+
+func TestFindMembershipSpec(t *testing.T) {
+	cases := map[string]struct {
+		feature *gkehub.Feature
+		want    *gkehub.MembershipFeatureSpec
+	}{
+		"Found": {
+			feature: &gkehub.Feature{MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+				"projects/123456789/locations/global/memberships/other-membership": {},
+				membershipKey: *spec(),
+			}},
+			want: spec(),
+		},
+		"Empty": {
+			feature: &gkehub.Feature{MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+				membershipKey: {},
+			}},
+			want: nil,
+		},
+		"NotFound": {
+			feature: &gkehub.Feature{},
+			want:    nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, FindMembershipSpec(tc.feature, *params())); diff != "" {
+				t.Errorf("FindMembershipSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMembershipSpec(t *testing.T) {
+	got := &gkehub.MembershipFeatureSpec{}
+	GenerateMembershipSpec(*params(), got)
+	if diff := cmp.Diff(spec(), got); diff != "" {
+		t.Errorf("GenerateMembershipSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   *gkehub.MembershipFeatureState
+		want v1alpha1.FeatureMembershipObservation
+	}{
+		"NoState": {
+			in:   nil,
+			want: v1alpha1.FeatureMembershipObservation{},
+		},
+		"ConfigManagement": {
+			in: &gkehub.MembershipFeatureState{
+				State: &gkehub.FeatureState{Code: "OK"},
+				Configmanagement: &gkehub.ConfigManagementMembershipState{
+					ConfigSyncState: &gkehub.ConfigManagementConfigSyncState{
+						SyncState: &gkehub.ConfigManagementSyncState{Code: "SYNCED"},
+					},
+					PolicyControllerState: &gkehub.ConfigManagementPolicyControllerState{
+						DeploymentState: &gkehub.ConfigManagementGatekeeperDeploymentState{GatekeeperControllerManagerState: "INSTALLED"},
+					},
+				},
+			},
+			want: v1alpha1.FeatureMembershipObservation{
+				State:                 &v1alpha1.FeatureState{Code: "OK"},
+				ConfigSyncState:       "SYNCED",
+				PolicyControllerState: "INSTALLED",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateObservation(tc.in)); diff != "" {
+				t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	got := params()
+	LateInitializeSpec(got, *spec(withDefaults))
+	if diff := cmp.Diff(params(withLateInitialized), got); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.FeatureMembershipParameters
+		observed *gkehub.MembershipFeatureSpec
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(withLateInitialized),
+			observed: spec(withDefaults),
+			want:     true,
+		},
+		"NeedsUpdate": {
+			in: params(func(p *v1alpha1.FeatureMembershipParameters) {
+				p.ConfigManagement.PolicyController.Enabled = gcp.BoolPtr(false)
+			}),
+			observed: spec(),
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateFeatureUpdate(t *testing.T) {
+	want := &gkehub.Feature{MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{
+		"projects/cool-project/locations/global/memberships/cool-membership": *spec(),
+	}}
+	if diff := cmp.Diff(want, GenerateFeatureUpdate(project, *params(), *spec())); diff != "" {
+		t.Errorf("GenerateFeatureUpdate(...): -want, +got:\n%s", diff)
+	}
+}
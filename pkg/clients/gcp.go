@@ -43,11 +43,23 @@ import (
 // to use when the controller connects to GCP API in order to reconcile the managed
 // resource.
 func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+	projectID, data, err := GetCredentials(ctx, c, mg)
+	if err != nil {
+		return "", nil, err
+	}
+	return projectID, option.WithCredentialsJSON(data), nil
+}
+
+// GetCredentials returns the project ID and the raw JSON credentials of the
+// provider configuration of the managed resource. It is useful when the
+// credentials are needed for more than connecting to GCP API, e.g. to mint
+// access tokens.
+func GetCredentials(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, data []byte, err error) {
 	switch {
 	case mg.GetProviderConfigReference() != nil:
-		return UseProviderConfig(ctx, c, mg)
+		return providerConfigCredentials(ctx, c, mg)
 	case mg.GetProviderReference() != nil:
-		return UseProvider(ctx, c, mg)
+		return providerCredentials(ctx, c, mg)
 	default:
 		return "", nil, errors.New("neither providerConfigRef nor providerRef is given")
 	}
@@ -56,6 +68,23 @@ func GetAuthInfo(ctx context.Context, c client.Client, mg resource.Managed) (pro
 // UseProvider to return GCP authentication information.
 // Deprecated: Use UseProviderConfig
 func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+	projectID, data, err := providerCredentials(ctx, c, mg)
+	if err != nil {
+		return "", nil, err
+	}
+	return projectID, option.WithCredentialsJSON(data), nil
+}
+
+// UseProviderConfig to return GCP authentication information.
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+	projectID, data, err := providerConfigCredentials(ctx, c, mg)
+	if err != nil {
+		return "", nil, err
+	}
+	return projectID, option.WithCredentialsJSON(data), nil
+}
+
+func providerCredentials(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, data []byte, err error) {
 	p := &v1alpha3.Provider{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderReference().Name}, p); err != nil {
 		return "", nil, err
@@ -66,11 +95,10 @@ func UseProvider(ctx context.Context, c client.Client, mg resource.Managed) (pro
 	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
 		return "", nil, err
 	}
-	return p.Spec.ProjectID, option.WithCredentialsJSON(s.Data[ref.Key]), nil
+	return p.Spec.ProjectID, s.Data[ref.Key], nil
 }
 
-// UseProviderConfig to return GCP authentication information.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, opts option.ClientOption, err error) {
+func providerConfigCredentials(ctx context.Context, c client.Client, mg resource.Managed) (projectID string, data []byte, err error) {
 	pc := &v1beta1.ProviderConfig{}
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
@@ -79,11 +107,11 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return "", nil, err
 	}
-	data, err := resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
+	data, err = resource.CommonCredentialExtractor(ctx, pc.Spec.Credentials.Source, c, pc.Spec.Credentials.CommonCredentialSelectors)
 	if err != nil {
 		return "", nil, errors.Wrap(err, "cannot get credentials")
 	}
-	return pc.Spec.ProjectID, option.WithCredentialsJSON(data), nil
+	return pc.Spec.ProjectID, data, nil
 }
 
 // IsErrorNotFoundGRPC gets a value indicating whether the given error represents
@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalforwardingrule
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateGlobalForwardingRule populates the supplied compute.ForwardingRule
+// with the supplied GlobalForwardingRuleParameters.
+func GenerateGlobalForwardingRule(name string, in v1alpha1.GlobalForwardingRuleParameters, fr *compute.ForwardingRule) {
+	fr.Name = name
+	fr.Description = gcp.StringValue(in.Description)
+	fr.IPAddress = gcp.StringValue(in.IPAddress)
+	fr.IPProtocol = gcp.StringValue(in.IPProtocol)
+	fr.IpVersion = gcp.StringValue(in.IPVersion)
+	fr.Labels = in.Labels
+	fr.LoadBalancingScheme = gcp.StringValue(in.LoadBalancingScheme)
+	fr.NetworkTier = gcp.StringValue(in.NetworkTier)
+	fr.PortRange = gcp.StringValue(in.PortRange)
+	fr.Target = gcp.StringValue(in.Target)
+}
+
+// GenerateGlobalForwardingRuleObservation takes a compute.ForwardingRule and
+// returns *GlobalForwardingRuleObservation.
+func GenerateGlobalForwardingRuleObservation(observed compute.ForwardingRule) v1alpha1.GlobalForwardingRuleObservation {
+	return v1alpha1.GlobalForwardingRuleObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		IPAddress:         observed.IPAddress,
+		LabelFingerprint:  observed.LabelFingerprint,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.ForwardingRule object. The IP address is not late initialized
+// because the API reports the literal address even when the rule was created
+// with a reference to a GlobalAddress.
+func LateInitializeSpec(spec *v1alpha1.GlobalForwardingRuleParameters, in compute.ForwardingRule) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.IPProtocol = gcp.LateInitializeString(spec.IPProtocol, in.IPProtocol)
+	spec.IPVersion = gcp.LateInitializeString(spec.IPVersion, in.IpVersion)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.LoadBalancingScheme = gcp.LateInitializeString(spec.LoadBalancingScheme, in.LoadBalancingScheme)
+	spec.NetworkTier = gcp.LateInitializeString(spec.NetworkTier, in.NetworkTier)
+	spec.PortRange = gcp.LateInitializeString(spec.PortRange, in.PortRange)
+	spec.Target = gcp.LateInitializeString(spec.Target, in.Target)
+}
+
+// IsUpToDate returns whether the target and the labels of the observed
+// compute.ForwardingRule, the only fields of a global forwarding rule that
+// can be updated, match the supplied parameters.
+func IsUpToDate(in *v1alpha1.GlobalForwardingRuleParameters, observed *compute.ForwardingRule) (targetUpToDate bool, labelsUpToDate bool) {
+	targetUpToDate = cmp.Equal(gcp.StringValue(in.Target), observed.Target, gcp.EquateComputeURLs())
+	labelsUpToDate = cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+	return targetUpToDate, labelsUpToDate
+}
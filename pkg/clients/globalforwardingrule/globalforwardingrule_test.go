@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package globalforwardingrule
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name        = "coolName"
+	description = "coolDescription"
+	ipAddress   = "https://www.googleapis.com/compute/v1/projects/cool/global/addresses/coolAddress"
+	protocol    = "TCP"
+	portRange   = "443-443"
+	target      = "https://www.googleapis.com/compute/v1/projects/cool/global/targetHttpsProxies/coolProxy"
+	labels      = map[string]string{"cool": "label"}
+
+	timestamp          = "coolTime"
+	link               = "coolLink"
+	address            = "10.0.0.1"
+	fingerprint        = "coolFingerprint"
+	id          uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.GlobalForwardingRuleParameters)) *v1alpha1.GlobalForwardingRuleParameters {
+	o := &v1alpha1.GlobalForwardingRuleParameters{
+		Description: &description,
+		IPAddress:   &ipAddress,
+		IPProtocol:  &protocol,
+		Labels:      labels,
+		PortRange:   &portRange,
+		Target:      &target,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func forwardingRule(m ...func(*compute.ForwardingRule)) *compute.ForwardingRule {
+	o := &compute.ForwardingRule{
+		Name:        name,
+		Description: description,
+		IPAddress:   ipAddress,
+		IPProtocol:  protocol,
+		Labels:      labels,
+		PortRange:   portRange,
+		Target:      target,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateGlobalForwardingRule(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.GlobalForwardingRuleParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.ForwardingRule
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: forwardingRule(),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.GlobalForwardingRuleParameters) {
+					p.IPAddress = nil
+				}),
+			},
+			want: forwardingRule(func(fr *compute.ForwardingRule) {
+				fr.IPAddress = ""
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.ForwardingRule{}
+			GenerateGlobalForwardingRule(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateGlobalForwardingRule(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateGlobalForwardingRuleObservation(t *testing.T) {
+	in := forwardingRule(func(fr *compute.ForwardingRule) {
+		fr.CreationTimestamp = timestamp
+		fr.Id = id
+		fr.IPAddress = address
+		fr.LabelFingerprint = fingerprint
+		fr.SelfLink = link
+	})
+	want := v1alpha1.GlobalForwardingRuleObservation{
+		CreationTimestamp: timestamp,
+		ID:                id,
+		IPAddress:         address,
+		LabelFingerprint:  fingerprint,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateGlobalForwardingRuleObservation(*in)); diff != "" {
+		t.Errorf("GenerateGlobalForwardingRuleObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.GlobalForwardingRuleParameters
+		in   compute.ForwardingRule
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.GlobalForwardingRuleParameters
+	}{
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *forwardingRule(func(fr *compute.ForwardingRule) {
+					fr.Description = "some other description"
+				}),
+			},
+			want: params(),
+		},
+		"IPAddressNotLateInitialized": {
+			args: args{
+				spec: params(func(p *v1alpha1.GlobalForwardingRuleParameters) {
+					p.IPAddress = nil
+					p.PortRange = nil
+				}),
+				in: *forwardingRule(func(fr *compute.ForwardingRule) {
+					fr.IPAddress = address
+				}),
+			},
+			want: params(func(p *v1alpha1.GlobalForwardingRuleParameters) {
+				p.IPAddress = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		target bool
+		labels bool
+	}
+	cases := map[string]struct {
+		in       *v1alpha1.GlobalForwardingRuleParameters
+		observed *compute.ForwardingRule
+		want     want
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: forwardingRule(),
+			want:     want{target: true, labels: true},
+		},
+		"RelativeTargetURL": {
+			in: params(func(p *v1alpha1.GlobalForwardingRuleParameters) {
+				p.Target = &target
+			}),
+			observed: forwardingRule(func(fr *compute.ForwardingRule) {
+				fr.Target = "projects/cool/global/targetHttpsProxies/coolProxy"
+			}),
+			want: want{target: true, labels: true},
+		},
+		"TargetChanged": {
+			in: params(),
+			observed: forwardingRule(func(fr *compute.ForwardingRule) {
+				fr.Target = "https://www.googleapis.com/compute/v1/projects/cool/global/targetHttpsProxies/other"
+			}),
+			want: want{target: false, labels: true},
+		},
+		"LabelsChanged": {
+			in: params(),
+			observed: forwardingRule(func(fr *compute.ForwardingRule) {
+				fr.Labels = nil
+			}),
+			want: want{target: true, labels: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			target, labels := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, want{target: target, labels: labels}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateHealthCheck populates the supplied compute.HealthCheck with the
+// supplied HealthCheckParameters.
+func GenerateHealthCheck(name string, in v1alpha1.HealthCheckParameters, hc *compute.HealthCheck) {
+	hc.Name = name
+	hc.CheckIntervalSec = gcp.Int64Value(in.CheckIntervalSec)
+	hc.Description = gcp.StringValue(in.Description)
+	hc.HealthyThreshold = gcp.Int64Value(in.HealthyThreshold)
+	hc.TimeoutSec = gcp.Int64Value(in.TimeoutSec)
+	hc.UnhealthyThreshold = gcp.Int64Value(in.UnhealthyThreshold)
+	hc.Type = in.Type
+
+	if in.GRPCHealthCheck != nil {
+		hc.GrpcHealthCheck = &compute.GRPCHealthCheck{
+			GrpcServiceName:   gcp.StringValue(in.GRPCHealthCheck.GRPCServiceName),
+			Port:              gcp.Int64Value(in.GRPCHealthCheck.Port),
+			PortName:          gcp.StringValue(in.GRPCHealthCheck.PortName),
+			PortSpecification: gcp.StringValue(in.GRPCHealthCheck.PortSpecification),
+		}
+	}
+	if in.HTTPHealthCheck != nil {
+		h := in.HTTPHealthCheck
+		hc.HttpHealthCheck = &compute.HTTPHealthCheck{
+			Host:              gcp.StringValue(h.Host),
+			Port:              gcp.Int64Value(h.Port),
+			PortName:          gcp.StringValue(h.PortName),
+			PortSpecification: gcp.StringValue(h.PortSpecification),
+			ProxyHeader:       gcp.StringValue(h.ProxyHeader),
+			RequestPath:       gcp.StringValue(h.RequestPath),
+			Response:          gcp.StringValue(h.Response),
+		}
+	}
+	if in.HTTPSHealthCheck != nil {
+		h := in.HTTPSHealthCheck
+		hc.HttpsHealthCheck = &compute.HTTPSHealthCheck{
+			Host:              gcp.StringValue(h.Host),
+			Port:              gcp.Int64Value(h.Port),
+			PortName:          gcp.StringValue(h.PortName),
+			PortSpecification: gcp.StringValue(h.PortSpecification),
+			ProxyHeader:       gcp.StringValue(h.ProxyHeader),
+			RequestPath:       gcp.StringValue(h.RequestPath),
+			Response:          gcp.StringValue(h.Response),
+		}
+	}
+	if in.HTTP2HealthCheck != nil {
+		h := in.HTTP2HealthCheck
+		hc.Http2HealthCheck = &compute.HTTP2HealthCheck{
+			Host:              gcp.StringValue(h.Host),
+			Port:              gcp.Int64Value(h.Port),
+			PortName:          gcp.StringValue(h.PortName),
+			PortSpecification: gcp.StringValue(h.PortSpecification),
+			ProxyHeader:       gcp.StringValue(h.ProxyHeader),
+			RequestPath:       gcp.StringValue(h.RequestPath),
+			Response:          gcp.StringValue(h.Response),
+		}
+	}
+	if in.SSLHealthCheck != nil {
+		t := in.SSLHealthCheck
+		hc.SslHealthCheck = &compute.SSLHealthCheck{
+			Port:              gcp.Int64Value(t.Port),
+			PortName:          gcp.StringValue(t.PortName),
+			PortSpecification: gcp.StringValue(t.PortSpecification),
+			ProxyHeader:       gcp.StringValue(t.ProxyHeader),
+			Request:           gcp.StringValue(t.Request),
+			Response:          gcp.StringValue(t.Response),
+		}
+	}
+	if in.TCPHealthCheck != nil {
+		t := in.TCPHealthCheck
+		hc.TcpHealthCheck = &compute.TCPHealthCheck{
+			Port:              gcp.Int64Value(t.Port),
+			PortName:          gcp.StringValue(t.PortName),
+			PortSpecification: gcp.StringValue(t.PortSpecification),
+			ProxyHeader:       gcp.StringValue(t.ProxyHeader),
+			Request:           gcp.StringValue(t.Request),
+			Response:          gcp.StringValue(t.Response),
+		}
+	}
+	if in.LogConfig != nil {
+		hc.LogConfig = &compute.HealthCheckLogConfig{
+			Enable: gcp.BoolValue(in.LogConfig.Enable),
+		}
+	}
+}
+
+// GenerateHealthCheckObservation takes a compute.HealthCheck and returns
+// *HealthCheckObservation.
+func GenerateHealthCheckObservation(observed compute.HealthCheck) v1alpha1.HealthCheckObservation {
+	return v1alpha1.HealthCheckObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.HealthCheck object.
+func LateInitializeSpec(spec *v1alpha1.HealthCheckParameters, in compute.HealthCheck) {
+	spec.CheckIntervalSec = gcp.LateInitializeInt64(spec.CheckIntervalSec, in.CheckIntervalSec)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.HealthyThreshold = gcp.LateInitializeInt64(spec.HealthyThreshold, in.HealthyThreshold)
+	spec.TimeoutSec = gcp.LateInitializeInt64(spec.TimeoutSec, in.TimeoutSec)
+	spec.UnhealthyThreshold = gcp.LateInitializeInt64(spec.UnhealthyThreshold, in.UnhealthyThreshold)
+	if spec.Type == "" {
+		spec.Type = in.Type
+	}
+
+	if in.HttpHealthCheck != nil {
+		if spec.HTTPHealthCheck == nil {
+			spec.HTTPHealthCheck = &v1alpha1.HTTPHealthCheck{}
+		}
+		h := in.HttpHealthCheck
+		lateInitializeHTTP(spec.HTTPHealthCheck, h.Host, h.Port, h.PortName, h.PortSpecification, h.ProxyHeader, h.RequestPath, h.Response)
+	}
+	if in.HttpsHealthCheck != nil {
+		if spec.HTTPSHealthCheck == nil {
+			spec.HTTPSHealthCheck = &v1alpha1.HTTPHealthCheck{}
+		}
+		h := in.HttpsHealthCheck
+		lateInitializeHTTP(spec.HTTPSHealthCheck, h.Host, h.Port, h.PortName, h.PortSpecification, h.ProxyHeader, h.RequestPath, h.Response)
+	}
+	if in.Http2HealthCheck != nil {
+		if spec.HTTP2HealthCheck == nil {
+			spec.HTTP2HealthCheck = &v1alpha1.HTTPHealthCheck{}
+		}
+		h := in.Http2HealthCheck
+		lateInitializeHTTP(spec.HTTP2HealthCheck, h.Host, h.Port, h.PortName, h.PortSpecification, h.ProxyHeader, h.RequestPath, h.Response)
+	}
+	if in.SslHealthCheck != nil {
+		if spec.SSLHealthCheck == nil {
+			spec.SSLHealthCheck = &v1alpha1.TCPHealthCheck{}
+		}
+		t := in.SslHealthCheck
+		lateInitializeTCP(spec.SSLHealthCheck, t.Port, t.PortName, t.PortSpecification, t.ProxyHeader, t.Request, t.Response)
+	}
+	if in.TcpHealthCheck != nil {
+		if spec.TCPHealthCheck == nil {
+			spec.TCPHealthCheck = &v1alpha1.TCPHealthCheck{}
+		}
+		t := in.TcpHealthCheck
+		lateInitializeTCP(spec.TCPHealthCheck, t.Port, t.PortName, t.PortSpecification, t.ProxyHeader, t.Request, t.Response)
+	}
+	if in.GrpcHealthCheck != nil {
+		if spec.GRPCHealthCheck == nil {
+			spec.GRPCHealthCheck = &v1alpha1.GRPCHealthCheck{}
+		}
+		g := in.GrpcHealthCheck
+		spec.GRPCHealthCheck.GRPCServiceName = gcp.LateInitializeString(spec.GRPCHealthCheck.GRPCServiceName, g.GrpcServiceName)
+		spec.GRPCHealthCheck.Port = gcp.LateInitializeInt64(spec.GRPCHealthCheck.Port, g.Port)
+		spec.GRPCHealthCheck.PortName = gcp.LateInitializeString(spec.GRPCHealthCheck.PortName, g.PortName)
+		spec.GRPCHealthCheck.PortSpecification = gcp.LateInitializeString(spec.GRPCHealthCheck.PortSpecification, g.PortSpecification)
+	}
+	if in.LogConfig != nil && spec.LogConfig == nil {
+		spec.LogConfig = &v1alpha1.HealthCheckLogConfig{
+			Enable: gcp.BoolPtr(in.LogConfig.Enable),
+		}
+	}
+}
+
+func lateInitializeHTTP(spec *v1alpha1.HTTPHealthCheck, host string, port int64, portName, portSpec, proxyHeader, requestPath, response string) {
+	spec.Host = gcp.LateInitializeString(spec.Host, host)
+	spec.Port = gcp.LateInitializeInt64(spec.Port, port)
+	spec.PortName = gcp.LateInitializeString(spec.PortName, portName)
+	spec.PortSpecification = gcp.LateInitializeString(spec.PortSpecification, portSpec)
+	spec.ProxyHeader = gcp.LateInitializeString(spec.ProxyHeader, proxyHeader)
+	spec.RequestPath = gcp.LateInitializeString(spec.RequestPath, requestPath)
+	spec.Response = gcp.LateInitializeString(spec.Response, response)
+}
+
+func lateInitializeTCP(spec *v1alpha1.TCPHealthCheck, port int64, portName, portSpec, proxyHeader, request, response string) {
+	spec.Port = gcp.LateInitializeInt64(spec.Port, port)
+	spec.PortName = gcp.LateInitializeString(spec.PortName, portName)
+	spec.PortSpecification = gcp.LateInitializeString(spec.PortSpecification, portSpec)
+	spec.ProxyHeader = gcp.LateInitializeString(spec.ProxyHeader, proxyHeader)
+	spec.Request = gcp.LateInitializeString(spec.Request, request)
+	spec.Response = gcp.LateInitializeString(spec.Response, response)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.HealthCheckParameters, observed *compute.HealthCheck) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.HealthCheck)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateHealthCheck(name, *in, desired)
+	return cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(compute.HealthCheck{}, "ForceSendFields")), nil
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package healthcheck
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	name              = "coolName"
+	description       = "coolDescription"
+	hcType            = "HTTP"
+	requestPath       = "/healthz"
+	port        int64 = 8080
+	interval    int64 = 5
+
+	timestamp        = "coolTime"
+	link             = "coolLink"
+	id        uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.HealthCheckParameters)) *v1alpha1.HealthCheckParameters {
+	o := &v1alpha1.HealthCheckParameters{
+		CheckIntervalSec: &interval,
+		Description:      &description,
+		Type:             hcType,
+		HTTPHealthCheck: &v1alpha1.HTTPHealthCheck{
+			Port:        &port,
+			RequestPath: &requestPath,
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func healthCheck(m ...func(*compute.HealthCheck)) *compute.HealthCheck {
+	o := &compute.HealthCheck{
+		Name:             name,
+		CheckIntervalSec: interval,
+		Description:      description,
+		Type:             hcType,
+		HttpHealthCheck: &compute.HTTPHealthCheck{
+			Port:        port,
+			RequestPath: requestPath,
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateHealthCheck(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.HealthCheckParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.HealthCheck
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: healthCheck(),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.HealthCheckParameters) {
+					p.Description = nil
+					p.HTTPHealthCheck = nil
+				}),
+			},
+			want: healthCheck(func(hc *compute.HealthCheck) {
+				hc.Description = ""
+				hc.HttpHealthCheck = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.HealthCheck{}
+			GenerateHealthCheck(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateHealthCheck(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateHealthCheckObservation(t *testing.T) {
+	in := healthCheck(func(hc *compute.HealthCheck) {
+		hc.CreationTimestamp = timestamp
+		hc.Id = id
+		hc.SelfLink = link
+	})
+	want := v1alpha1.HealthCheckObservation{
+		CreationTimestamp: timestamp,
+		ID:                id,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateHealthCheckObservation(*in)); diff != "" {
+		t.Errorf("GenerateHealthCheckObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.HealthCheckParameters
+		in   compute.HealthCheck
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.HealthCheckParameters
+	}{
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *healthCheck(func(hc *compute.HealthCheck) {
+					hc.Description = "some other description"
+				}),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.HealthCheckParameters) {
+					p.CheckIntervalSec = nil
+					p.HTTPHealthCheck = nil
+				}),
+				in: *healthCheck(func(hc *compute.HealthCheck) {
+					hc.HttpHealthCheck.ProxyHeader = "NONE"
+				}),
+			},
+			want: params(func(p *v1alpha1.HealthCheckParameters) {
+				p.HTTPHealthCheck.ProxyHeader = gcp.StringPtr("NONE")
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.want, tc.args.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		name     string
+		in       *v1alpha1.HealthCheckParameters
+		observed *compute.HealthCheck
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				name:     name,
+				in:       params(),
+				observed: healthCheck(),
+			},
+			want: true,
+		},
+		"NotUpToDate": {
+			args: args{
+				name: name,
+				in:   params(),
+				observed: healthCheck(func(hc *compute.HealthCheck) {
+					hc.HttpHealthCheck.RequestPath = "/"
+				}),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.name, tc.args.in, tc.args.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membership
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	// ParentFormat is the format for the parent of a membership.
+	ParentFormat = "projects/%s/locations/%s"
+
+	// NameFormat is the format for the fully qualified name of a membership.
+	NameFormat = ParentFormat + "/memberships/%s"
+
+	// IssuerPrefix is the prefix of the workload identity issuer of a GKE
+	// cluster.
+	IssuerPrefix = "https://container.googleapis.com/v1/"
+
+	errCheckUpToDate = "unable to determine if external resource is up to date"
+)
+
+// Client should be satisfied to conduct Membership operations.
+type Client interface {
+	Create(parent string, membership *gkehub.Membership) *gkehub.ProjectsLocationsMembershipsCreateCall
+	Get(name string) *gkehub.ProjectsLocationsMembershipsGetCall
+	Patch(name string, membership *gkehub.Membership) *gkehub.ProjectsLocationsMembershipsPatchCall
+	Delete(name string) *gkehub.ProjectsLocationsMembershipsDeleteCall
+}
+
+// ClusterIssuer returns the workload identity issuer of the GKE cluster with
+// the supplied resource link, or an empty string if the link is not one of a
+// GKE cluster.
+func ClusterIssuer(link string) string {
+	if !strings.HasPrefix(link, v1alpha1.GKEResourceLinkPrefix) {
+		return ""
+	}
+	return IssuerPrefix + strings.TrimPrefix(link, v1alpha1.GKEResourceLinkPrefix)
+}
+
+// GenerateMembership generates *gkehub.Membership instance from
+// MembershipParameters.
+func GenerateMembership(in v1alpha1.MembershipParameters, m *gkehub.Membership) {
+	m.ExternalId = gcp.StringValue(in.ExternalID)
+	m.Labels = in.Labels
+
+	if in.Cluster != nil {
+		if m.Endpoint == nil {
+			m.Endpoint = &gkehub.MembershipEndpoint{}
+		}
+		if m.Endpoint.GkeCluster == nil {
+			m.Endpoint.GkeCluster = &gkehub.GkeCluster{}
+		}
+		m.Endpoint.GkeCluster.ResourceLink = gcp.StringValue(in.Cluster)
+	}
+
+	if in.Authority != nil {
+		if m.Authority == nil {
+			m.Authority = &gkehub.Authority{}
+		}
+		m.Authority.Issuer = gcp.StringValue(in.Authority.Issuer)
+		if m.Authority.Issuer == "" {
+			m.Authority.Issuer = ClusterIssuer(gcp.StringValue(in.Cluster))
+		}
+	}
+}
+
+// GenerateObservation produces MembershipObservation object from
+// *gkehub.Membership object.
+func GenerateObservation(in gkehub.Membership) v1alpha1.MembershipObservation {
+	o := v1alpha1.MembershipObservation{
+		Name:       in.Name,
+		UniqueID:   in.UniqueId,
+		CreateTime: in.CreateTime,
+		UpdateTime: in.UpdateTime,
+	}
+	if in.State != nil {
+		o.State = in.State.Code
+	}
+	if in.Endpoint != nil && in.Endpoint.GkeCluster != nil {
+		o.ClusterMissing = in.Endpoint.GkeCluster.ClusterMissing
+	}
+	if in.Authority != nil {
+		o.WorkloadIdentityPool = in.Authority.WorkloadIdentityPool
+		o.IdentityProvider = in.Authority.IdentityProvider
+	}
+	return o
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// *gkehub.Membership object.
+func LateInitializeSpec(spec *v1alpha1.MembershipParameters, in gkehub.Membership) {
+	spec.ExternalID = gcp.LateInitializeString(spec.ExternalID, in.ExternalId)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	if in.Endpoint != nil && in.Endpoint.GkeCluster != nil {
+		spec.Cluster = gcp.LateInitializeString(spec.Cluster, in.Endpoint.GkeCluster.ResourceLink)
+	}
+	if spec.Authority != nil && in.Authority != nil {
+		spec.Authority.Issuer = gcp.LateInitializeString(spec.Authority.Issuer, in.Authority.Issuer)
+	}
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. It returns the update mask of the fields to update if it
+// is not.
+func IsUpToDate(in *v1alpha1.MembershipParameters, observed *gkehub.Membership) (bool, string, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, "", errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*gkehub.Membership)
+	if !ok {
+		return true, "", errors.New(errCheckUpToDate)
+	}
+	GenerateMembership(*in, desired)
+
+	um := make([]string, 0, 3)
+	if !cmp.Equal(desired.Labels, observed.Labels, cmpopts.EquateEmpty()) {
+		um = append(um, "labels")
+	}
+	if desired.ExternalId != observed.ExternalId {
+		um = append(um, "externalId")
+	}
+	if !cmp.Equal(desired.Authority, observed.Authority, cmpopts.EquateEmpty()) {
+		um = append(um, "authority")
+	}
+	if len(um) > 0 {
+		return false, strings.Join(um, ","), nil
+	}
+	return true, "", nil
+}
+
+// GetFullyQualifiedParent builds the fully qualified name of the parent of a
+// membership.
+func GetFullyQualifiedParent(project string, p v1alpha1.MembershipParameters) string {
+	return fmt.Sprintf(ParentFormat, project, p.Location)
+}
+
+// GetFullyQualifiedName builds the fully qualified name of a membership.
+func GetFullyQualifiedName(project string, p v1alpha1.MembershipParameters, name string) string {
+	return fmt.Sprintf(NameFormat, project, p.Location, name)
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membership
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	gkehub "google.golang.org/api/gkehub/v1"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	cluster = "//container.googleapis.com/projects/cool-project/locations/us-central1/clusters/cool-cluster"
+	issuer  = "https://container.googleapis.com/v1/projects/cool-project/locations/us-central1/clusters/cool-cluster"
+)
+
+func params(m ...func(*v1alpha1.MembershipParameters)) *v1alpha1.MembershipParameters {
+	p := &v1alpha1.MembershipParameters{
+		Location: "global",
+		Cluster:  gcp.StringPtr(cluster),
+		Labels:   map[string]string{"cool": "label"},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func membership(m ...func(*gkehub.Membership)) *gkehub.Membership {
+	o := &gkehub.Membership{
+		Endpoint: &gkehub.MembershipEndpoint{
+			GkeCluster: &gkehub.GkeCluster{ResourceLink: cluster},
+		},
+		Labels: map[string]string{"cool": "label"},
+	}
+	for _, f := range m {
+		f(o)
+	}
+	return o
+}
+
+func TestClusterIssuer(t *testing.T) {
+	cases := map[string]struct {
+		link string
+		want string
+	}{
+		"GKECluster": {
+			link: cluster,
+			want: issuer,
+		},
+		"NotGKECluster": {
+			link: "//gkemulticloud.googleapis.com/projects/cool-project/locations/us-east4/awsClusters/cool-cluster",
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ClusterIssuer(tc.link)); diff != "" {
+				t.Errorf("ClusterIssuer(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateMembership(t *testing.T) {
+	cases := map[string]struct {
+		in   *v1alpha1.MembershipParameters
+		want *gkehub.Membership
+	}{
+		"WithoutAuthority": {
+			in:   params(),
+			want: membership(),
+		},
+		"DefaultIssuer": {
+			in: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{}
+			}),
+			want: membership(func(m *gkehub.Membership) {
+				m.Authority = &gkehub.Authority{Issuer: issuer}
+			}),
+		},
+		"CustomIssuer": {
+			in: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{Issuer: gcp.StringPtr("https://cool-issuer")}
+			}),
+			want: membership(func(m *gkehub.Membership) {
+				m.Authority = &gkehub.Authority{Issuer: "https://cool-issuer"}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := &gkehub.Membership{}
+			GenerateMembership(*tc.in, got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateMembership(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateObservation(t *testing.T) {
+	in := membership(func(m *gkehub.Membership) {
+		m.Name = "projects/cool-project/locations/global/memberships/cool-membership"
+		m.UniqueId = "cool-id"
+		m.State = &gkehub.MembershipState{Code: v1alpha1.MembershipStateReady}
+		m.Authority = &gkehub.Authority{
+			Issuer:               issuer,
+			WorkloadIdentityPool: "cool-project.svc.id.goog",
+			IdentityProvider:     "https://gkehub.googleapis.com/projects/cool-project/locations/global/memberships/cool-membership",
+		}
+	})
+	want := v1alpha1.MembershipObservation{
+		Name:                 "projects/cool-project/locations/global/memberships/cool-membership",
+		UniqueID:             "cool-id",
+		State:                v1alpha1.MembershipStateReady,
+		WorkloadIdentityPool: "cool-project.svc.id.goog",
+		IdentityProvider:     "https://gkehub.googleapis.com/projects/cool-project/locations/global/memberships/cool-membership",
+	}
+	if diff := cmp.Diff(want, GenerateObservation(*in)); diff != "" {
+		t.Errorf("GenerateObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	cases := map[string]struct {
+		spec     *v1alpha1.MembershipParameters
+		observed *gkehub.Membership
+		want     *v1alpha1.MembershipParameters
+	}{
+		"Cluster": {
+			spec: params(func(p *v1alpha1.MembershipParameters) {
+				p.Cluster = nil
+			}),
+			observed: membership(),
+			want:     params(),
+		},
+		"IssuerOnlyWithAuthority": {
+			spec: params(),
+			observed: membership(func(m *gkehub.Membership) {
+				m.Authority = &gkehub.Authority{Issuer: issuer}
+			}),
+			want: params(),
+		},
+		"Issuer": {
+			spec: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{}
+			}),
+			observed: membership(func(m *gkehub.Membership) {
+				m.Authority = &gkehub.Authority{Issuer: issuer}
+			}),
+			want: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{Issuer: gcp.StringPtr(issuer)}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.spec, *tc.observed)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate bool
+		mask     string
+	}
+	cases := map[string]struct {
+		in       *v1alpha1.MembershipParameters
+		observed *gkehub.Membership
+		want     want
+	}{
+		"UpToDate": {
+			in: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{}
+			}),
+			observed: membership(func(m *gkehub.Membership) {
+				m.State = &gkehub.MembershipState{Code: v1alpha1.MembershipStateReady}
+				m.Authority = &gkehub.Authority{Issuer: issuer, WorkloadIdentityPool: "cool-project.svc.id.goog"}
+			}),
+			want: want{upToDate: true},
+		},
+		"EnableWorkloadIdentity": {
+			in: params(func(p *v1alpha1.MembershipParameters) {
+				p.Authority = &v1alpha1.Authority{}
+			}),
+			observed: membership(),
+			want:     want{upToDate: false, mask: "authority"},
+		},
+		"LabelsAndExternalID": {
+			in: params(func(p *v1alpha1.MembershipParameters) {
+				p.Labels = map[string]string{"cool": "new-label"}
+				p.ExternalID = gcp.StringPtr("cool-id")
+			}),
+			observed: membership(),
+			want:     want{upToDate: false, mask: "labels,externalId"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upToDate, mask, err := IsUpToDate(tc.in, tc.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, want{upToDate: upToDate, mask: mask}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
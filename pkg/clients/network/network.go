@@ -60,7 +60,7 @@ func GenerateNetworkObservation(in compute.Network) v1beta1.NetworkObservation {
 		Subnetworks:       in.Subnetworks,
 	}
 	for _, p := range in.Peerings {
-		gp := &v1beta1.NetworkPeering{
+		gp := &v1beta1.NetworkPeeringObservation{
 			Name:                 p.Name,
 			Network:              p.Network,
 			State:                p.State,
@@ -102,7 +102,7 @@ func observation(m ...func(*v1beta1.NetworkObservation)) *v1beta1.NetworkObserva
 		CreationTimestamp: testCreationTimestamp,
 		GatewayIPv4:       testGatewayIPv4,
 		ID:                2029819203,
-		Peerings: []*v1beta1.NetworkPeering{
+		Peerings: []*v1beta1.NetworkPeeringObservation{
 			{
 				AutoCreateRoutes:     true,
 				ExchangeSubnetRoutes: true,
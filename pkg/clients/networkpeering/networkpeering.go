@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpeering
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateNetworkPeering populates the supplied compute.NetworkPeering with
+// the supplied NetworkPeeringParameters. Route exchange settings are always
+// sent so that they may be disabled by an update.
+func GenerateNetworkPeering(name string, in v1alpha1.NetworkPeeringParameters, p *compute.NetworkPeering) {
+	p.Name = name
+	p.Network = gcp.StringValue(in.PeerNetwork)
+	p.ExchangeSubnetRoutes = true
+	p.ExportCustomRoutes = gcp.BoolValue(in.ExportCustomRoutes)
+	p.ImportCustomRoutes = gcp.BoolValue(in.ImportCustomRoutes)
+	p.ExportSubnetRoutesWithPublicIp = gcp.BoolValue(in.ExportSubnetRoutesWithPublicIP)
+	p.ImportSubnetRoutesWithPublicIp = gcp.BoolValue(in.ImportSubnetRoutesWithPublicIP)
+	p.ForceSendFields = []string{
+		"ExportCustomRoutes",
+		"ImportCustomRoutes",
+		"ExportSubnetRoutesWithPublicIp",
+		"ImportSubnetRoutesWithPublicIp",
+	}
+}
+
+// GenerateNetworkPeeringObservation takes a compute.NetworkPeering and
+// returns *NetworkPeeringObservation.
+func GenerateNetworkPeeringObservation(observed compute.NetworkPeering) v1alpha1.NetworkPeeringObservation {
+	return v1alpha1.NetworkPeeringObservation{
+		PeerMTU:      observed.PeerMtu,
+		State:        observed.State,
+		StateDetails: observed.StateDetails,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.NetworkPeering object.
+func LateInitializeSpec(spec *v1alpha1.NetworkPeeringParameters, in compute.NetworkPeering) {
+	spec.PeerNetwork = gcp.LateInitializeString(spec.PeerNetwork, in.Network)
+	spec.ExportCustomRoutes = gcp.LateInitializeBool(spec.ExportCustomRoutes, in.ExportCustomRoutes)
+	spec.ImportCustomRoutes = gcp.LateInitializeBool(spec.ImportCustomRoutes, in.ImportCustomRoutes)
+	spec.ExportSubnetRoutesWithPublicIP = gcp.LateInitializeBool(spec.ExportSubnetRoutesWithPublicIP, in.ExportSubnetRoutesWithPublicIp)
+	spec.ImportSubnetRoutesWithPublicIP = gcp.LateInitializeBool(spec.ImportSubnetRoutesWithPublicIP, in.ImportSubnetRoutesWithPublicIp)
+}
+
+// IsUpToDate returns whether the route exchange settings of the observed
+// compute.NetworkPeering, the only fields of a peering that may be updated,
+// match the supplied parameters.
+func IsUpToDate(in *v1alpha1.NetworkPeeringParameters, observed *compute.NetworkPeering) bool {
+	return gcp.BoolValue(in.ExportCustomRoutes) == observed.ExportCustomRoutes &&
+		gcp.BoolValue(in.ImportCustomRoutes) == observed.ImportCustomRoutes &&
+		gcp.BoolValue(in.ExportSubnetRoutesWithPublicIP) == observed.ExportSubnetRoutesWithPublicIp &&
+		gcp.BoolValue(in.ImportSubnetRoutesWithPublicIP) == observed.ImportSubnetRoutesWithPublicIp
+}
+
+// Find returns the peering with the supplied name of the supplied
+// compute.Network, or nil if the network has no such peering.
+func Find(name string, n *compute.Network) *compute.NetworkPeering {
+	for _, p := range n.Peerings {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
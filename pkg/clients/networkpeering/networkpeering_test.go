@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkpeering
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	name        = "coolPeering"
+	network     = "projects/cool/global/networks/coolNetwork"
+	peerNetwork = "projects/other/global/networks/coolPeer"
+)
+
+func params(m ...func(*v1alpha1.NetworkPeeringParameters)) *v1alpha1.NetworkPeeringParameters {
+	o := &v1alpha1.NetworkPeeringParameters{
+		Network:                        &network,
+		PeerNetwork:                    &peerNetwork,
+		ExportCustomRoutes:             gcp.BoolPtr(true),
+		ImportCustomRoutes:             gcp.BoolPtr(false),
+		ExportSubnetRoutesWithPublicIP: gcp.BoolPtr(true),
+		ImportSubnetRoutesWithPublicIP: gcp.BoolPtr(false),
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func peering(m ...func(*compute.NetworkPeering)) *compute.NetworkPeering {
+	o := &compute.NetworkPeering{
+		Name:                           name,
+		Network:                        peerNetwork,
+		ExchangeSubnetRoutes:           true,
+		ExportCustomRoutes:             true,
+		ExportSubnetRoutesWithPublicIp: true,
+		ForceSendFields: []string{
+			"ExportCustomRoutes",
+			"ImportCustomRoutes",
+			"ExportSubnetRoutesWithPublicIp",
+			"ImportSubnetRoutesWithPublicIp",
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateNetworkPeering(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.NetworkPeeringParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.NetworkPeering
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: peering(),
+		},
+		"RouteExchangeOmitted": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.NetworkPeeringParameters) {
+					p.ExportCustomRoutes = nil
+					p.ExportSubnetRoutesWithPublicIP = nil
+				}),
+			},
+			want: peering(func(p *compute.NetworkPeering) {
+				p.ExportCustomRoutes = false
+				p.ExportSubnetRoutesWithPublicIp = false
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.NetworkPeering{}
+			GenerateNetworkPeering(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(r, tc.want); diff != "" {
+				t.Errorf("GenerateNetworkPeering(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.NetworkPeeringParameters
+		in   compute.NetworkPeering
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.NetworkPeeringParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: params(),
+				in:   *peering(),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.NetworkPeeringParameters) {
+					p.ExportCustomRoutes = nil
+					p.ImportCustomRoutes = nil
+				}),
+				in: *peering(),
+			},
+			want: params(func(p *v1alpha1.NetworkPeeringParameters) {
+				p.ImportCustomRoutes = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.NetworkPeeringParameters
+		observed *compute.NetworkPeering
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: peering(),
+			want:     true,
+		},
+		"ImportCustomRoutes": {
+			in: params(),
+			observed: peering(func(p *compute.NetworkPeering) {
+				p.ImportCustomRoutes = true
+			}),
+			want: false,
+		},
+		"ExportSubnetRoutesWithPublicIP": {
+			in: params(),
+			observed: peering(func(p *compute.NetworkPeering) {
+				p.ExportSubnetRoutesWithPublicIp = false
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	n := &compute.Network{Peerings: []*compute.NetworkPeering{{Name: "other"}, peering()}}
+	if diff := cmp.Diff(peering(), Find(name, n)); diff != "" {
+		t.Errorf("Find(...): -want, +got:\n%s", diff)
+	}
+	if got := Find("missing", n); got != nil {
+		t.Errorf("Find(...): want nil, got %v", got)
+	}
+}
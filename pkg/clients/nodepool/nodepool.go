@@ -19,6 +19,8 @@ package nodepool
 import (
 	"context"
 	"fmt"
+	"math"
+	"reflect"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
@@ -41,6 +43,32 @@ const (
 	runtimeKey = "sandbox.gke.io/runtime"
 )
 
+var (
+	// replacementPoolFields are the fields of a node pool that cannot be
+	// updated in place.
+	replacementPoolFields = []string{"InitialNodeCount", "MaxPodsConstraint"}
+
+	// replacementConfigFields are the fields of a node config that cannot
+	// be updated in place.
+	replacementConfigFields = []string{
+		"Accelerators",
+		"BootDiskKmsKey",
+		"DiskSizeGb",
+		"DiskType",
+		"LocalSsdCount",
+		"MachineType",
+		"Metadata",
+		"MinCpuPlatform",
+		"NodeGroup",
+		"OauthScopes",
+		"Preemptible",
+		"ReservationAffinity",
+		"SandboxConfig",
+		"ServiceAccount",
+		"ShieldedInstanceConfig",
+	}
+)
+
 // GenerateNodePool generates *container.NodePool instance from NodePoolParameters.
 func GenerateNodePool(name string, in v1beta1.NodePoolParameters, pool *container.NodePool) { // nolint:gocyclo
 	pool.InitialNodeCount = gcp.Int64Value(in.InitialNodeCount)
@@ -198,8 +226,33 @@ func GenerateUpgradeSettings(in *v1beta2.UpgradeSettings, pool *container.NodePo
 		if pool.UpgradeSettings == nil {
 			pool.UpgradeSettings = &container.UpgradeSettings{}
 		}
-		pool.UpgradeSettings.MaxSurge = gcp.Int64Value(in.MaxSurge)
-		pool.UpgradeSettings.MaxUnavailable = gcp.Int64Value(in.MaxUnavailable)
+		FillUpgradeSettings(in, pool.UpgradeSettings)
+	}
+}
+
+// FillUpgradeSettings fills *container.UpgradeSettings from *UpgradeSettings.
+// It is shared by node pools and the node auto-provisioning defaults of
+// clusters.
+func FillUpgradeSettings(in *v1beta2.UpgradeSettings, out *container.UpgradeSettings) {
+	out.MaxSurge = gcp.Int64Value(in.MaxSurge)
+	out.MaxUnavailable = gcp.Int64Value(in.MaxUnavailable)
+	out.Strategy = gcp.StringValue(in.Strategy)
+	if in.BlueGreenSettings != nil {
+		if out.BlueGreenSettings == nil {
+			out.BlueGreenSettings = &container.BlueGreenSettings{}
+		}
+		out.BlueGreenSettings.NodePoolSoakDuration = gcp.StringValue(in.BlueGreenSettings.NodePoolSoakDuration)
+		if in.BlueGreenSettings.StandardRolloutPolicy != nil {
+			if out.BlueGreenSettings.StandardRolloutPolicy == nil {
+				out.BlueGreenSettings.StandardRolloutPolicy = &container.StandardRolloutPolicy{}
+			}
+			p := out.BlueGreenSettings.StandardRolloutPolicy
+			p.BatchNodeCount = gcp.Int64Value(in.BlueGreenSettings.StandardRolloutPolicy.BatchNodeCount)
+			// The API takes a fraction of the blue pool rather than a
+			// percentage, and CRDs do not support floating point numbers.
+			p.BatchPercentage = float64(gcp.Int64Value(in.BlueGreenSettings.StandardRolloutPolicy.BatchPercentage)) / 100
+			p.BatchSoakDuration = gcp.StringValue(in.BlueGreenSettings.StandardRolloutPolicy.BatchSoakDuration)
+		}
 	}
 }
 
@@ -231,6 +284,18 @@ func GenerateObservation(in container.NodePool) v1beta1.NodePoolObservation { //
 		}
 	}
 
+	if in.UpdateInfo != nil && in.UpdateInfo.BlueGreenInfo != nil {
+		o.UpdateInfo = &v1beta1.UpdateInfo{
+			BlueGreenInfo: &v1beta1.BlueGreenInfo{
+				Phase:                     in.UpdateInfo.BlueGreenInfo.Phase,
+				GreenPoolVersion:          in.UpdateInfo.BlueGreenInfo.GreenPoolVersion,
+				BluePoolDeletionStartTime: in.UpdateInfo.BlueGreenInfo.BluePoolDeletionStartTime,
+				BlueInstanceGroupUrls:     in.UpdateInfo.BlueGreenInfo.BlueInstanceGroupUrls,
+				GreenInstanceGroupUrls:    in.UpdateInfo.BlueGreenInfo.GreenInstanceGroupUrls,
+			},
+		}
+	}
+
 	return o
 
 }
@@ -242,6 +307,12 @@ func GenerateNodePoolUpdate(in *v1beta1.NodePoolParameters) *container.UpdateNod
 		NodeVersion: gcp.StringValue(in.Version),
 	}
 
+	if in.UpgradeSettings != nil {
+		pool := &container.NodePool{}
+		GenerateUpgradeSettings(in.UpgradeSettings, pool)
+		o.UpgradeSettings = pool.UpgradeSettings
+	}
+
 	if in.Config != nil {
 		o.ImageType = gcp.StringValue(in.Config.ImageType)
 
@@ -378,13 +449,41 @@ func LateInitializeSpec(spec *v1beta1.NodePoolParameters, in container.NodePool)
 		if spec.UpgradeSettings == nil {
 			spec.UpgradeSettings = &v1beta2.UpgradeSettings{}
 		}
-		spec.UpgradeSettings.MaxSurge = gcp.LateInitializeInt64(spec.UpgradeSettings.MaxSurge, in.UpgradeSettings.MaxSurge)
-		spec.UpgradeSettings.MaxUnavailable = gcp.LateInitializeInt64(spec.UpgradeSettings.MaxUnavailable, in.UpgradeSettings.MaxUnavailable)
+		LateInitializeUpgradeSettings(spec.UpgradeSettings, in.UpgradeSettings)
 	}
 
 	spec.Version = gcp.LateInitializeString(spec.Version, in.Version)
 }
 
+// LateInitializeUpgradeSettings fills unassigned fields of *UpgradeSettings
+// with the values in *container.UpgradeSettings. It is shared by node pools
+// and the node auto-provisioning defaults of clusters.
+func LateInitializeUpgradeSettings(spec *v1beta2.UpgradeSettings, in *container.UpgradeSettings) {
+	spec.MaxSurge = gcp.LateInitializeInt64(spec.MaxSurge, in.MaxSurge)
+	spec.MaxUnavailable = gcp.LateInitializeInt64(spec.MaxUnavailable, in.MaxUnavailable)
+	spec.Strategy = gcp.LateInitializeString(spec.Strategy, in.Strategy)
+	if in.BlueGreenSettings != nil {
+		if spec.BlueGreenSettings == nil {
+			spec.BlueGreenSettings = &v1beta2.BlueGreenSettings{}
+		}
+		bg := in.BlueGreenSettings
+		spec.BlueGreenSettings.NodePoolSoakDuration = gcp.LateInitializeString(spec.BlueGreenSettings.NodePoolSoakDuration, bg.NodePoolSoakDuration)
+		if bg.StandardRolloutPolicy != nil {
+			if spec.BlueGreenSettings.StandardRolloutPolicy == nil {
+				spec.BlueGreenSettings.StandardRolloutPolicy = &v1beta2.StandardRolloutPolicy{}
+			}
+			p := spec.BlueGreenSettings.StandardRolloutPolicy
+			p.BatchNodeCount = gcp.LateInitializeInt64(p.BatchNodeCount, bg.StandardRolloutPolicy.BatchNodeCount)
+			// Only late initialize a whole percentage so that it
+			// round-trips through FillUpgradeSettings.
+			if pct := math.Round(bg.StandardRolloutPolicy.BatchPercentage * 100); pct/100 == bg.StandardRolloutPolicy.BatchPercentage {
+				p.BatchPercentage = gcp.LateInitializeInt64(p.BatchPercentage, int64(pct))
+			}
+			p.BatchSoakDuration = gcp.LateInitializeString(p.BatchSoakDuration, bg.StandardRolloutPolicy.BatchSoakDuration)
+		}
+	}
+}
+
 // newAutoscalingUpdateFn returns a function that updates the Autoscaling of a node pool.
 func newAutoscalingUpdateFn(in *v1beta1.NodePoolAutoscaling) UpdateFn {
 	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
@@ -416,6 +515,63 @@ func newGeneralUpdateFn(in *v1beta1.NodePoolParameters) UpdateFn {
 	}
 }
 
+// UpgradeActionTarget returns the blue instance groups of the upgrade that
+// the supplied upgrade action applies to, given the previously recorded
+// target and the ongoing upgrade described by the supplied update info. An
+// action applies to the first upgrade that can be completed or rolled back
+// while it is set.
+func UpgradeActionTarget(action *string, target []string, info *v1beta1.UpdateInfo) []string {
+	switch {
+	case action == nil:
+		return nil
+	case len(target) > 0:
+		return target
+	case !upgradeActionable(info):
+		return nil
+	}
+	return info.BlueGreenInfo.BlueInstanceGroupUrls
+}
+
+// UpgradeActionPending returns true if the supplied upgrade action should be
+// applied to the ongoing upgrade described by the supplied update info, i.e.
+// if that upgrade is the supplied target of the action.
+func UpgradeActionPending(action *string, target []string, info *v1beta1.UpdateInfo) bool {
+	if action == nil || len(target) == 0 || !upgradeActionable(info) {
+		return false
+	}
+	if !cmp.Equal(target, info.BlueGreenInfo.BlueInstanceGroupUrls) {
+		// The action applied to an earlier upgrade.
+		return false
+	}
+	return *action == v1beta1.UpgradeActionComplete || *action == v1beta1.UpgradeActionRollback
+}
+
+// upgradeActionable returns true if the supplied update info describes a
+// blue-green upgrade that can be completed or rolled back.
+func upgradeActionable(info *v1beta1.UpdateInfo) bool {
+	if info == nil || info.BlueGreenInfo == nil || len(info.BlueGreenInfo.BlueInstanceGroupUrls) == 0 {
+		return false
+	}
+	switch info.BlueGreenInfo.Phase {
+	case "", v1beta1.BlueGreenPhaseUnspecified, v1beta1.BlueGreenPhaseDeletingBlue, v1beta1.BlueGreenPhaseRollbackStarted:
+		// There is no upgrade, or it is already completing or rolling back.
+		return false
+	}
+	return true
+}
+
+// NewUpgradeActionFn returns a function that completes or rolls back the
+// ongoing upgrade of a node pool.
+func NewUpgradeActionFn(action string) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		if action == v1beta1.UpgradeActionRollback {
+			return s.Projects.Locations.Clusters.NodePools.Rollback(name, &container.RollbackNodePoolUpgradeRequest{}).Context(ctx).Do()
+		}
+		_, err := s.Projects.Locations.Clusters.NodePools.CompleteUpgrade(name, &container.CompleteNodePoolUpgradeRequest{}).Context(ctx).Do()
+		return nil, err
+	}
+}
+
 func noOpUpdate(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
 	return nil, nil
 }
@@ -423,37 +579,196 @@ func noOpUpdate(ctx context.Context, s *container.Service, name string) (*contai
 // UpdateFn returns a function that updates a node pool.
 type UpdateFn func(context.Context, *container.Service, string) (*container.Operation, error)
 
-// IsUpToDate checks whether current state is up-to-date compared to the given
-// set of parameters.
-func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool) (bool, UpdateFn, error) {
+// nodeConfig returns the config of the supplied node pool, or an empty config
+// if it has none.
+func nodeConfig(in *container.NodePool) *container.NodeConfig {
+	if in.Config == nil {
+		return &container.NodeConfig{}
+	}
+	return in.Config
+}
+
+// withRuntimeLabel returns the supplied labels with the gVisor runtime label
+// of the supplied observed labels, so that updating labels does not remove it.
+func withRuntimeLabel(in, observed map[string]string) map[string]string {
+	v, ok := observed[runtimeKey]
+	if !ok {
+		return in
+	}
+	out := make(map[string]string, len(in)+1)
+	for k, l := range in {
+		out[k] = l
+	}
+	out[runtimeKey] = v
+	return out
+}
+
+// withRuntimeTaint returns the supplied taints with the gVisor runtime taint
+// of the supplied observed taints, so that updating taints does not remove it.
+func withRuntimeTaint(in, observed []*container.NodeTaint) []*container.NodeTaint {
+	out := make([]*container.NodeTaint, 0, len(in)+1)
+	for _, t := range in {
+		if t != nil && t.Key != runtimeKey {
+			out = append(out, t)
+		}
+	}
+	for _, t := range observed {
+		if t != nil && t.Key == runtimeKey {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// newConfigUpdateFn returns a function that updates the node config of a node
+// pool in place using the supplied request. The node version and image type
+// are required by the API, so the observed ones are sent unless the request
+// sets them.
+func newConfigUpdateFn(update *container.UpdateNodePoolRequest, observed *container.NodePool) UpdateFn {
+	return func(ctx context.Context, s *container.Service, name string) (*container.Operation, error) {
+		if update.NodeVersion == "" {
+			update.NodeVersion = observed.Version
+		}
+		if update.ImageType == "" {
+			update.ImageType = nodeConfig(observed).ImageType
+		}
+		return s.Projects.Locations.Clusters.NodePools.Update(name, update).Context(ctx).Do()
+	}
+}
+
+// configUpdate returns a request that updates the first field of the observed
+// node config that differs from the desired one and can be updated in place,
+// or nil if there is none. Only one field is updated at a time.
+func configUpdate(desired, observed *container.NodePool) *container.UpdateNodePoolRequest { // nolint:gocyclo
+	d, o := nodeConfig(desired), nodeConfig(observed)
+	switch {
+	case !cmp.Equal(d.Labels, o.Labels, cmpopts.EquateEmpty(), ignoreRuntimeLabel()):
+		return &container.UpdateNodePoolRequest{Labels: &container.NodeLabels{Labels: withRuntimeLabel(d.Labels, o.Labels)}}
+	case !cmp.Equal(d.Taints, o.Taints, cmpopts.EquateEmpty(), ignoreRuntimeTaint()):
+		return &container.UpdateNodePoolRequest{Taints: &container.NodeTaints{Taints: withRuntimeTaint(d.Taints, o.Taints)}}
+	case !cmp.Equal(d.Tags, o.Tags, cmpopts.EquateEmpty()):
+		return &container.UpdateNodePoolRequest{Tags: &container.NetworkTags{Tags: d.Tags}}
+	case !cmp.Equal(d.KubeletConfig, o.KubeletConfig, cmpopts.EquateEmpty()):
+		return &container.UpdateNodePoolRequest{KubeletConfig: d.KubeletConfig}
+	case !cmp.Equal(d.LinuxNodeConfig, o.LinuxNodeConfig, cmpopts.EquateEmpty()):
+		return &container.UpdateNodePoolRequest{LinuxNodeConfig: d.LinuxNodeConfig}
+	case !cmp.Equal(d.WorkloadMetadataConfig, o.WorkloadMetadataConfig, cmpopts.EquateEmpty()):
+		return &container.UpdateNodePoolRequest{WorkloadMetadataConfig: d.WorkloadMetadataConfig}
+	case !strings.EqualFold(d.ImageType, o.ImageType):
+		return &container.UpdateNodePoolRequest{ImageType: d.ImageType}
+	}
+	return nil
+}
+
+// ignoreRuntimeLabel ignores the gVisor runtime label that GKE adds to nodes.
+// TODO(hasheddan): remove manual ignore functions when resolution is
+// reached on https://github.com/crossplane/crossplane-runtime/issues/120
+func ignoreRuntimeLabel() cmp.Option {
+	return cmpopts.IgnoreMapEntries(func(key, _ string) bool {
+		return key == runtimeKey
+	})
+}
+
+// ignoreRuntimeTaint ignores the gVisor runtime taint that GKE adds to nodes.
+func ignoreRuntimeTaint() cmp.Option {
+	return cmpopts.IgnoreSliceElements(func(c *container.NodeTaint) bool {
+		return c.Key == runtimeKey
+	})
+}
+
+// generateDesired returns the observed node pool with the supplied parameters
+// applied.
+func generateDesired(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool) (*container.NodePool, error) {
 	generated, err := copystructure.Copy(observed)
 	if err != nil {
-		return true, noOpUpdate, errors.Wrap(err, errCheckUpToDate)
+		return nil, errors.Wrap(err, errCheckUpToDate)
 	}
 	desired, ok := generated.(*container.NodePool)
 	if !ok {
-		return true, noOpUpdate, errors.New(errCheckUpToDate)
+		return nil, errors.New(errCheckUpToDate)
 	}
 	GenerateNodePool(name, *in, desired)
+	return desired, nil
+}
+
+// ReplacementRequired returns the fields of the supplied parameters that
+// differ from the observed node pool and cannot be updated in place.
+func ReplacementRequired(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool) ([]string, error) {
+	desired, err := generateDesired(name, in, observed)
+	if err != nil {
+		return nil, err
+	}
+	fields := changedFields(desired, observed, "", replacementPoolFields)
+	return append(fields, changedFields(nodeConfig(desired), nodeConfig(observed), "config.", replacementConfigFields)...), nil
+}
+
+// changedFields returns the JSON paths of the supplied struct fields that
+// differ between the supplied desired and observed structs.
+func changedFields(desired, observed interface{}, prefix string, names []string) []string {
+	d, o := reflect.ValueOf(desired).Elem(), reflect.ValueOf(observed).Elem()
+	var changed []string
+	for _, n := range names {
+		if cmp.Equal(d.FieldByName(n).Interface(), o.FieldByName(n).Interface(), cmpopts.EquateEmpty(), cmp.Comparer(strings.EqualFold)) {
+			continue
+		}
+		f, _ := d.Type().FieldByName(n)
+		changed = append(changed, prefix+strings.Split(f.Tag.Get("json"), ",")[0])
+	}
+	return changed
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters. Fields that cannot be updated in place are not
+// considered; see ReplacementRequired. Neither are upgrade actions; see
+// UpgradeActionPending.
+func IsUpToDate(name string, in *v1beta1.NodePoolParameters, observed *container.NodePool) (bool, UpdateFn, error) {
+	desired, err := generateDesired(name, in, observed)
+	if err != nil {
+		return true, noOpUpdate, err
+	}
+	if gcp.StringValue(in.UpgradeAction) == v1beta1.UpgradeActionRollback {
+		// Do not upgrade the node pool again while it is rolled back.
+		desired.Version = observed.Version
+	}
 	if !cmp.Equal(desired.Autoscaling, observed.Autoscaling, cmpopts.EquateEmpty()) {
 		return false, newAutoscalingUpdateFn(in.Autoscaling), nil
 	}
 	if !cmp.Equal(desired.Management, observed.Management, cmpopts.EquateEmpty()) {
 		return false, newManagementUpdateFn(in.Management), nil
 	}
+	if u := configUpdate(desired, observed); u != nil {
+		return false, newConfigUpdateFn(u, observed), nil
+	}
 
-	// TODO(hasheddan): remove manual ignore functions when resolution is
-	// reached on https://github.com/crossplane/crossplane-runtime/issues/120
-	if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), cmpopts.IgnoreSliceElements(func(c *container.NodeTaint) bool {
-		return c.Key == runtimeKey
-	}), cmpopts.IgnoreMapEntries(func(key, _ string) bool {
-		return key == runtimeKey
-	}), cmp.Comparer(strings.EqualFold)) {
+	if !cmp.Equal(desired, observed, cmpopts.EquateEmpty(), ignoreRuntimeTaint(), ignoreRuntimeLabel(), cmp.Comparer(strings.EqualFold),
+		cmpopts.IgnoreFields(container.NodePool{}, replacementPoolFields...),
+		cmpopts.IgnoreFields(container.NodeConfig{}, replacementConfigFields...)) {
 		return false, newGeneralUpdateFn(in), nil
 	}
 	return true, noOpUpdate, nil
 }
 
+// TrackMasterVersion sets the version of the supplied parameters to the
+// version of the control plane of the supplied cluster, once the control
+// plane is done upgrading. The observed version is kept until then so that
+// the node pool is upgraded after the control plane.
+func TrackMasterVersion(in *v1beta1.NodePoolParameters, cluster *container.Cluster, observed *container.NodePool) {
+	if !gcp.BoolValue(in.TrackMasterVersion) {
+		return
+	}
+	v := observed.Version
+	if cluster.Status == v1beta2.ClusterStateRunning && cluster.CurrentMasterVersion != "" {
+		v = cluster.CurrentMasterVersion
+	}
+	in.Version = &v
+}
+
+// GetFullyQualifiedClusterName builds the fully qualified name of the cluster
+// of the node pool.
+func GetFullyQualifiedClusterName(p v1beta1.NodePoolParameters) string {
+	return strings.ReplaceAll(p.Cluster, "/zones/", "/locations/")
+}
+
 // GetFullyQualifiedName builds the fully qualified name of the cluster.
 func GetFullyQualifiedName(p v1beta1.NodePoolParameters, name string) string {
 	// Zonal clusters use /zones/ in their path instead of /locations/. We
@@ -522,6 +522,190 @@ func TestGenerateMaxPodsConstraint(t *testing.T) {
 	}
 }
 
+func TestGenerateUpgradeSettings(t *testing.T) {
+	type args struct {
+		in   *v1beta2.UpgradeSettings
+		pool *container.NodePool
+	}
+	tests := map[string]struct {
+		args args
+		want *container.NodePool
+	}{
+		"Nil": {
+			args: args{
+				pool: nodePool(),
+			},
+			want: nodePool(),
+		},
+		"BlueGreen": {
+			args: args{
+				in: &v1beta2.UpgradeSettings{
+					Strategy: gcp.StringPtr(v1beta2.UpgradeStrategyBlueGreen),
+					BlueGreenSettings: &v1beta2.BlueGreenSettings{
+						NodePoolSoakDuration: gcp.StringPtr("3600s"),
+						StandardRolloutPolicy: &v1beta2.StandardRolloutPolicy{
+							BatchPercentage:   gcp.Int64Ptr(25),
+							BatchSoakDuration: gcp.StringPtr("60s"),
+						},
+					},
+				},
+				pool: nodePool(),
+			},
+			want: nodePool(func(n *container.NodePool) {
+				n.UpgradeSettings = &container.UpgradeSettings{
+					Strategy: v1beta2.UpgradeStrategyBlueGreen,
+					BlueGreenSettings: &container.BlueGreenSettings{
+						NodePoolSoakDuration: "3600s",
+						StandardRolloutPolicy: &container.StandardRolloutPolicy{
+							BatchPercentage:   0.25,
+							BatchSoakDuration: "60s",
+						},
+					},
+				}
+			}),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			GenerateUpgradeSettings(tc.args.in, tc.args.pool)
+			if diff := cmp.Diff(tc.want, tc.args.pool); diff != "" {
+				t.Errorf("GenerateUpgradeSettings(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeActionTarget(t *testing.T) {
+	blue := []string{"blue-group"}
+	inPhase := func(phase string) *v1beta1.UpdateInfo {
+		return &v1beta1.UpdateInfo{BlueGreenInfo: &v1beta1.BlueGreenInfo{Phase: phase, BlueInstanceGroupUrls: blue}}
+	}
+	type args struct {
+		action *string
+		target []string
+		info   *v1beta1.UpdateInfo
+	}
+	tests := map[string]struct {
+		args args
+		want []string
+	}{
+		"NoAction": {
+			args: args{
+				target: []string{"old-group"},
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: nil,
+		},
+		"NoUpgrade": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+			},
+			want: nil,
+		},
+		"OngoingUpgrade": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: blue,
+		},
+		"CompletingUpgrade": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				info:   inPhase(v1beta1.BlueGreenPhaseDeletingBlue),
+			},
+			want: nil,
+		},
+		"KeepRecordedTarget": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				target: []string{"old-group"},
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: []string{"old-group"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := UpgradeActionTarget(tc.args.action, tc.args.target, tc.args.info)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpgradeActionTarget(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpgradeActionPending(t *testing.T) {
+	blue := []string{"blue-group"}
+	inPhase := func(phase string) *v1beta1.UpdateInfo {
+		return &v1beta1.UpdateInfo{BlueGreenInfo: &v1beta1.BlueGreenInfo{Phase: phase, BlueInstanceGroupUrls: blue}}
+	}
+	type args struct {
+		action *string
+		target []string
+		info   *v1beta1.UpdateInfo
+	}
+	tests := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoAction": {
+			args: args{
+				target: blue,
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: false,
+		},
+		"NoUpgrade": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				target: blue,
+			},
+			want: false,
+		},
+		"Complete": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				target: blue,
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: true,
+		},
+		"Rollback": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionRollback),
+				target: blue,
+				info:   inPhase(v1beta1.BlueGreenPhaseDrainingBlue),
+			},
+			want: true,
+		},
+		"AlreadyRollingBack": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionRollback),
+				target: blue,
+				info:   inPhase(v1beta1.BlueGreenPhaseRollbackStarted),
+			},
+			want: false,
+		},
+		"EarlierUpgrade": {
+			args: args{
+				action: gcp.StringPtr(v1beta1.UpgradeActionComplete),
+				target: []string{"old-group"},
+				info:   inPhase(v1beta1.BlueGreenPhaseNodePoolSoaking),
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := UpgradeActionPending(tc.args.action, tc.args.target, tc.args.info)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("UpgradeActionPending(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestLateInitializeSpec(t *testing.T) {
 	type args struct {
 		nodePool *container.NodePool
@@ -675,6 +859,69 @@ func TestIsUpToDate(t *testing.T) {
 				isErr:    false,
 			},
 		},
+		"NeedsLabelsUpdate": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Labels: map[string]string{"cool-key": "cool-value"}}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{Labels: map[string]string{"cool-key": "new-value"}}
+				}),
+			},
+			want: want{
+				upToDate: false,
+				isErr:    false,
+			},
+		},
+		"UpToDateReplacementRequired": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{MachineType: "n1-standard-1"}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{MachineType: gcp.StringPtr("e2-standard-4")}
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateIgnoringUpgradeAction": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.UpdateInfo = &container.UpdateInfo{
+						BlueGreenInfo: &container.BlueGreenInfo{Phase: v1beta1.BlueGreenPhaseNodePoolSoaking},
+					}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.UpgradeAction = gcp.StringPtr(v1beta1.UpgradeActionComplete)
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
+		"UpToDateVersionHeldDuringRollback": {
+			args: args{
+				name: name,
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Version = "1.20.8-gke.900"
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.UpgradeAction = gcp.StringPtr(v1beta1.UpgradeActionRollback)
+					p.Version = gcp.StringPtr("1.21.1-gke.2200")
+				}),
+			},
+			want: want{
+				upToDate: true,
+				isErr:    false,
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -689,6 +936,149 @@ func TestIsUpToDate(t *testing.T) {
 	}
 }
 
+func TestConfigUpdate(t *testing.T) {
+	type args struct {
+		desired  *container.NodePool
+		observed *container.NodePool
+	}
+	cases := map[string]struct {
+		args args
+		want *container.UpdateNodePoolRequest
+	}{
+		"NoChanges": {
+			args: args{
+				desired:  nodePool(),
+				observed: nodePool(),
+			},
+			want: nil,
+		},
+		"Labels": {
+			args: args{
+				desired: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Labels: map[string]string{"cool-key": "new-value"}}
+				}),
+				observed: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Labels: map[string]string{"cool-key": "cool-value", runtimeKey: "gvisor"}}
+				}),
+			},
+			want: &container.UpdateNodePoolRequest{
+				Labels: &container.NodeLabels{Labels: map[string]string{"cool-key": "new-value", runtimeKey: "gvisor"}},
+			},
+		},
+		"Taints": {
+			args: args{
+				desired: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Taints: []*container.NodeTaint{{Key: "cool-key", Effect: "NO_SCHEDULE"}}}
+				}),
+				observed: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Taints: []*container.NodeTaint{{Key: runtimeKey, Effect: "NO_SCHEDULE"}}}
+				}),
+			},
+			want: &container.UpdateNodePoolRequest{
+				Taints: &container.NodeTaints{Taints: []*container.NodeTaint{
+					{Key: "cool-key", Effect: "NO_SCHEDULE"},
+					{Key: runtimeKey, Effect: "NO_SCHEDULE"},
+				}},
+			},
+		},
+		"OneFieldAtATime": {
+			args: args{
+				desired: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{
+						Tags:          []string{"cool-tag"},
+						KubeletConfig: &container.NodeKubeletConfig{CpuManagerPolicy: "static"},
+					}
+				}),
+				observed: nodePool(),
+			},
+			want: &container.UpdateNodePoolRequest{
+				Tags: &container.NetworkTags{Tags: []string{"cool-tag"}},
+			},
+		},
+		"ImageType": {
+			args: args{
+				desired: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ImageType: "COS_CONTAINERD"}
+				}),
+				observed: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ImageType: "COS"}
+				}),
+			},
+			want: &container.UpdateNodePoolRequest{ImageType: "COS_CONTAINERD"},
+		},
+		"ImageTypeCaseInsensitive": {
+			args: args{
+				desired: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ImageType: "cos_containerd"}
+				}),
+				observed: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{ImageType: "COS_CONTAINERD"}
+				}),
+			},
+			want: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := configUpdate(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("configUpdate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReplacementRequired(t *testing.T) {
+	type args struct {
+		nodePool *container.NodePool
+		params   *v1beta1.NodePoolParameters
+	}
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"None": {
+			args: args{
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{Labels: map[string]string{"cool-key": "cool-value"}}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.Config = &v1beta1.NodeConfig{Labels: map[string]string{"cool-key": "new-value"}}
+				}),
+			},
+			want: nil,
+		},
+		"ImmutableFields": {
+			args: args{
+				nodePool: nodePool(func(n *container.NodePool) {
+					n.Config = &container.NodeConfig{MachineType: "n1-standard-1", DiskSizeGb: 100}
+				}),
+				params: params(func(p *v1beta1.NodePoolParameters) {
+					p.InitialNodeCount = gcp.Int64Ptr(1)
+					p.Config = &v1beta1.NodeConfig{
+						MachineType: gcp.StringPtr("e2-standard-4"),
+						DiskSizeGb:  gcp.Int64Ptr(100),
+					}
+				}),
+			},
+			want: []string{"initialNodeCount", "config.machineType"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ReplacementRequired(name, tc.args.params, tc.args.nodePool)
+			if err != nil {
+				t.Fatalf("ReplacementRequired(...): unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ReplacementRequired(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGetFullyQualifiedName(t *testing.T) {
 	type args struct {
 		params v1beta1.NodePoolParameters
@@ -724,3 +1114,50 @@ func TestGetFullyQualifiedName(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackMasterVersion(t *testing.T) {
+	observedVersion := "1.20.8-gke.900"
+	masterVersion := "1.21.1-gke.2200"
+	type args struct {
+		in       *v1beta1.NodePoolParameters
+		cluster  *container.Cluster
+		observed *container.NodePool
+	}
+	tests := map[string]struct {
+		args args
+		want *v1beta1.NodePoolParameters
+	}{
+		"NotTracking": {
+			args: args{
+				in:       &v1beta1.NodePoolParameters{},
+				cluster:  &container.Cluster{Status: v1beta2.ClusterStateRunning, CurrentMasterVersion: masterVersion},
+				observed: &container.NodePool{Version: observedVersion},
+			},
+			want: &v1beta1.NodePoolParameters{},
+		},
+		"MasterUpgraded": {
+			args: args{
+				in:       &v1beta1.NodePoolParameters{TrackMasterVersion: gcp.BoolPtr(true)},
+				cluster:  &container.Cluster{Status: v1beta2.ClusterStateRunning, CurrentMasterVersion: masterVersion},
+				observed: &container.NodePool{Version: observedVersion},
+			},
+			want: &v1beta1.NodePoolParameters{TrackMasterVersion: gcp.BoolPtr(true), Version: &masterVersion},
+		},
+		"MasterUpgrading": {
+			args: args{
+				in:       &v1beta1.NodePoolParameters{TrackMasterVersion: gcp.BoolPtr(true)},
+				cluster:  &container.Cluster{Status: v1beta2.ClusterStateReconciling, CurrentMasterVersion: masterVersion},
+				observed: &container.NodePool{Version: observedVersion},
+			},
+			want: &v1beta1.NodePoolParameters{TrackMasterVersion: gcp.BoolPtr(true), Version: &observedVersion},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			TrackMasterVersion(tc.args.in, tc.args.cluster, tc.args.observed)
+			if diff := cmp.Diff(tc.want, tc.args.in); diff != "" {
+				t.Errorf("TrackMasterVersion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
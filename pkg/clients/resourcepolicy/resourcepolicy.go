@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateResourcePolicy populates the supplied compute.ResourcePolicy with
+// the supplied ResourcePolicyParameters.
+func GenerateResourcePolicy(name string, in v1alpha1.ResourcePolicyParameters, rp *compute.ResourcePolicy) {
+	rp.Name = name
+	rp.Description = gcp.StringValue(in.Description)
+
+	sp := in.SnapshotSchedulePolicy
+	rp.SnapshotSchedulePolicy = &compute.ResourcePolicySnapshotSchedulePolicy{
+		Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{},
+	}
+	if s := sp.Schedule.DailySchedule; s != nil {
+		rp.SnapshotSchedulePolicy.Schedule.DailySchedule = &compute.ResourcePolicyDailyCycle{
+			DaysInCycle: s.DaysInCycle,
+			StartTime:   s.StartTime,
+		}
+	}
+	if s := sp.Schedule.HourlySchedule; s != nil {
+		rp.SnapshotSchedulePolicy.Schedule.HourlySchedule = &compute.ResourcePolicyHourlyCycle{
+			HoursInCycle: s.HoursInCycle,
+			StartTime:    s.StartTime,
+		}
+	}
+	if s := sp.Schedule.WeeklySchedule; s != nil {
+		rp.SnapshotSchedulePolicy.Schedule.WeeklySchedule = &compute.ResourcePolicyWeeklyCycle{}
+		for _, d := range s.DayOfWeeks {
+			rp.SnapshotSchedulePolicy.Schedule.WeeklySchedule.DayOfWeeks = append(rp.SnapshotSchedulePolicy.Schedule.WeeklySchedule.DayOfWeeks,
+				&compute.ResourcePolicyWeeklyCycleDayOfWeek{Day: d.Day, StartTime: d.StartTime})
+		}
+	}
+	if r := sp.RetentionPolicy; r != nil {
+		rp.SnapshotSchedulePolicy.RetentionPolicy = &compute.ResourcePolicySnapshotSchedulePolicyRetentionPolicy{
+			MaxRetentionDays:   r.MaxRetentionDays,
+			OnSourceDiskDelete: gcp.StringValue(r.OnSourceDiskDelete),
+		}
+	}
+	if p := sp.SnapshotProperties; p != nil {
+		rp.SnapshotSchedulePolicy.SnapshotProperties = &compute.ResourcePolicySnapshotSchedulePolicySnapshotProperties{
+			GuestFlush:       gcp.BoolValue(p.GuestFlush),
+			Labels:           p.Labels,
+			StorageLocations: p.StorageLocations,
+		}
+	}
+}
+
+// GenerateResourcePolicyObservation takes a compute.ResourcePolicy and
+// returns *ResourcePolicyObservation.
+func GenerateResourcePolicyObservation(observed compute.ResourcePolicy) v1alpha1.ResourcePolicyObservation {
+	return v1alpha1.ResourcePolicyObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+		Status:            observed.Status,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.ResourcePolicy object.
+func LateInitializeSpec(spec *v1alpha1.ResourcePolicyParameters, in compute.ResourcePolicy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+
+	sp := in.SnapshotSchedulePolicy
+	if sp == nil {
+		return
+	}
+	if r := spec.SnapshotSchedulePolicy.RetentionPolicy; r != nil && sp.RetentionPolicy != nil {
+		r.OnSourceDiskDelete = gcp.LateInitializeString(r.OnSourceDiskDelete, sp.RetentionPolicy.OnSourceDiskDelete)
+	}
+	if p := sp.SnapshotProperties; p != nil && len(p.Labels)+len(p.StorageLocations) > 0 {
+		if spec.SnapshotSchedulePolicy.SnapshotProperties == nil {
+			spec.SnapshotSchedulePolicy.SnapshotProperties = &v1alpha1.ResourcePolicySnapshotProperties{}
+		}
+		p := spec.SnapshotSchedulePolicy.SnapshotProperties
+		p.Labels = gcp.LateInitializeStringMap(p.Labels, sp.SnapshotProperties.Labels)
+		p.StorageLocations = gcp.LateInitializeStringSlice(p.StorageLocations, sp.SnapshotProperties.StorageLocations)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourcepolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	name        = "coolPolicy"
+	description = "coolDescription"
+	startTime   = "04:00"
+)
+
+func params(m ...func(*v1alpha1.ResourcePolicyParameters)) *v1alpha1.ResourcePolicyParameters {
+	o := &v1alpha1.ResourcePolicyParameters{
+		Region:      "us-central1",
+		Description: &description,
+		SnapshotSchedulePolicy: v1alpha1.ResourcePolicySnapshotSchedulePolicy{
+			Schedule: v1alpha1.ResourcePolicySnapshotSchedule{
+				WeeklySchedule: &v1alpha1.ResourcePolicyWeeklyCycle{
+					DayOfWeeks: []v1alpha1.ResourcePolicyWeeklyCycleDayOfWeek{
+						{Day: "MONDAY", StartTime: startTime},
+						{Day: "THURSDAY", StartTime: startTime},
+					},
+				},
+			},
+			RetentionPolicy: &v1alpha1.ResourcePolicySnapshotRetentionPolicy{
+				MaxRetentionDays:   14,
+				OnSourceDiskDelete: gcp.StringPtr(v1alpha1.OnSourceDiskDeleteKeepAutoSnapshots),
+			},
+			SnapshotProperties: &v1alpha1.ResourcePolicySnapshotProperties{
+				GuestFlush:       gcp.BoolPtr(true),
+				Labels:           map[string]string{"cool": "true"},
+				StorageLocations: []string{"us"},
+			},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func resourcePolicy(m ...func(*compute.ResourcePolicy)) *compute.ResourcePolicy {
+	o := &compute.ResourcePolicy{
+		Name:        name,
+		Description: description,
+		SnapshotSchedulePolicy: &compute.ResourcePolicySnapshotSchedulePolicy{
+			Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{
+				WeeklySchedule: &compute.ResourcePolicyWeeklyCycle{
+					DayOfWeeks: []*compute.ResourcePolicyWeeklyCycleDayOfWeek{
+						{Day: "MONDAY", StartTime: startTime},
+						{Day: "THURSDAY", StartTime: startTime},
+					},
+				},
+			},
+			RetentionPolicy: &compute.ResourcePolicySnapshotSchedulePolicyRetentionPolicy{
+				MaxRetentionDays:   14,
+				OnSourceDiskDelete: v1alpha1.OnSourceDiskDeleteKeepAutoSnapshots,
+			},
+			SnapshotProperties: &compute.ResourcePolicySnapshotSchedulePolicySnapshotProperties{
+				GuestFlush:       true,
+				Labels:           map[string]string{"cool": "true"},
+				StorageLocations: []string{"us"},
+			},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateResourcePolicy(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.ResourcePolicyParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.ResourcePolicy
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: resourcePolicy(),
+		},
+		"HourlyOnly": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.ResourcePolicyParameters) {
+					p.Description = nil
+					p.SnapshotSchedulePolicy = v1alpha1.ResourcePolicySnapshotSchedulePolicy{
+						Schedule: v1alpha1.ResourcePolicySnapshotSchedule{
+							HourlySchedule: &v1alpha1.ResourcePolicyHourlyCycle{HoursInCycle: 4, StartTime: startTime},
+						},
+					}
+				}),
+			},
+			want: &compute.ResourcePolicy{
+				Name: name,
+				SnapshotSchedulePolicy: &compute.ResourcePolicySnapshotSchedulePolicy{
+					Schedule: &compute.ResourcePolicySnapshotSchedulePolicySchedule{
+						HourlySchedule: &compute.ResourcePolicyHourlyCycle{HoursInCycle: 4, StartTime: startTime},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.ResourcePolicy{}
+			GenerateResourcePolicy(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(r, tc.want); diff != "" {
+				t.Errorf("GenerateResourcePolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.ResourcePolicyParameters
+		in   compute.ResourcePolicy
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.ResourcePolicyParameters
+	}{
+		"AllFilledNoDiff": {
+			args: args{
+				spec: params(),
+				in:   *resourcePolicy(),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.ResourcePolicyParameters) {
+					p.Description = nil
+					p.SnapshotSchedulePolicy.RetentionPolicy.OnSourceDiskDelete = nil
+					p.SnapshotSchedulePolicy.SnapshotProperties = nil
+				}),
+				in: *resourcePolicy(),
+			},
+			want: params(func(p *v1alpha1.ResourcePolicyParameters) {
+				p.SnapshotSchedulePolicy.SnapshotProperties.GuestFlush = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicy
+
+import (
+	"sort"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateSecurityPolicy populates the supplied compute.SecurityPolicy with
+// the supplied SecurityPolicyParameters.
+func GenerateSecurityPolicy(name string, in v1alpha1.SecurityPolicyParameters, sp *compute.SecurityPolicy) {
+	sp.Name = name
+	sp.Description = gcp.StringValue(in.Description)
+
+	sp.Rules = nil
+	for _, r := range in.Rules {
+		sp.Rules = append(sp.Rules, GenerateSecurityPolicyRule(r))
+	}
+}
+
+// GenerateSecurityPolicyRule returns the compute.SecurityPolicyRule that
+// corresponds to the supplied SecurityPolicyRule.
+func GenerateSecurityPolicyRule(in v1alpha1.SecurityPolicyRule) *compute.SecurityPolicyRule {
+	r := &compute.SecurityPolicyRule{
+		Action:      in.Action,
+		Description: gcp.StringValue(in.Description),
+		Preview:     gcp.BoolValue(in.Preview),
+		Priority:    in.Priority,
+		Match: &compute.SecurityPolicyRuleMatcher{
+			VersionedExpr: gcp.StringValue(in.Match.VersionedExpr),
+		},
+	}
+	// Priority zero is the highest priority, and a rule that is taken out
+	// of preview must be patched with preview false, so both are sent
+	// explicitly.
+	r.ForceSendFields = []string{"Action", "Preview", "Priority"}
+	if in.Match.Config != nil {
+		r.Match.Config = &compute.SecurityPolicyRuleMatcherConfig{
+			SrcIpRanges: in.Match.Config.SrcIPRanges,
+		}
+	}
+	if in.Match.Expr != nil {
+		r.Match.Expr = &compute.Expr{
+			Expression: in.Match.Expr.Expression,
+		}
+	}
+	if in.RateLimitOptions != nil {
+		r.RateLimitOptions = GenerateRateLimitOptions(*in.RateLimitOptions)
+	}
+	return r
+}
+
+// GenerateRateLimitOptions returns the
+// compute.SecurityPolicyRuleRateLimitOptions that correspond to the supplied
+// SecurityPolicyRuleRateLimitOptions.
+func GenerateRateLimitOptions(in v1alpha1.SecurityPolicyRuleRateLimitOptions) *compute.SecurityPolicyRuleRateLimitOptions {
+	o := &compute.SecurityPolicyRuleRateLimitOptions{
+		BanDurationSec:   gcp.Int64Value(in.BanDurationSec),
+		ConformAction:    in.ConformAction,
+		EnforceOnKey:     gcp.StringValue(in.EnforceOnKey),
+		EnforceOnKeyName: gcp.StringValue(in.EnforceOnKeyName),
+		ExceedAction:     in.ExceedAction,
+		RateLimitThreshold: &compute.SecurityPolicyRuleRateLimitOptionsThreshold{
+			Count:       in.RateLimitThreshold.Count,
+			IntervalSec: in.RateLimitThreshold.IntervalSec,
+		},
+	}
+	if in.BanThreshold != nil {
+		o.BanThreshold = &compute.SecurityPolicyRuleRateLimitOptionsThreshold{
+			Count:       in.BanThreshold.Count,
+			IntervalSec: in.BanThreshold.IntervalSec,
+		}
+	}
+	if in.ExceedRedirectOptions != nil {
+		o.ExceedRedirectOptions = &compute.SecurityPolicyRuleRedirectOptions{
+			Target: gcp.StringValue(in.ExceedRedirectOptions.Target),
+			Type:   in.ExceedRedirectOptions.Type,
+		}
+	}
+	return o
+}
+
+// GenerateSecurityPolicyObservation takes a compute.SecurityPolicy and
+// returns *SecurityPolicyObservation.
+func GenerateSecurityPolicyObservation(observed compute.SecurityPolicy) v1alpha1.SecurityPolicyObservation {
+	return v1alpha1.SecurityPolicyObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		Fingerprint:       observed.Fingerprint,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.SecurityPolicy object. Rules are not late initialized; rules that
+// exist only in GCP are removed, except for the default rule.
+func LateInitializeSpec(spec *v1alpha1.SecurityPolicyParameters, in compute.SecurityPolicy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsUpToDate returns whether the description of the observed
+// compute.SecurityPolicy, the only field that is updated by patching the
+// policy itself, matches the supplied parameters.
+func IsUpToDate(in *v1alpha1.SecurityPolicyParameters, observed *compute.SecurityPolicy) bool {
+	return gcp.StringValue(in.Description) == observed.Description
+}
+
+// A RuleUpdate describes the rule operations that must be made to bring a
+// SecurityPolicy up to date. Rules are identified by their priority.
+type RuleUpdate struct {
+	Add    []*compute.SecurityPolicyRule
+	Patch  []*compute.SecurityPolicyRule
+	Remove []int64
+}
+
+// Any returns true if any rule requires an update.
+func (u RuleUpdate) Any() bool {
+	return len(u.Add)+len(u.Patch)+len(u.Remove) > 0
+}
+
+// GetRuleUpdate returns the rules of the observed compute.SecurityPolicy
+// that must be added, patched or removed to match the supplied parameters.
+// The default rule is never removed.
+func GetRuleUpdate(in *v1alpha1.SecurityPolicyParameters, observed *compute.SecurityPolicy) RuleUpdate {
+	existing := make(map[int64]*compute.SecurityPolicyRule, len(observed.Rules))
+	for _, r := range observed.Rules {
+		existing[r.Priority] = r
+	}
+
+	u := RuleUpdate{}
+	desired := make(map[int64]bool, len(in.Rules))
+	for _, r := range in.Rules {
+		desired[r.Priority] = true
+		d := GenerateSecurityPolicyRule(r)
+		e, ok := existing[r.Priority]
+		switch {
+		case !ok:
+			u.Add = append(u.Add, d)
+		case !IsRuleUpToDate(d, e):
+			u.Patch = append(u.Patch, d)
+		}
+	}
+	for p := range existing {
+		if !desired[p] && p != v1alpha1.DefaultSecurityPolicyRulePriority {
+			u.Remove = append(u.Remove, p)
+		}
+	}
+	sort.Slice(u.Remove, func(i, j int) bool { return u.Remove[i] < u.Remove[j] })
+	return u
+}
+
+// IsRuleUpToDate returns true if the observed rule matches the desired rule.
+// A rate limit that does not specify a key is enforced on all requests, which
+// GCP reports as the ALL key.
+func IsRuleUpToDate(desired, observed *compute.SecurityPolicyRule) bool {
+	if o := desired.RateLimitOptions; o != nil && o.EnforceOnKey == "" {
+		d := *desired
+		rl := *o
+		rl.EnforceOnKey = v1alpha1.EnforceOnKeyAll
+		d.RateLimitOptions = &rl
+		desired = &d
+	}
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRule{}, "Kind", "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRuleMatcher{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRuleMatcherConfig{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.Expr{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRuleRateLimitOptions{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRuleRateLimitOptionsThreshold{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.SecurityPolicyRuleRedirectOptions{}, "ForceSendFields"),
+	)
+}
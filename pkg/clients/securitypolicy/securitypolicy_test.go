@@ -0,0 +1,316 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securitypolicy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+var (
+	name        = "coolName"
+	description = "coolDescription"
+	expression  = "origin.region_code == 'AU'"
+	srcIPRanges = []string{"10.0.0.0/8"}
+
+	timestamp          = "coolTime"
+	link               = "coolLink"
+	fingerprint        = "coolFingerprint"
+	id          uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.SecurityPolicyParameters)) *v1alpha1.SecurityPolicyParameters {
+	o := &v1alpha1.SecurityPolicyParameters{
+		Description: &description,
+		Rules: []v1alpha1.SecurityPolicyRule{
+			{
+				Action:   "deny(403)",
+				Priority: 0,
+				Match: v1alpha1.SecurityPolicyRuleMatcher{
+					Expr: &v1alpha1.SecurityPolicyRuleExpr{Expression: expression},
+				},
+			},
+			{
+				Action:   "allow",
+				Priority: 1000,
+				Preview:  gcp.BoolPtr(true),
+				Match: v1alpha1.SecurityPolicyRuleMatcher{
+					VersionedExpr: gcp.StringPtr(v1alpha1.VersionedExprSrcIPsV1),
+					Config:        &v1alpha1.SecurityPolicyRuleMatcherConfig{SrcIPRanges: srcIPRanges},
+				},
+			},
+		},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func rule(action string, priority int64, m ...func(*compute.SecurityPolicyRule)) *compute.SecurityPolicyRule {
+	r := &compute.SecurityPolicyRule{
+		Action:          action,
+		Priority:        priority,
+		Match:           &compute.SecurityPolicyRuleMatcher{},
+		ForceSendFields: []string{"Action", "Preview", "Priority"},
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func exprRule() *compute.SecurityPolicyRule {
+	return rule("deny(403)", 0, func(r *compute.SecurityPolicyRule) {
+		r.Match.Expr = &compute.Expr{Expression: expression}
+	})
+}
+
+func srcIPRule() *compute.SecurityPolicyRule {
+	return rule("allow", 1000, func(r *compute.SecurityPolicyRule) {
+		r.Preview = true
+		r.Match.VersionedExpr = v1alpha1.VersionedExprSrcIPsV1
+		r.Match.Config = &compute.SecurityPolicyRuleMatcherConfig{SrcIpRanges: srcIPRanges}
+	})
+}
+
+func defaultRule() *compute.SecurityPolicyRule {
+	return rule("allow", v1alpha1.DefaultSecurityPolicyRulePriority, func(r *compute.SecurityPolicyRule) {
+		r.Match.VersionedExpr = v1alpha1.VersionedExprSrcIPsV1
+		r.Match.Config = &compute.SecurityPolicyRuleMatcherConfig{SrcIpRanges: []string{"*"}}
+	})
+}
+
+func throttleRule() *compute.SecurityPolicyRule {
+	return rule("throttle", 2000, func(r *compute.SecurityPolicyRule) {
+		r.Match.VersionedExpr = v1alpha1.VersionedExprSrcIPsV1
+		r.Match.Config = &compute.SecurityPolicyRuleMatcherConfig{SrcIpRanges: []string{"*"}}
+		r.RateLimitOptions = &compute.SecurityPolicyRuleRateLimitOptions{
+			ConformAction:      "allow",
+			ExceedAction:       "deny(429)",
+			RateLimitThreshold: &compute.SecurityPolicyRuleRateLimitOptionsThreshold{Count: 100, IntervalSec: 60},
+		}
+	})
+}
+
+func throttleParams(p *v1alpha1.SecurityPolicyParameters) {
+	p.Rules = append(p.Rules, v1alpha1.SecurityPolicyRule{
+		Action:   "throttle",
+		Priority: 2000,
+		Match: v1alpha1.SecurityPolicyRuleMatcher{
+			VersionedExpr: gcp.StringPtr(v1alpha1.VersionedExprSrcIPsV1),
+			Config:        &v1alpha1.SecurityPolicyRuleMatcherConfig{SrcIPRanges: []string{"*"}},
+		},
+		RateLimitOptions: &v1alpha1.SecurityPolicyRuleRateLimitOptions{
+			ConformAction:      "allow",
+			ExceedAction:       "deny(429)",
+			RateLimitThreshold: v1alpha1.SecurityPolicyRuleRateLimitThreshold{Count: 100, IntervalSec: 60},
+		},
+	})
+}
+
+func securityPolicy(m ...func(*compute.SecurityPolicy)) *compute.SecurityPolicy {
+	o := &compute.SecurityPolicy{
+		Name:        name,
+		Description: description,
+		Rules:       []*compute.SecurityPolicyRule{exprRule(), srcIPRule()},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateSecurityPolicy(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.SecurityPolicyParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.SecurityPolicy
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: securityPolicy(),
+		},
+		"NoRules": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.SecurityPolicyParameters) {
+					p.Rules = nil
+				}),
+			},
+			want: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = nil
+			}),
+		},
+		"RateLimitedRule": {
+			args: args{
+				name: name,
+				in:   *params(throttleParams),
+			},
+			want: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = append(sp.Rules, throttleRule())
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.SecurityPolicy{}
+			GenerateSecurityPolicy(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateSecurityPolicy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSecurityPolicyObservation(t *testing.T) {
+	in := securityPolicy(func(sp *compute.SecurityPolicy) {
+		sp.CreationTimestamp = timestamp
+		sp.Fingerprint = fingerprint
+		sp.Id = id
+		sp.SelfLink = link
+	})
+	want := v1alpha1.SecurityPolicyObservation{
+		CreationTimestamp: timestamp,
+		Fingerprint:       fingerprint,
+		ID:                id,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateSecurityPolicyObservation(*in)); diff != "" {
+		t.Errorf("GenerateSecurityPolicyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := params(func(p *v1alpha1.SecurityPolicyParameters) {
+		p.Description = nil
+		p.Rules = nil
+	})
+	LateInitializeSpec(spec, *securityPolicy())
+	want := params(func(p *v1alpha1.SecurityPolicyParameters) {
+		p.Rules = nil
+	})
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetRuleUpdate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.SecurityPolicyParameters
+		observed *compute.SecurityPolicy
+		want     RuleUpdate
+	}{
+		"UpToDateIgnoringDefaultRule": {
+			in: params(),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = append(sp.Rules, defaultRule())
+			}),
+			want: RuleUpdate{},
+		},
+		"AddRule": {
+			in: params(),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = []*compute.SecurityPolicyRule{srcIPRule(), defaultRule()}
+			}),
+			want: RuleUpdate{Add: []*compute.SecurityPolicyRule{exprRule()}},
+		},
+		"PatchRule": {
+			in: params(),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules[1].Preview = false
+			}),
+			want: RuleUpdate{Patch: []*compute.SecurityPolicyRule{srcIPRule()}},
+		},
+		"RateLimitUpToDateWithDefaultKey": {
+			in: params(throttleParams),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				r := throttleRule()
+				r.RateLimitOptions.EnforceOnKey = v1alpha1.EnforceOnKeyAll
+				sp.Rules = append(sp.Rules, r, defaultRule())
+			}),
+			want: RuleUpdate{},
+		},
+		"PatchRateLimit": {
+			in: params(throttleParams),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				r := throttleRule()
+				r.RateLimitOptions.RateLimitThreshold.Count = 50
+				sp.Rules = append(sp.Rules, r)
+			}),
+			want: RuleUpdate{Patch: []*compute.SecurityPolicyRule{throttleRule()}},
+		},
+		"RemoveRules": {
+			in: params(func(p *v1alpha1.SecurityPolicyParameters) {
+				p.Rules = nil
+			}),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = append(sp.Rules, defaultRule())
+			}),
+			want: RuleUpdate{Remove: []int64{0, 1000}},
+		},
+		"PatchDefaultRule": {
+			in: params(func(p *v1alpha1.SecurityPolicyParameters) {
+				p.Rules = []v1alpha1.SecurityPolicyRule{{
+					Action:   "deny(404)",
+					Priority: v1alpha1.DefaultSecurityPolicyRulePriority,
+					Match: v1alpha1.SecurityPolicyRuleMatcher{
+						VersionedExpr: gcp.StringPtr(v1alpha1.VersionedExprSrcIPsV1),
+						Config:        &v1alpha1.SecurityPolicyRuleMatcherConfig{SrcIPRanges: []string{"*"}},
+					},
+				}}
+			}),
+			observed: securityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = []*compute.SecurityPolicyRule{defaultRule()}
+			}),
+			want: RuleUpdate{Patch: []*compute.SecurityPolicyRule{
+				rule("deny(404)", v1alpha1.DefaultSecurityPolicyRulePriority, func(r *compute.SecurityPolicyRule) {
+					r.Match.VersionedExpr = v1alpha1.VersionedExprSrcIPsV1
+					r.Match.Config = &compute.SecurityPolicyRuleMatcherConfig{SrcIpRanges: []string{"*"}}
+				}),
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetRuleUpdate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetRuleUpdate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.Any(), got.Any()); diff != "" {
+				t.Errorf("Any(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
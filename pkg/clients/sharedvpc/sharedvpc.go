@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharedvpc
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+// Known Shared VPC values.
+const (
+	// XpnProjectStatusHost is the XpnProjectStatus of a Shared VPC host
+	// project.
+	XpnProjectStatusHost = "HOST"
+
+	// XpnResourceTypeProject is the XpnResourceId type of a service
+	// project.
+	XpnResourceTypeProject = "PROJECT"
+)
+
+// IsHostProject returns true if the supplied project is enabled as a Shared
+// VPC host project.
+func IsHostProject(p *compute.Project) bool {
+	return p != nil && p.XpnProjectStatus == XpnProjectStatusHost
+}
+
+// GenerateXpnResource returns the XpnResourceId of the service project
+// described by the supplied SharedVPCServiceProjectParameters.
+func GenerateXpnResource(in v1alpha1.SharedVPCServiceProjectParameters) *compute.XpnResourceId {
+	return &compute.XpnResourceId{
+		Id:   in.ServiceProject,
+		Type: XpnResourceTypeProject,
+	}
+}
+
+// HasServiceProject returns true if the supplied service project is among
+// the supplied resources of a Shared VPC host project.
+func HasServiceProject(resources []*compute.XpnResourceId, project string) bool {
+	for _, r := range resources {
+		if r.Type == XpnResourceTypeProject && r.Id == project {
+			return true
+		}
+	}
+	return false
+}
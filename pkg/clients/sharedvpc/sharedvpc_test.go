@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharedvpc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+)
+
+const serviceProject = "coolService"
+
+func TestIsHostProject(t *testing.T) {
+	cases := map[string]struct {
+		in   *compute.Project
+		want bool
+	}{
+		"Nil": {
+			in:   nil,
+			want: false,
+		},
+		"Host": {
+			in:   &compute.Project{XpnProjectStatus: XpnProjectStatusHost},
+			want: true,
+		},
+		"Unspecified": {
+			in:   &compute.Project{XpnProjectStatus: "UNSPECIFIED_XPN_PROJECT_STATUS"},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsHostProject(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsHostProject(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasServiceProject(t *testing.T) {
+	cases := map[string]struct {
+		in   []*compute.XpnResourceId
+		want bool
+	}{
+		"Empty": {
+			in:   nil,
+			want: false,
+		},
+		"Attached": {
+			in: []*compute.XpnResourceId{
+				{Id: "other", Type: XpnResourceTypeProject},
+				{Id: serviceProject, Type: XpnResourceTypeProject},
+			},
+			want: true,
+		},
+		"OtherType": {
+			in:   []*compute.XpnResourceId{{Id: serviceProject, Type: "XPN_RESOURCE_TYPE_UNSPECIFIED"}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := HasServiceProject(tc.in, serviceProject)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("HasServiceProject(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/disk"
+)
+
+// GenerateSnapshot populates the supplied compute.Snapshot with the supplied
+// SnapshotParameters. The source disk is not part of the snapshot; it is
+// specified when the snapshot is created.
+func GenerateSnapshot(name string, in v1alpha1.SnapshotParameters, s *compute.Snapshot) {
+	s.Name = name
+	s.Description = gcp.StringValue(in.Description)
+	s.Labels = in.Labels
+	s.SnapshotEncryptionKey = disk.GenerateCustomerEncryptionKey(in.SnapshotEncryptionKey)
+	s.StorageLocations = in.StorageLocations
+}
+
+// GenerateSnapshotObservation takes a compute.Snapshot and returns
+// *SnapshotObservation.
+func GenerateSnapshotObservation(observed compute.Snapshot) v1alpha1.SnapshotObservation {
+	return v1alpha1.SnapshotObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		DiskSizeGB:        observed.DiskSizeGb,
+		ID:                observed.Id,
+		LabelFingerprint:  observed.LabelFingerprint,
+		SelfLink:          observed.SelfLink,
+		SourceDiskID:      observed.SourceDiskId,
+		Status:            observed.Status,
+		StorageBytes:      observed.StorageBytes,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.Snapshot object.
+func LateInitializeSpec(spec *v1alpha1.SnapshotParameters, in compute.Snapshot) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.Labels = gcp.LateInitializeStringMap(spec.Labels, in.Labels)
+	spec.SourceDisk = gcp.LateInitializeString(spec.SourceDisk, in.SourceDisk)
+	spec.StorageLocations = gcp.LateInitializeStringSlice(spec.StorageLocations, in.StorageLocations)
+}
+
+// IsUpToDate returns whether the labels of the observed compute.Snapshot,
+// the only field of a snapshot that may be updated, match the supplied
+// parameters.
+func IsUpToDate(in *v1alpha1.SnapshotParameters, observed *compute.Snapshot) bool {
+	return cmp.Equal(in.Labels, observed.Labels, cmpopts.EquateEmpty())
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name        = "coolSnapshot"
+	description = "coolDescription"
+	sourceDisk  = "projects/cool/zones/us-central1-a/disks/coolDisk"
+	kmsKeyName  = "projects/cool/locations/us/keyRings/cool/cryptoKeys/cool"
+	labels      = map[string]string{"cool": "true"}
+	locations   = []string{"us"}
+)
+
+func params(m ...func(*v1alpha1.SnapshotParameters)) *v1alpha1.SnapshotParameters {
+	o := &v1alpha1.SnapshotParameters{
+		SourceDisk:            &sourceDisk,
+		Description:           &description,
+		Labels:                labels,
+		SnapshotEncryptionKey: &v1alpha1.CustomerEncryptionKey{KMSKeyName: &kmsKeyName},
+		StorageLocations:      locations,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func snapshot(m ...func(*compute.Snapshot)) *compute.Snapshot {
+	o := &compute.Snapshot{
+		Name:                  name,
+		Description:           description,
+		Labels:                labels,
+		SnapshotEncryptionKey: &compute.CustomerEncryptionKey{KmsKeyName: kmsKeyName},
+		StorageLocations:      locations,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateSnapshot(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.SnapshotParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.Snapshot
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: snapshot(),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.SnapshotParameters) {
+					p.SnapshotEncryptionKey = nil
+				}),
+			},
+			want: snapshot(func(s *compute.Snapshot) {
+				s.SnapshotEncryptionKey = nil
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.Snapshot{}
+			GenerateSnapshot(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(r, tc.want); diff != "" {
+				t.Errorf("GenerateSnapshot(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	type args struct {
+		spec *v1alpha1.SnapshotParameters
+		in   compute.Snapshot
+	}
+	cases := map[string]struct {
+		args args
+		want *v1alpha1.SnapshotParameters
+	}{
+		"AllFilledExternalDiff": {
+			args: args{
+				spec: params(),
+				in: *snapshot(func(s *compute.Snapshot) {
+					s.Description = "some other description"
+					s.SourceDisk = "https://www.googleapis.com/compute/v1/" + sourceDisk
+				}),
+			},
+			want: params(),
+		},
+		"PartialFilled": {
+			args: args{
+				spec: params(func(p *v1alpha1.SnapshotParameters) {
+					p.StorageLocations = nil
+				}),
+				in: *snapshot(),
+			},
+			want: params(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeSpec(tc.args.spec, tc.args.in)
+			if diff := cmp.Diff(tc.args.spec, tc.want); diff != "" {
+				t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.SnapshotParameters
+		observed *compute.Snapshot
+		want     bool
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: snapshot(),
+			want:     true,
+		},
+		"NoLabels": {
+			in: params(func(p *v1alpha1.SnapshotParameters) {
+				p.Labels = map[string]string{}
+			}),
+			observed: snapshot(func(s *compute.Snapshot) {
+				s.Labels = nil
+			}),
+			want: true,
+		},
+		"LabelsDiffer": {
+			in: params(),
+			observed: snapshot(func(s *compute.Snapshot) {
+				s.Labels = map[string]string{"cool": "false"}
+			}),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsUpToDate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sslcertificate
+
+import (
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateSSLCertificate populates the supplied compute.SslCertificate with
+// the supplied SSLCertificateParameters. The certificate and private key of a
+// self-managed certificate are read from secrets by the caller.
+func GenerateSSLCertificate(name string, in v1alpha1.SSLCertificateParameters, certificate, privateKey string, c *compute.SslCertificate) {
+	// The SslCertificate API does not support updates, so we can safely
+	// convert any nil pointer to their zero values.
+	c.Name = name
+	c.Description = gcp.StringValue(in.Description)
+	c.Type = in.Type
+
+	if in.Managed != nil {
+		c.Managed = &compute.SslCertificateManagedSslCertificate{
+			Domains: in.Managed.Domains,
+		}
+	}
+	if in.SelfManaged != nil {
+		c.SelfManaged = &compute.SslCertificateSelfManagedSslCertificate{
+			Certificate: certificate,
+			PrivateKey:  privateKey,
+		}
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.SslCertificate object.
+func LateInitializeSpec(spec *v1alpha1.SSLCertificateParameters, in compute.SslCertificate) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	if spec.Type == "" {
+		spec.Type = in.Type
+	}
+}
+
+// GenerateSSLCertificateObservation takes a compute.SslCertificate and returns
+// *SSLCertificateObservation.
+func GenerateSSLCertificateObservation(observed compute.SslCertificate) v1alpha1.SSLCertificateObservation {
+	o := v1alpha1.SSLCertificateObservation{
+		CreationTimestamp:       observed.CreationTimestamp,
+		ExpireTime:              observed.ExpireTime,
+		ID:                      observed.Id,
+		SelfLink:                observed.SelfLink,
+		SubjectAlternativeNames: observed.SubjectAlternativeNames,
+	}
+	if observed.Managed != nil {
+		o.Status = observed.Managed.Status
+		o.DomainStatus = observed.Managed.DomainStatus
+	}
+	return o
+}
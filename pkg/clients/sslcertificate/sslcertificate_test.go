@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sslcertificate
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name        = "coolName"
+	description = "coolDescription"
+	domains     = []string{"example.com"}
+	certificate = "coolCertificate"
+	privateKey  = "coolKey"
+
+	timestamp        = "coolTime"
+	link             = "coolLink"
+	expire           = "coolExpiry"
+	id        uint64 = 3001
+)
+
+func TestGenerateSSLCertificate(t *testing.T) {
+	type args struct {
+		name        string
+		in          v1alpha1.SSLCertificateParameters
+		certificate string
+		privateKey  string
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.SslCertificate
+	}{
+		"Managed": {
+			args: args{
+				name: name,
+				in: v1alpha1.SSLCertificateParameters{
+					Description: &description,
+					Type:        v1alpha1.SSLCertificateTypeManaged,
+					Managed:     &v1alpha1.ManagedSSLCertificate{Domains: domains},
+				},
+			},
+			want: &compute.SslCertificate{
+				Name:        name,
+				Description: description,
+				Type:        v1alpha1.SSLCertificateTypeManaged,
+				Managed:     &compute.SslCertificateManagedSslCertificate{Domains: domains},
+			},
+		},
+		"SelfManaged": {
+			args: args{
+				name: name,
+				in: v1alpha1.SSLCertificateParameters{
+					Type: v1alpha1.SSLCertificateTypeSelfManaged,
+					SelfManaged: &v1alpha1.SelfManagedSSLCertificate{
+						CertificateSecretRef: xpv1.SecretKeySelector{Key: "tls.crt"},
+						PrivateKeySecretRef:  xpv1.SecretKeySelector{Key: "tls.key"},
+					},
+				},
+				certificate: certificate,
+				privateKey:  privateKey,
+			},
+			want: &compute.SslCertificate{
+				Name: name,
+				Type: v1alpha1.SSLCertificateTypeSelfManaged,
+				SelfManaged: &compute.SslCertificateSelfManagedSslCertificate{
+					Certificate: certificate,
+					PrivateKey:  privateKey,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.SslCertificate{}
+			GenerateSSLCertificate(tc.args.name, tc.args.in, tc.args.certificate, tc.args.privateKey, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateSSLCertificate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateSSLCertificateObservation(t *testing.T) {
+	cases := map[string]struct {
+		in   compute.SslCertificate
+		want v1alpha1.SSLCertificateObservation
+	}{
+		"Managed": {
+			in: compute.SslCertificate{
+				CreationTimestamp:       timestamp,
+				ExpireTime:              expire,
+				Id:                      id,
+				SelfLink:                link,
+				SubjectAlternativeNames: domains,
+				Managed: &compute.SslCertificateManagedSslCertificate{
+					Status:       v1alpha1.SSLCertificateStatusProvisioning,
+					DomainStatus: map[string]string{"example.com": "PROVISIONING"},
+				},
+			},
+			want: v1alpha1.SSLCertificateObservation{
+				CreationTimestamp:       timestamp,
+				ExpireTime:              expire,
+				ID:                      id,
+				SelfLink:                link,
+				SubjectAlternativeNames: domains,
+				Status:                  v1alpha1.SSLCertificateStatusProvisioning,
+				DomainStatus:            map[string]string{"example.com": "PROVISIONING"},
+			},
+		},
+		"SelfManaged": {
+			in: compute.SslCertificate{
+				CreationTimestamp: timestamp,
+				Id:                id,
+				SelfLink:          link,
+			},
+			want: v1alpha1.SSLCertificateObservation{
+				CreationTimestamp: timestamp,
+				ID:                id,
+				SelfLink:          link,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateSSLCertificateObservation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GenerateSSLCertificateObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := &v1alpha1.SSLCertificateParameters{}
+	LateInitializeSpec(spec, compute.SslCertificate{Description: description, Type: v1alpha1.SSLCertificateTypeManaged})
+	want := &v1alpha1.SSLCertificateParameters{Description: &description, Type: v1alpha1.SSLCertificateTypeManaged}
+	if diff := cmp.Diff(want, spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetworkiammember
+
+import (
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const errInvalidSubnetworkURL = "subnetwork must be a URL of the form projects/<project>/regions/<region>/subnetworks/<name>"
+
+// URL identifies a Subnetwork. Project is empty if the URL did not include
+// one.
+type URL struct {
+	Project    string
+	Region     string
+	Subnetwork string
+}
+
+// ParseURL parses the partially or fully qualified URL of a Subnetwork.
+func ParseURL(u string) (URL, error) {
+	parts := strings.Split(strings.TrimPrefix(u, v1beta1.ComputeURIPrefix), "/")
+	s := URL{}
+	if len(parts) == 6 {
+		if parts[0] != "projects" {
+			return URL{}, errors.New(errInvalidSubnetworkURL)
+		}
+		s.Project = parts[1]
+		parts = parts[2:]
+	}
+	if len(parts) != 4 || parts[0] != "regions" || parts[2] != "subnetworks" || parts[1] == "" || parts[3] == "" {
+		return URL{}, errors.New(errInvalidSubnetworkURL)
+	}
+	s.Region = parts[1]
+	s.Subnetwork = parts[3]
+	return s, nil
+}
+
+// GenerateCondition converts the supplied condition to its compute
+// representation.
+func GenerateCondition(in *iamv1alpha1.Expr) *compute.Expr {
+	if in == nil {
+		return nil
+	}
+	return &compute.Expr{
+		Description: gcp.StringValue(in.Description),
+		Expression:  in.Expression,
+		Location:    gcp.StringValue(in.Location),
+		Title:       gcp.StringValue(in.Title),
+	}
+}
+
+// findBinding returns the binding of the supplied policy that has the role
+// and condition of the supplied SubnetworkIAMMemberParameters, if any.
+func findBinding(in v1alpha1.SubnetworkIAMMemberParameters, p *compute.Policy) *compute.Binding {
+	c := GenerateCondition(in.Condition)
+	for _, b := range p.Bindings {
+		if b.Role == in.Role && cmp.Equal(b.Condition, c) {
+			return b
+		}
+	}
+	return nil
+}
+
+// IsMember returns true if the supplied policy grants the role of the
+// supplied SubnetworkIAMMemberParameters to its member.
+func IsMember(in v1alpha1.SubnetworkIAMMemberParameters, p *compute.Policy) bool {
+	b := findBinding(in, p)
+	if b == nil {
+		return false
+	}
+	for _, m := range b.Members {
+		if m == gcp.StringValue(in.Member) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddMember adds the member of the supplied SubnetworkIAMMemberParameters to
+// the matching binding of the supplied policy, creating the binding if it
+// does not exist. It returns false if the policy was already up to date.
+func AddMember(in v1alpha1.SubnetworkIAMMemberParameters, p *compute.Policy) bool {
+	if IsMember(in, p) {
+		return false
+	}
+	// Conditional role bindings require version 3 policies.
+	p.Version = iamv1alpha1.PolicyVersion
+	if b := findBinding(in, p); b != nil {
+		b.Members = append(b.Members, gcp.StringValue(in.Member))
+		return true
+	}
+	p.Bindings = append(p.Bindings, &compute.Binding{
+		Role:      in.Role,
+		Members:   []string{gcp.StringValue(in.Member)},
+		Condition: GenerateCondition(in.Condition),
+	})
+	return true
+}
+
+// RemoveMember removes the member of the supplied
+// SubnetworkIAMMemberParameters from the matching binding of the supplied
+// policy, dropping the binding if it has no members left. It returns false
+// if the policy was already up to date.
+func RemoveMember(in v1alpha1.SubnetworkIAMMemberParameters, p *compute.Policy) bool {
+	if !IsMember(in, p) {
+		return false
+	}
+	p.Version = iamv1alpha1.PolicyVersion
+	target := findBinding(in, p)
+	bindings := make([]*compute.Binding, 0, len(p.Bindings))
+	for _, b := range p.Bindings {
+		if b == target {
+			members := make([]string, 0, len(b.Members))
+			for _, m := range b.Members {
+				if m != gcp.StringValue(in.Member) {
+					members = append(members, m)
+				}
+			}
+			if len(members) == 0 {
+				continue
+			}
+			b.Members = members
+		}
+		bindings = append(bindings, b)
+	}
+	p.Bindings = bindings
+	return true
+}
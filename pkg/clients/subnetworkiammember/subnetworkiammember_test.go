@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package subnetworkiammember
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+var (
+	role       = "roles/compute.networkUser"
+	member     = "serviceAccount:cool@example.iam.gserviceaccount.com"
+	other      = "user:other@example.com"
+	expression = "request.time < timestamp(\"2030-01-01T00:00:00Z\")"
+	title      = "coolTitle"
+)
+
+func params(m ...func(*v1alpha1.SubnetworkIAMMemberParameters)) v1alpha1.SubnetworkIAMMemberParameters {
+	o := &v1alpha1.SubnetworkIAMMemberParameters{
+		Role:   role,
+		Member: &member,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return *o
+}
+
+func withCondition(p *v1alpha1.SubnetworkIAMMemberParameters) {
+	p.Condition = &iamv1alpha1.Expr{Expression: expression, Title: &title}
+}
+
+func TestParseURL(t *testing.T) {
+	type want struct {
+		url URL
+		err error
+	}
+	cases := map[string]struct {
+		in   string
+		want want
+	}{
+		"FullyQualified": {
+			in:   "https://www.googleapis.com/compute/v1/projects/coolProject/regions/us-central1/subnetworks/coolSubnet",
+			want: want{url: URL{Project: "coolProject", Region: "us-central1", Subnetwork: "coolSubnet"}},
+		},
+		"NoProject": {
+			in:   "regions/us-central1/subnetworks/coolSubnet",
+			want: want{url: URL{Region: "us-central1", Subnetwork: "coolSubnet"}},
+		},
+		"Invalid": {
+			in:   "projects/coolProject/global/networks/coolNetwork",
+			want: want{err: errors.New(errInvalidSubnetworkURL)},
+		},
+		"NameOnly": {
+			in:   "coolSubnet",
+			want: want{err: errors.New(errInvalidSubnetworkURL)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseURL(tc.in)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ParseURL(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.url, got); diff != "" {
+				t.Errorf("ParseURL(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMember(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.SubnetworkIAMMemberParameters
+		p    *compute.Policy
+		want bool
+	}{
+		"Member": {
+			in:   params(),
+			p:    &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{other, member}}}},
+			want: true,
+		},
+		"OtherRole": {
+			in:   params(),
+			p:    &compute.Policy{Bindings: []*compute.Binding{{Role: "roles/viewer", Members: []string{member}}}},
+			want: false,
+		},
+		"ConditionDiffers": {
+			in:   params(withCondition),
+			p:    &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{member}}}},
+			want: false,
+		},
+		"ConditionMatches": {
+			in: params(withCondition),
+			p: &compute.Policy{Bindings: []*compute.Binding{{
+				Role:      role,
+				Members:   []string{member},
+				Condition: &compute.Expr{Expression: expression, Title: title},
+			}}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMember(tc.in, tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMember(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	type want struct {
+		changed bool
+		p       *compute.Policy
+	}
+	cases := map[string]struct {
+		in   v1alpha1.SubnetworkIAMMemberParameters
+		p    *compute.Policy
+		want want
+	}{
+		"AlreadyMember": {
+			in: params(),
+			p:  &compute.Policy{Etag: "e", Bindings: []*compute.Binding{{Role: role, Members: []string{member}}}},
+			want: want{
+				changed: false,
+				p:       &compute.Policy{Etag: "e", Bindings: []*compute.Binding{{Role: role, Members: []string{member}}}},
+			},
+		},
+		"ExistingBinding": {
+			in: params(),
+			p:  &compute.Policy{Etag: "e", Bindings: []*compute.Binding{{Role: role, Members: []string{other}}}},
+			want: want{
+				changed: true,
+				p: &compute.Policy{
+					Etag:     "e",
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*compute.Binding{{Role: role, Members: []string{other, member}}},
+				},
+			},
+		},
+		"NewConditionalBinding": {
+			in: params(withCondition),
+			p:  &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{other}}}},
+			want: want{
+				changed: true,
+				p: &compute.Policy{
+					Version: iamv1alpha1.PolicyVersion,
+					Bindings: []*compute.Binding{
+						{Role: role, Members: []string{other}},
+						{Role: role, Members: []string{member}, Condition: &compute.Expr{Expression: expression, Title: title}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AddMember(tc.in, tc.p)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("AddMember(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, tc.p); diff != "" {
+				t.Errorf("AddMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	type want struct {
+		changed bool
+		p       *compute.Policy
+	}
+	cases := map[string]struct {
+		in   v1alpha1.SubnetworkIAMMemberParameters
+		p    *compute.Policy
+		want want
+	}{
+		"NotMember": {
+			in: params(),
+			p:  &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{other}}}},
+			want: want{
+				changed: false,
+				p:       &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{other}}}},
+			},
+		},
+		"KeepOtherMembers": {
+			in: params(),
+			p:  &compute.Policy{Bindings: []*compute.Binding{{Role: role, Members: []string{other, member}}}},
+			want: want{
+				changed: true,
+				p: &compute.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*compute.Binding{{Role: role, Members: []string{other}}},
+				},
+			},
+		},
+		"DropEmptyBinding": {
+			in: params(),
+			p: &compute.Policy{Bindings: []*compute.Binding{
+				{Role: "roles/viewer", Members: []string{member}},
+				{Role: role, Members: []string{member}},
+			}},
+			want: want{
+				changed: true,
+				p: &compute.Policy{
+					Version:  iamv1alpha1.PolicyVersion,
+					Bindings: []*compute.Binding{{Role: "roles/viewer", Members: []string{member}}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RemoveMember(tc.in, tc.p)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("RemoveMember(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, tc.p); diff != "" {
+				t.Errorf("RemoveMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
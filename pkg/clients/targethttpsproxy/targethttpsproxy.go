@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targethttpsproxy
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// GenerateTargetHTTPSProxy populates the supplied compute.TargetHttpsProxy
+// with the supplied TargetHTTPSProxyParameters.
+func GenerateTargetHTTPSProxy(name string, in v1alpha1.TargetHTTPSProxyParameters, p *compute.TargetHttpsProxy) {
+	p.Name = name
+	p.Description = gcp.StringValue(in.Description)
+	p.QuicOverride = gcp.StringValue(in.QuicOverride)
+	p.SslCertificates = in.SSLCertificates
+	p.SslPolicy = gcp.StringValue(in.SSLPolicy)
+	p.UrlMap = gcp.StringValue(in.URLMap)
+}
+
+// GenerateTargetHTTPSProxyObservation takes a compute.TargetHttpsProxy and
+// returns *TargetHTTPSProxyObservation.
+func GenerateTargetHTTPSProxyObservation(observed compute.TargetHttpsProxy) v1alpha1.TargetHTTPSProxyObservation {
+	return v1alpha1.TargetHTTPSProxyObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.TargetHttpsProxy object.
+func LateInitializeSpec(spec *v1alpha1.TargetHTTPSProxyParameters, in compute.TargetHttpsProxy) {
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+	spec.QuicOverride = gcp.LateInitializeString(spec.QuicOverride, in.QuicOverride)
+	spec.SSLCertificates = gcp.LateInitializeStringSlice(spec.SSLCertificates, in.SslCertificates)
+	spec.SSLPolicy = gcp.LateInitializeString(spec.SSLPolicy, in.SslPolicy)
+	spec.URLMap = gcp.LateInitializeString(spec.URLMap, in.UrlMap)
+}
+
+// An Update that must be made to bring a TargetHttpsProxy up to date. Target
+// HTTPS proxies expose a distinct API call per updatable field.
+type Update struct {
+	URLMap          bool
+	SSLCertificates bool
+	QuicOverride    bool
+	SSLPolicy       bool
+}
+
+// Any returns true if any field requires an update.
+func (u Update) Any() bool {
+	return u.URLMap || u.SSLCertificates || u.QuicOverride || u.SSLPolicy
+}
+
+// GetUpdate returns which fields of the observed compute.TargetHttpsProxy
+// differ from the supplied parameters.
+func GetUpdate(in *v1alpha1.TargetHTTPSProxyParameters, observed *compute.TargetHttpsProxy) Update {
+	return Update{
+		URLMap:          in.URLMap != nil && !cmp.Equal(*in.URLMap, observed.UrlMap, gcp.EquateComputeURLs()),
+		SSLCertificates: !cmp.Equal(in.SSLCertificates, observed.SslCertificates, cmpopts.EquateEmpty(), gcp.EquateComputeURLs()),
+		QuicOverride:    in.QuicOverride != nil && *in.QuicOverride != observed.QuicOverride,
+		SSLPolicy:       !cmp.Equal(gcp.StringValue(in.SSLPolicy), observed.SslPolicy, gcp.EquateComputeURLs()),
+	}
+}
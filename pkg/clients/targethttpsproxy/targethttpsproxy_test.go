@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targethttpsproxy
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name         = "coolName"
+	description  = "coolDescription"
+	quicOverride = "NONE"
+	urlMap       = "https://www.googleapis.com/compute/v1/projects/cool/global/urlMaps/coolMap"
+	certificates = []string{"https://www.googleapis.com/compute/v1/projects/cool/global/sslCertificates/coolCert"}
+
+	timestamp        = "coolTime"
+	link             = "coolLink"
+	id        uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.TargetHTTPSProxyParameters)) *v1alpha1.TargetHTTPSProxyParameters {
+	o := &v1alpha1.TargetHTTPSProxyParameters{
+		Description:     &description,
+		QuicOverride:    &quicOverride,
+		SSLCertificates: certificates,
+		URLMap:          &urlMap,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func proxy(m ...func(*compute.TargetHttpsProxy)) *compute.TargetHttpsProxy {
+	o := &compute.TargetHttpsProxy{
+		Name:            name,
+		Description:     description,
+		QuicOverride:    quicOverride,
+		SslCertificates: certificates,
+		UrlMap:          urlMap,
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateTargetHTTPSProxy(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.TargetHTTPSProxyParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.TargetHttpsProxy
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: proxy(),
+		},
+		"PartialFilled": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.TargetHTTPSProxyParameters) {
+					p.QuicOverride = nil
+				}),
+			},
+			want: proxy(func(p *compute.TargetHttpsProxy) {
+				p.QuicOverride = ""
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.TargetHttpsProxy{}
+			GenerateTargetHTTPSProxy(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateTargetHTTPSProxy(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTargetHTTPSProxyObservation(t *testing.T) {
+	in := proxy(func(p *compute.TargetHttpsProxy) {
+		p.CreationTimestamp = timestamp
+		p.Id = id
+		p.SelfLink = link
+	})
+	want := v1alpha1.TargetHTTPSProxyObservation{
+		CreationTimestamp: timestamp,
+		ID:                id,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateTargetHTTPSProxyObservation(*in)); diff != "" {
+		t.Errorf("GenerateTargetHTTPSProxyObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetUpdate(t *testing.T) {
+	cases := map[string]struct {
+		in       *v1alpha1.TargetHTTPSProxyParameters
+		observed *compute.TargetHttpsProxy
+		want     Update
+	}{
+		"UpToDate": {
+			in:       params(),
+			observed: proxy(),
+			want:     Update{},
+		},
+		"URLMapChanged": {
+			in: params(),
+			observed: proxy(func(p *compute.TargetHttpsProxy) {
+				p.UrlMap = "https://www.googleapis.com/compute/v1/projects/cool/global/urlMaps/other"
+			}),
+			want: Update{URLMap: true},
+		},
+		"CertificatesAndQuicChanged": {
+			in: params(),
+			observed: proxy(func(p *compute.TargetHttpsProxy) {
+				p.SslCertificates = nil
+				p.QuicOverride = "ENABLE"
+			}),
+			want: Update{SSLCertificates: true, QuicOverride: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetUpdate(tc.in, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetUpdate(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.Any(), got.Any()); diff != "" {
+				t.Errorf("Any(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
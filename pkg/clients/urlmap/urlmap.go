@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package urlmap
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/mitchellh/copystructure"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const errCheckUpToDate = "unable to determine if external resource is up to date"
+
+// GenerateURLMap populates the supplied compute.UrlMap with the supplied
+// URLMapParameters.
+func GenerateURLMap(name string, in v1alpha1.URLMapParameters, um *compute.UrlMap) {
+	um.Name = name
+	um.DefaultService = gcp.StringValue(in.DefaultService)
+	um.Description = gcp.StringValue(in.Description)
+	um.DefaultUrlRedirect = generateRedirect(in.DefaultURLRedirect)
+
+	um.HostRules = nil
+	for _, hr := range in.HostRules {
+		um.HostRules = append(um.HostRules, &compute.HostRule{
+			Description: gcp.StringValue(hr.Description),
+			Hosts:       hr.Hosts,
+			PathMatcher: hr.PathMatcher,
+		})
+	}
+
+	um.PathMatchers = nil
+	for _, pm := range in.PathMatchers {
+		m := &compute.PathMatcher{
+			Name:           pm.Name,
+			DefaultService: gcp.StringValue(pm.DefaultService),
+			Description:    gcp.StringValue(pm.Description),
+		}
+		for _, pr := range pm.PathRules {
+			m.PathRules = append(m.PathRules, &compute.PathRule{
+				Paths:   pr.Paths,
+				Service: gcp.StringValue(pr.Service),
+			})
+		}
+		um.PathMatchers = append(um.PathMatchers, m)
+	}
+}
+
+func generateRedirect(in *v1alpha1.HTTPRedirectAction) *compute.HttpRedirectAction {
+	if in == nil {
+		return nil
+	}
+	out := &compute.HttpRedirectAction{
+		HostRedirect:         gcp.StringValue(in.HostRedirect),
+		HttpsRedirect:        gcp.BoolValue(in.HTTPSRedirect),
+		PathRedirect:         gcp.StringValue(in.PathRedirect),
+		RedirectResponseCode: gcp.StringValue(in.RedirectResponseCode),
+		StripQuery:           in.StripQuery,
+	}
+	// StripQuery is required by the API, so it must be sent even when false.
+	out.ForceSendFields = []string{"StripQuery"}
+	return out
+}
+
+// GenerateURLMapObservation takes a compute.UrlMap and returns
+// *URLMapObservation.
+func GenerateURLMapObservation(observed compute.UrlMap) v1alpha1.URLMapObservation {
+	return v1alpha1.URLMapObservation{
+		CreationTimestamp: observed.CreationTimestamp,
+		Fingerprint:       observed.Fingerprint,
+		ID:                observed.Id,
+		SelfLink:          observed.SelfLink,
+	}
+}
+
+// LateInitializeSpec fills unassigned fields with the values in
+// compute.UrlMap object.
+func LateInitializeSpec(spec *v1alpha1.URLMapParameters, in compute.UrlMap) {
+	spec.DefaultService = gcp.LateInitializeString(spec.DefaultService, in.DefaultService)
+	spec.Description = gcp.LateInitializeString(spec.Description, in.Description)
+}
+
+// IsUpToDate checks whether current state is up-to-date compared to the given
+// set of parameters.
+func IsUpToDate(name string, in *v1alpha1.URLMapParameters, observed *compute.UrlMap) (bool, error) {
+	generated, err := copystructure.Copy(observed)
+	if err != nil {
+		return true, errors.Wrap(err, errCheckUpToDate)
+	}
+	desired, ok := generated.(*compute.UrlMap)
+	if !ok {
+		return true, errors.New(errCheckUpToDate)
+	}
+	GenerateURLMap(name, *in, desired)
+	return cmp.Equal(desired, observed,
+		cmpopts.EquateEmpty(),
+		gcp.EquateComputeURLs(),
+		cmpopts.IgnoreFields(compute.UrlMap{}, "ForceSendFields"),
+		cmpopts.IgnoreFields(compute.HttpRedirectAction{}, "ForceSendFields"),
+	), nil
+}
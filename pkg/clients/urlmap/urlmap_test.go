@@ -0,0 +1,204 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package urlmap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	compute "google.golang.org/api/compute/v1"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+)
+
+var (
+	name           = "coolName"
+	description    = "coolDescription"
+	defaultService = "https://www.googleapis.com/compute/v1/projects/cool/global/backendServices/coolService"
+	apiService     = "https://www.googleapis.com/compute/v1/projects/cool/global/backendServices/apiService"
+
+	timestamp          = "coolTime"
+	link               = "coolLink"
+	fingerprint        = "coolFingerprint"
+	id          uint64 = 3001
+)
+
+func params(m ...func(*v1alpha1.URLMapParameters)) *v1alpha1.URLMapParameters {
+	o := &v1alpha1.URLMapParameters{
+		DefaultService: &defaultService,
+		Description:    &description,
+		HostRules: []v1alpha1.HostRule{{
+			Hosts:       []string{"example.com"},
+			PathMatcher: "paths",
+		}},
+		PathMatchers: []v1alpha1.PathMatcher{{
+			Name:           "paths",
+			DefaultService: &defaultService,
+			PathRules: []v1alpha1.PathRule{{
+				Paths:   []string{"/api/*"},
+				Service: &apiService,
+			}},
+		}},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func urlMap(m ...func(*compute.UrlMap)) *compute.UrlMap {
+	o := &compute.UrlMap{
+		Name:           name,
+		DefaultService: defaultService,
+		Description:    description,
+		HostRules: []*compute.HostRule{{
+			Hosts:       []string{"example.com"},
+			PathMatcher: "paths",
+		}},
+		PathMatchers: []*compute.PathMatcher{{
+			Name:           "paths",
+			DefaultService: defaultService,
+			PathRules: []*compute.PathRule{{
+				Paths:   []string{"/api/*"},
+				Service: apiService,
+			}},
+		}},
+	}
+
+	for _, f := range m {
+		f(o)
+	}
+
+	return o
+}
+
+func TestGenerateURLMap(t *testing.T) {
+	type args struct {
+		name string
+		in   v1alpha1.URLMapParameters
+	}
+	cases := map[string]struct {
+		args args
+		want *compute.UrlMap
+	}{
+		"AllFilled": {
+			args: args{
+				name: name,
+				in:   *params(),
+			},
+			want: urlMap(),
+		},
+		"Redirect": {
+			args: args{
+				name: name,
+				in: *params(func(p *v1alpha1.URLMapParameters) {
+					p.DefaultService = nil
+					p.DefaultURLRedirect = &v1alpha1.HTTPRedirectAction{}
+				}),
+			},
+			want: urlMap(func(um *compute.UrlMap) {
+				um.DefaultService = ""
+				um.DefaultUrlRedirect = &compute.HttpRedirectAction{ForceSendFields: []string{"StripQuery"}}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &compute.UrlMap{}
+			GenerateURLMap(tc.args.name, tc.args.in, r)
+			if diff := cmp.Diff(tc.want, r); diff != "" {
+				t.Errorf("GenerateURLMap(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateURLMapObservation(t *testing.T) {
+	in := urlMap(func(um *compute.UrlMap) {
+		um.CreationTimestamp = timestamp
+		um.Fingerprint = fingerprint
+		um.Id = id
+		um.SelfLink = link
+	})
+	want := v1alpha1.URLMapObservation{
+		CreationTimestamp: timestamp,
+		Fingerprint:       fingerprint,
+		ID:                id,
+		SelfLink:          link,
+	}
+	if diff := cmp.Diff(want, GenerateURLMapObservation(*in)); diff != "" {
+		t.Errorf("GenerateURLMapObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestLateInitializeSpec(t *testing.T) {
+	spec := params(func(p *v1alpha1.URLMapParameters) {
+		p.Description = nil
+	})
+	LateInitializeSpec(spec, *urlMap())
+	if diff := cmp.Diff(params(), spec); diff != "" {
+		t.Errorf("LateInitializeSpec(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		name     string
+		in       *v1alpha1.URLMapParameters
+		observed *compute.UrlMap
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				name: name,
+				in:   params(),
+				observed: urlMap(func(um *compute.UrlMap) {
+					um.Fingerprint = fingerprint
+				}),
+			},
+			want: true,
+		},
+		"PathRuleChanged": {
+			args: args{
+				name: name,
+				in:   params(),
+				observed: urlMap(func(um *compute.UrlMap) {
+					um.PathMatchers[0].PathRules[0].Paths = []string{"/v1/*"}
+				}),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.name, tc.args.in, tc.args.observed)
+			if err != nil {
+				t.Fatalf("IsUpToDate(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
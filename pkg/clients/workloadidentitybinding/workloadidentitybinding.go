@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadidentitybinding
+
+import (
+	"fmt"
+
+	"google.golang.org/api/iam/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+// MemberFormat is the format of the IAM member that identifies a Kubernetes
+// service account in a workload pool.
+const MemberFormat = "serviceAccount:%s[%s/%s]"
+
+// Client should be satisfied to conduct WorkloadIdentityBinding operations.
+type Client interface {
+	GetIamPolicy(resource string) *iam.ProjectsServiceAccountsGetIamPolicyCall
+	SetIamPolicy(resource string, setiampolicyrequest *iam.SetIamPolicyRequest) *iam.ProjectsServiceAccountsSetIamPolicyCall
+}
+
+// Member returns the IAM member that identifies the Kubernetes service
+// account of the supplied WorkloadIdentityBindingParameters.
+func Member(in v1alpha1.WorkloadIdentityBindingParameters) string {
+	return fmt.Sprintf(MemberFormat, gcp.StringValue(in.WorkloadPool), in.Namespace, in.KubernetesServiceAccount)
+}
+
+// findBinding returns the unconditional workload identity user binding of the
+// supplied policy, if any.
+func findBinding(p *iam.Policy) *iam.Binding {
+	for _, b := range p.Bindings {
+		if b.Role == v1alpha1.WorkloadIdentityUserRole && b.Condition == nil {
+			return b
+		}
+	}
+	return nil
+}
+
+// IsMember returns true if the supplied policy allows the Kubernetes service
+// account of the supplied WorkloadIdentityBindingParameters to impersonate
+// the ServiceAccount.
+func IsMember(in v1alpha1.WorkloadIdentityBindingParameters, p *iam.Policy) bool {
+	b := findBinding(p)
+	if b == nil {
+		return false
+	}
+	m := Member(in)
+	for _, o := range b.Members {
+		if o == m {
+			return true
+		}
+	}
+	return false
+}
+
+// AddMember adds the member of the supplied
+// WorkloadIdentityBindingParameters to the workload identity user binding of
+// the supplied policy, creating the binding if it does not exist. It returns
+// false if the policy was already up to date.
+func AddMember(in v1alpha1.WorkloadIdentityBindingParameters, p *iam.Policy) bool {
+	if IsMember(in, p) {
+		return false
+	}
+	// Other bindings of the policy may be conditional, which requires
+	// version 3 policies.
+	p.Version = v1alpha1.PolicyVersion
+	if b := findBinding(p); b != nil {
+		b.Members = append(b.Members, Member(in))
+		return true
+	}
+	p.Bindings = append(p.Bindings, &iam.Binding{
+		Role:    v1alpha1.WorkloadIdentityUserRole,
+		Members: []string{Member(in)},
+	})
+	return true
+}
+
+// RemoveMember removes the member of the supplied
+// WorkloadIdentityBindingParameters from the workload identity user binding
+// of the supplied policy, dropping the binding if it has no members left. It
+// returns false if the policy was already up to date.
+func RemoveMember(in v1alpha1.WorkloadIdentityBindingParameters, p *iam.Policy) bool {
+	if !IsMember(in, p) {
+		return false
+	}
+	p.Version = v1alpha1.PolicyVersion
+	target := findBinding(p)
+	m := Member(in)
+	bindings := make([]*iam.Binding, 0, len(p.Bindings))
+	for _, b := range p.Bindings {
+		if b == target {
+			members := make([]string, 0, len(b.Members))
+			for _, o := range b.Members {
+				if o != m {
+					members = append(members, o)
+				}
+			}
+			if len(members) == 0 {
+				continue
+			}
+			b.Members = members
+		}
+		bindings = append(bindings, b)
+	}
+	p.Bindings = bindings
+	return true
+}
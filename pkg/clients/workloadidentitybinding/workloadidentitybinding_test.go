@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workloadidentitybinding
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iam/v1"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+var (
+	role   = v1alpha1.WorkloadIdentityUserRole
+	pool   = "cool-project.svc.id.goog"
+	member = "serviceAccount:cool-project.svc.id.goog[cool-namespace/cool-ksa]"
+	other  = "serviceAccount:cool-project.svc.id.goog[other-namespace/cool-ksa]"
+)
+
+func params() v1alpha1.WorkloadIdentityBindingParameters {
+	return v1alpha1.WorkloadIdentityBindingParameters{
+		WorkloadPool:             &pool,
+		Namespace:                "cool-namespace",
+		KubernetesServiceAccount: "cool-ksa",
+	}
+}
+
+func TestMember(t *testing.T) {
+	if diff := cmp.Diff(member, Member(params())); diff != "" {
+		t.Errorf("Member(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsMember(t *testing.T) {
+	cases := map[string]struct {
+		p    *iam.Policy
+		want bool
+	}{
+		"Member": {
+			p:    &iam.Policy{Bindings: []*iam.Binding{{Role: role, Members: []string{other, member}}}},
+			want: true,
+		},
+		"OtherRole": {
+			p:    &iam.Policy{Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{member}}}},
+			want: false,
+		},
+		"ConditionalBinding": {
+			p: &iam.Policy{Bindings: []*iam.Binding{{
+				Role:      role,
+				Members:   []string{member},
+				Condition: &iam.Expr{Expression: "true"},
+			}}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMember(params(), tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsMember(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	type want struct {
+		changed bool
+		p       *iam.Policy
+	}
+	cases := map[string]struct {
+		p    *iam.Policy
+		want want
+	}{
+		"AlreadyMember": {
+			p: &iam.Policy{Etag: "e", Bindings: []*iam.Binding{{Role: role, Members: []string{member}}}},
+			want: want{
+				changed: false,
+				p:       &iam.Policy{Etag: "e", Bindings: []*iam.Binding{{Role: role, Members: []string{member}}}},
+			},
+		},
+		"ExistingBinding": {
+			p: &iam.Policy{Etag: "e", Bindings: []*iam.Binding{{Role: role, Members: []string{other}}}},
+			want: want{
+				changed: true,
+				p: &iam.Policy{
+					Etag:     "e",
+					Version:  v1alpha1.PolicyVersion,
+					Bindings: []*iam.Binding{{Role: role, Members: []string{other, member}}},
+				},
+			},
+		},
+		"NewBinding": {
+			p: &iam.Policy{Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{other}}}},
+			want: want{
+				changed: true,
+				p: &iam.Policy{
+					Version: v1alpha1.PolicyVersion,
+					Bindings: []*iam.Binding{
+						{Role: "roles/iam.serviceAccountUser", Members: []string{other}},
+						{Role: role, Members: []string{member}},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AddMember(params(), tc.p)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("AddMember(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, tc.p); diff != "" {
+				t.Errorf("AddMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveMember(t *testing.T) {
+	type want struct {
+		changed bool
+		p       *iam.Policy
+	}
+	cases := map[string]struct {
+		p    *iam.Policy
+		want want
+	}{
+		"NotMember": {
+			p: &iam.Policy{Bindings: []*iam.Binding{{Role: role, Members: []string{other}}}},
+			want: want{
+				changed: false,
+				p:       &iam.Policy{Bindings: []*iam.Binding{{Role: role, Members: []string{other}}}},
+			},
+		},
+		"KeepOtherMembers": {
+			p: &iam.Policy{Bindings: []*iam.Binding{{Role: role, Members: []string{other, member}}}},
+			want: want{
+				changed: true,
+				p: &iam.Policy{
+					Version:  v1alpha1.PolicyVersion,
+					Bindings: []*iam.Binding{{Role: role, Members: []string{other}}},
+				},
+			},
+		},
+		"DropEmptyBinding": {
+			p: &iam.Policy{Bindings: []*iam.Binding{
+				{Role: "roles/iam.serviceAccountUser", Members: []string{member}},
+				{Role: role, Members: []string{member}},
+			}},
+			want: want{
+				changed: true,
+				p: &iam.Policy{
+					Version:  v1alpha1.PolicyVersion,
+					Bindings: []*iam.Binding{{Role: "roles/iam.serviceAccountUser", Members: []string{member}}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := RemoveMember(params(), tc.p)
+			if diff := cmp.Diff(tc.want.changed, got); diff != "" {
+				t.Errorf("RemoveMember(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.p, tc.p); diff != "" {
+				t.Errorf("RemoveMember(...): -want policy, +got policy:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/backendservice"
+)
+
+// Error strings.
+const (
+	errNotBackendService           = "managed resource is not a BackendService"
+	errGetBackendService           = "cannot get external BackendService resource"
+	errCreateBackendService        = "cannot create external BackendService resource"
+	errUpdateBackendService        = "cannot update external BackendService resource"
+	errDeleteBackendService        = "cannot delete external BackendService resource"
+	errManagedBackendServiceUpdate = "cannot update managed BackendService resource"
+	errCheckBackendServiceUpToDate = "cannot determine if BackendService is up to date"
+	errSetSecurityPolicy           = "cannot set security policy of external BackendService resource"
+)
+
+// SetupBackendService adds a controller that reconciles BackendService managed
+// resources.
+func SetupBackendService(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.BackendServiceGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.BackendService{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.BackendServiceGroupVersionKind),
+			managed.WithExternalConnecter(&backendServiceConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type backendServiceConnector struct {
+	kube client.Client
+}
+
+func (c *backendServiceConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &backendServiceExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type backendServiceExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *backendServiceExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBackendService)
+	}
+	observed, err := e.BackendServices.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackendService)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	backendservice.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedBackendServiceUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = backendservice.GenerateBackendServiceObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := backendservice.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckBackendServiceUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u && backendservice.IsSecurityPolicyUpToDate(&cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *backendServiceExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBackendService)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	bs := &compute.BackendService{}
+	backendservice.GenerateBackendService(meta.GetExternalName(cr), cr.Spec.ForProvider, bs)
+	_, err := e.BackendServices.Insert(e.projectID, bs).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackendService)
+}
+
+func (e *backendServiceExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBackendService)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.BackendServices.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetBackendService)
+	}
+
+	u, err := backendservice.IsUpToDate(name, &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckBackendServiceUpToDate)
+	}
+	if !u {
+		bs := &compute.BackendService{}
+		backendservice.GenerateBackendService(name, cr.Spec.ForProvider, bs)
+		bs.Fingerprint = observed.Fingerprint
+		if _, err := e.BackendServices.Patch(e.projectID, name, bs).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateBackendService)
+		}
+	}
+
+	// The security policy of a backend service can only be changed using a
+	// distinct API call. An empty reference detaches the policy.
+	if !backendservice.IsSecurityPolicyUpToDate(&cr.Spec.ForProvider, observed) {
+		req := &compute.SecurityPolicyReference{
+			SecurityPolicy:  gcp.StringValue(cr.Spec.ForProvider.SecurityPolicy),
+			ForceSendFields: []string{"SecurityPolicy"},
+		}
+		if _, err := e.BackendServices.SetSecurityPolicy(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSecurityPolicy)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *backendServiceExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BackendService)
+	if !ok {
+		return errors.New(errNotBackendService)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.BackendServices.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteBackendService)
+}
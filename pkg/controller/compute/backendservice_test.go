@@ -0,0 +1,493 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/backendservice"
+)
+
+const (
+	testBackendServiceName = "test-backendservice"
+)
+
+var testBackendServiceProtocol = "HTTPS"
+
+var _ managed.ExternalConnecter = &backendServiceConnector{}
+var _ managed.ExternalClient = &backendServiceExternal{}
+
+type backendServiceModifier func(*v1alpha1.BackendService)
+
+func backendServiceWithConditions(c ...xpv1.Condition) backendServiceModifier {
+	return func(i *v1alpha1.BackendService) { i.Status.SetConditions(c...) }
+}
+
+func backendServiceWithDescription(d string) backendServiceModifier {
+	return func(i *v1alpha1.BackendService) { i.Spec.ForProvider.Description = &d }
+}
+
+func backendServiceObj(im ...backendServiceModifier) *v1alpha1.BackendService {
+	i := &v1alpha1.BackendService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testBackendServiceName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testBackendServiceName,
+			},
+		},
+		Spec: v1alpha1.BackendServiceSpec{
+			ForProvider: v1alpha1.BackendServiceParameters{
+				Protocol: &testBackendServiceProtocol,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestBackendServiceObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotBackendService": {
+			handler: nil,
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotBackendService),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.BackendService{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg: backendServiceObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.BackendService{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg:  backendServiceObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetBackendService),
+			},
+		},
+		"SpecUpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				bs := &compute.BackendService{}
+				backendservice.GenerateBackendService(testBackendServiceName, backendServiceObj().Spec.ForProvider, bs)
+				bs.Description = "a very interesting description"
+				_ = json.NewEncoder(w).Encode(bs)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg:  backendServiceObj(backendServiceWithDescription("a very interesting description")),
+				err: errors.Wrap(errBoom, errManagedBackendServiceUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				bs := &compute.BackendService{}
+				backendservice.GenerateBackendService(testBackendServiceName, backendServiceObj().Spec.ForProvider, bs)
+				_ = json.NewEncoder(w).Encode(bs)
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: backendServiceObj(backendServiceWithConditions(xpv1.Available())),
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				bs := &compute.BackendService{}
+				backendservice.GenerateBackendService(testBackendServiceName, backendServiceObj().Spec.ForProvider, bs)
+				bs.Protocol = "HTTP"
+				_ = json.NewEncoder(w).Encode(bs)
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: backendServiceObj(backendServiceWithConditions(xpv1.Available())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendServiceExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendServiceCreate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotBackendService": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotBackendService),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				bs := &compute.BackendService{}
+				if err := json.NewDecoder(r.Body).Decode(bs); err != nil {
+					t.Error(err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testBackendServiceName, bs.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg: backendServiceObj(backendServiceWithConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg:  backendServiceObj(backendServiceWithConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBackendService),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendServiceExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendServiceUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	observed := func(m func(*compute.BackendService)) *compute.BackendService {
+		bs := &compute.BackendService{}
+		backendservice.GenerateBackendService(testBackendServiceName, backendServiceObj().Spec.ForProvider, bs)
+		m(bs)
+		return bs
+	}
+	withSecurityPolicy := func(i *v1alpha1.BackendService) {
+		sp := "projects/p/global/securityPolicies/policy"
+		i.Spec.ForProvider.SecurityPolicy = &sp
+	}
+
+	cases := map[string]struct {
+		mg       resource.Managed
+		observed *compute.BackendService
+		status   int
+		want     want
+	}{
+		"NotBackendService": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				err: errors.New(errNotBackendService),
+			},
+		},
+		"PatchSuccessful": {
+			mg:       backendServiceObj(),
+			observed: observed(func(bs *compute.BackendService) { bs.Protocol = "HTTP" }),
+			status:   http.StatusOK,
+			want: want{
+				calls: []string{testBackendServiceName},
+			},
+		},
+		"SetSecurityPolicy": {
+			mg:       backendServiceObj(withSecurityPolicy),
+			observed: observed(func(bs *compute.BackendService) {}),
+			status:   http.StatusOK,
+			want: want{
+				calls: []string{"setSecurityPolicy"},
+			},
+		},
+		"DetachSecurityPolicy": {
+			mg: backendServiceObj(),
+			observed: observed(func(bs *compute.BackendService) {
+				bs.SecurityPolicy = "projects/p/global/securityPolicies/policy"
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"setSecurityPolicy"},
+			},
+		},
+		"PatchFailed": {
+			mg:       backendServiceObj(withSecurityPolicy),
+			observed: observed(func(bs *compute.BackendService) { bs.Protocol = "HTTP" }),
+			status:   http.StatusBadRequest,
+			want: want{
+				calls: []string{testBackendServiceName},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateBackendService),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendServiceExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackendServiceDelete(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotBackendService": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotBackendService),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg: backendServiceObj(backendServiceWithConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg: backendServiceObj(backendServiceWithConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: backendServiceObj(),
+			},
+			want: want{
+				mg:  backendServiceObj(backendServiceWithConditions(xpv1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBackendService),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := backendServiceExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
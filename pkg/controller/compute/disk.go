@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/disk"
+)
+
+// Error strings.
+const (
+	errNotDisk                    = "managed resource is not a Disk"
+	errDiskLocation               = "exactly one of zone or region must be specified"
+	errGetDisk                    = "cannot get external Disk resource"
+	errCreateDisk                 = "cannot create external Disk resource"
+	errDeleteDisk                 = "cannot delete external Disk resource"
+	errManagedDiskUpdate          = "cannot update managed Disk resource"
+	errSetDiskLabels              = "cannot set labels of external Disk resource"
+	errAddDiskResourcePolicies    = "cannot add resource policies to external Disk resource"
+	errRemoveDiskResourcePolicies = "cannot remove resource policies from external Disk resource"
+	errResizeDisk                 = "cannot resize external Disk resource"
+	fmtErrShrinkDisk              = "cannot shrink disk from %dGB to %dGB: persistent disks can only grow"
+)
+
+// SetupDisk adds a controller that reconciles Disk managed resources.
+func SetupDisk(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.DiskGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Disk{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.DiskGroupVersionKind),
+			managed.WithExternalConnecter(&diskConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type diskConnector struct {
+	kube client.Client
+}
+
+func (c *diskConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &diskExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type diskExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *diskExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotDisk)
+	}
+	if (cr.Spec.ForProvider.Zone == nil) == (cr.Spec.ForProvider.Region == nil) {
+		return managed.ExternalObservation{}, errors.New(errDiskLocation)
+	}
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDisk)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	disk.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedDiskUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = disk.GenerateDiskObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.DiskStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.DiskStatusCreating, v1alpha1.DiskStatusRestoring:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.DiskStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// A smaller size is not a pending update, so the disk is still reported
+	// as up to date.
+	switch {
+	case disk.IsShrink(&cr.Spec.ForProvider, observed):
+		cr.SetConditions(v1alpha1.ResizeRefused(fmt.Sprintf(fmtErrShrinkDisk, observed.SizeGb, gcp.Int64Value(cr.Spec.ForProvider.SizeGB))))
+	case cr.GetCondition(v1alpha1.TypeResizeRefused).Status == corev1.ConditionTrue:
+		cr.SetConditions(v1alpha1.NoResizeRefused())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !disk.GetUpdate(&cr.Spec.ForProvider, observed).Any(),
+	}, nil
+}
+
+func (e *diskExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotDisk)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	d := &compute.Disk{}
+	disk.GenerateDisk(meta.GetExternalName(cr), cr.Spec.ForProvider, d)
+
+	var err error
+	if r := cr.Spec.ForProvider.Region; r != nil {
+		_, err = e.RegionDisks.Insert(e.projectID, *r, d).Context(ctx).Do()
+	} else {
+		_, err = e.Disks.Insert(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Zone), d).Context(ctx).Do()
+	}
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDisk)
+}
+
+// Update makes the API call for each field of the disk that differs from
+// the desired state. Disks may only grow; a request to shrink a disk is
+// ignored, and reported by Observe using the ResizeRefused condition.
+func (e *diskExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotDisk)
+	}
+
+	observed, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetDisk)
+	}
+
+	name := meta.GetExternalName(cr)
+	p := cr.Spec.ForProvider
+	u := disk.GetUpdate(&p, observed)
+
+	if u.Labels {
+		if r := p.Region; r != nil {
+			rq := &compute.RegionSetLabelsRequest{Labels: p.Labels, LabelFingerprint: observed.LabelFingerprint}
+			_, err = e.RegionDisks.SetLabels(e.projectID, *r, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.ZoneSetLabelsRequest{Labels: p.Labels, LabelFingerprint: observed.LabelFingerprint}
+			_, err = e.Disks.SetLabels(e.projectID, gcp.StringValue(p.Zone), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetDiskLabels)
+		}
+	}
+
+	if len(u.AddResourcePolicies) > 0 {
+		if r := p.Region; r != nil {
+			rq := &compute.RegionDisksAddResourcePoliciesRequest{ResourcePolicies: u.AddResourcePolicies}
+			_, err = e.RegionDisks.AddResourcePolicies(e.projectID, *r, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.DisksAddResourcePoliciesRequest{ResourcePolicies: u.AddResourcePolicies}
+			_, err = e.Disks.AddResourcePolicies(e.projectID, gcp.StringValue(p.Zone), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddDiskResourcePolicies)
+		}
+	}
+
+	if len(u.RemoveResourcePolicies) > 0 {
+		if r := p.Region; r != nil {
+			rq := &compute.RegionDisksRemoveResourcePoliciesRequest{ResourcePolicies: u.RemoveResourcePolicies}
+			_, err = e.RegionDisks.RemoveResourcePolicies(e.projectID, *r, name, rq).Context(ctx).Do()
+		} else {
+			rq := &compute.DisksRemoveResourcePoliciesRequest{ResourcePolicies: u.RemoveResourcePolicies}
+			_, err = e.Disks.RemoveResourcePolicies(e.projectID, gcp.StringValue(p.Zone), name, rq).Context(ctx).Do()
+		}
+		if err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveDiskResourcePolicies)
+		}
+	}
+
+	if u.SizeGB == 0 {
+		return managed.ExternalUpdate{}, nil
+	}
+	if r := p.Region; r != nil {
+		rq := &compute.RegionDisksResizeRequest{SizeGb: u.SizeGB}
+		_, err = e.RegionDisks.Resize(e.projectID, *r, name, rq).Context(ctx).Do()
+	} else {
+		rq := &compute.DisksResizeRequest{SizeGb: u.SizeGB}
+		_, err = e.Disks.Resize(e.projectID, gcp.StringValue(p.Zone), name, rq).Context(ctx).Do()
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(err, errResizeDisk)
+}
+
+func (e *diskExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Disk)
+	if !ok {
+		return errors.New(errNotDisk)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	var err error
+	if r := cr.Spec.ForProvider.Region; r != nil {
+		_, err = e.RegionDisks.Delete(e.projectID, *r, meta.GetExternalName(cr)).Context(ctx).Do()
+	} else {
+		_, err = e.Disks.Delete(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Zone), meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDisk)
+}
+
+// get returns the zonal or regional disk described by the supplied Disk.
+func (e *diskExternal) get(ctx context.Context, cr *v1alpha1.Disk) (*compute.Disk, error) {
+	if r := cr.Spec.ForProvider.Region; r != nil {
+		return e.RegionDisks.Get(e.projectID, *r, meta.GetExternalName(cr)).Context(ctx).Do()
+	}
+	return e.Disks.Get(e.projectID, gcp.StringValue(cr.Spec.ForProvider.Zone), meta.GetExternalName(cr)).Context(ctx).Do()
+}
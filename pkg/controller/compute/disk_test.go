@@ -0,0 +1,414 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/disk"
+)
+
+const (
+	testDiskName = "test-disk"
+	testDiskZone = "us-central1-a"
+)
+
+var _ managed.ExternalConnecter = &diskConnector{}
+var _ managed.ExternalClient = &diskExternal{}
+
+type diskModifier func(*v1alpha1.Disk)
+
+func diskWithConditions(c ...xpv1.Condition) diskModifier {
+	return func(i *v1alpha1.Disk) { i.Status.SetConditions(c...) }
+}
+
+func diskWithRegion(region string) diskModifier {
+	return func(i *v1alpha1.Disk) {
+		i.Spec.ForProvider.Zone = nil
+		i.Spec.ForProvider.Region = &region
+	}
+}
+
+func diskWithSizeGB(size int64) diskModifier {
+	return func(i *v1alpha1.Disk) { i.Spec.ForProvider.SizeGB = &size }
+}
+
+func diskWithObservation(o v1alpha1.DiskObservation) diskModifier {
+	return func(i *v1alpha1.Disk) { i.Status.AtProvider = o }
+}
+
+func diskObj(im ...diskModifier) *v1alpha1.Disk {
+	zone := testDiskZone
+	size := int64(20)
+	diskType := "pd-ssd"
+	i := &v1alpha1.Disk{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testDiskName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testDiskName,
+			},
+		},
+		Spec: v1alpha1.DiskSpec{
+			ForProvider: v1alpha1.DiskParameters{
+				Zone:             &zone,
+				Labels:           map[string]string{"app": "db"},
+				ResourcePolicies: []string{"projects/" + projectID + "/regions/us-central1/resourcePolicies/daily"},
+				SizeGB:           &size,
+				Type:             &diskType,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func observedDisk(m ...func(*compute.Disk)) *compute.Disk {
+	d := &compute.Disk{}
+	disk.GenerateDisk(testDiskName, diskObj().Spec.ForProvider, d)
+	d.Status = v1alpha1.DiskStatusReady
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestDiskObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotDisk": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotDisk),
+			},
+		},
+		"ZoneAndRegion": {
+			mg: diskObj(func(i *v1alpha1.Disk) { i.Spec.ForProvider.Region = i.Spec.ForProvider.Zone }),
+			want: want{
+				mg:  diskObj(func(i *v1alpha1.Disk) { i.Spec.ForProvider.Region = i.Spec.ForProvider.Zone }),
+				err: errors.New(errDiskLocation),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Disk{})
+			}),
+			mg: diskObj(),
+			want: want{
+				mg: diskObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Disk{})
+			}),
+			mg: diskObj(),
+			want: want{
+				mg:  diskObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDisk),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDisk())
+			}),
+			mg: diskObj(),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(xpv1.Available()),
+					diskWithObservation(v1alpha1.DiskObservation{SizeGB: 20, Status: v1alpha1.DiskStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Regional": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if !strings.Contains(r.URL.Path, "/regions/us-central1/disks/") {
+					t.Errorf("r: unexpected path %s", r.URL.Path)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDisk(func(d *compute.Disk) {
+					d.Status = v1alpha1.DiskStatusCreating
+				}))
+			}),
+			mg: diskObj(diskWithRegion("us-central1")),
+			want: want{
+				mg: diskObj(
+					diskWithRegion("us-central1"),
+					diskWithConditions(xpv1.Creating()),
+					diskWithObservation(v1alpha1.DiskObservation{SizeGB: 20, Status: v1alpha1.DiskStatusCreating}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SizeNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDisk(func(d *compute.Disk) {
+					d.SizeGb = 10
+				}))
+			}),
+			mg: diskObj(),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(xpv1.Available()),
+					diskWithObservation(v1alpha1.DiskObservation{SizeGB: 10, Status: v1alpha1.DiskStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"ShrinkRefused": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDisk())
+			}),
+			mg: diskObj(diskWithSizeGB(10)),
+			want: want{
+				mg: diskObj(
+					diskWithSizeGB(10),
+					diskWithConditions(xpv1.Available(), v1alpha1.ResizeRefused(fmt.Sprintf(fmtErrShrinkDisk, 20, 10))),
+					diskWithObservation(v1alpha1.DiskObservation{SizeGB: 20, Status: v1alpha1.DiskStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ShrinkRefusalCleared": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedDisk())
+			}),
+			mg: diskObj(diskWithConditions(v1alpha1.ResizeRefused(fmt.Sprintf(fmtErrShrinkDisk, 20, 10)))),
+			want: want{
+				mg: diskObj(
+					diskWithConditions(v1alpha1.NoResizeRefused(), xpv1.Available()),
+					diskWithObservation(v1alpha1.DiskObservation{SizeGB: 20, Status: v1alpha1.DiskStatusReady}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiskUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg       *v1alpha1.Disk
+		observed *compute.Disk
+		status   int
+		want     want
+	}{
+		"Grow": {
+			mg: diskObj(diskWithSizeGB(50)),
+			observed: observedDisk(func(d *compute.Disk) {
+				d.SizeGb = 20
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"POST zones/" + testDiskZone + "/disks/" + testDiskName + "/resize"},
+			},
+		},
+		"GrowRegional": {
+			mg: diskObj(diskWithRegion("us-central1"), diskWithSizeGB(50)),
+			observed: observedDisk(func(d *compute.Disk) {
+				d.SizeGb = 20
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"POST regions/us-central1/disks/" + testDiskName + "/resize"},
+			},
+		},
+		"SkipShrink": {
+			mg: diskObj(diskWithSizeGB(10)),
+			observed: observedDisk(func(d *compute.Disk) {
+				d.SizeGb = 20
+			}),
+			status: http.StatusOK,
+			want:   want{},
+		},
+		"LabelsAndResourcePolicies": {
+			mg: diskObj(),
+			observed: observedDisk(func(d *compute.Disk) {
+				d.Labels = nil
+				d.ResourcePolicies = []string{"https://www.googleapis.com/compute/v1/projects/" + projectID + "/regions/us-central1/resourcePolicies/weekly"}
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{
+					"POST zones/" + testDiskZone + "/disks/" + testDiskName + "/setLabels",
+					"POST zones/" + testDiskZone + "/disks/" + testDiskName + "/addResourcePolicies",
+					"POST zones/" + testDiskZone + "/disks/" + testDiskName + "/removeResourcePolicies",
+				},
+			},
+		},
+		"ResizeFailed": {
+			mg: diskObj(diskWithSizeGB(50)),
+			observed: observedDisk(func(d *compute.Disk) {
+				d.SizeGb = 20
+			}),
+			status: http.StatusBadRequest,
+			want: want{
+				calls: []string{"POST zones/" + testDiskZone + "/disks/" + testDiskName + "/resize"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errResizeDisk),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.Method+" "+strings.TrimPrefix(r.URL.Path, "/projects/"+projectID+"/"))
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiskDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDisk),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := diskExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := diskObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(diskObj(diskWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
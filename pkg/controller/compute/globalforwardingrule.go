@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/globalforwardingrule"
+)
+
+// Error strings.
+const (
+	errNotGlobalForwardingRule           = "managed resource is not a GlobalForwardingRule"
+	errGetGlobalForwardingRule           = "cannot get external ForwardingRule resource"
+	errCreateGlobalForwardingRule        = "cannot create external ForwardingRule resource"
+	errDeleteGlobalForwardingRule        = "cannot delete external ForwardingRule resource"
+	errManagedGlobalForwardingRuleUpdate = "cannot update managed GlobalForwardingRule resource"
+	errSetTarget                         = "cannot set target of external ForwardingRule resource"
+	errSetLabels                         = "cannot set labels of external ForwardingRule resource"
+)
+
+// SetupGlobalForwardingRule adds a controller that reconciles
+// GlobalForwardingRule managed resources.
+func SetupGlobalForwardingRule(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.GlobalForwardingRuleGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.GlobalForwardingRule{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.GlobalForwardingRuleGroupVersionKind),
+			managed.WithExternalConnecter(&gfrConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type gfrConnector struct {
+	kube client.Client
+}
+
+func (c *gfrConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &gfrExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type gfrExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *gfrExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.GlobalForwardingRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotGlobalForwardingRule)
+	}
+	observed, err := e.GlobalForwardingRules.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetGlobalForwardingRule)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	globalforwardingrule.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedGlobalForwardingRuleUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = globalforwardingrule.GenerateGlobalForwardingRuleObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	target, labels := globalforwardingrule.IsUpToDate(&cr.Spec.ForProvider, observed)
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: target && labels,
+	}, nil
+}
+
+func (e *gfrExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.GlobalForwardingRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotGlobalForwardingRule)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	fr := &compute.ForwardingRule{}
+	globalforwardingrule.GenerateGlobalForwardingRule(meta.GetExternalName(cr), cr.Spec.ForProvider, fr)
+	_, err := e.GlobalForwardingRules.Insert(e.projectID, fr).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateGlobalForwardingRule)
+}
+
+func (e *gfrExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.GlobalForwardingRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotGlobalForwardingRule)
+	}
+
+	observed, err := e.GlobalForwardingRules.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetGlobalForwardingRule)
+	}
+
+	name := meta.GetExternalName(cr)
+	target, labels := globalforwardingrule.IsUpToDate(&cr.Spec.ForProvider, observed)
+	if !target {
+		req := &compute.TargetReference{Target: gcp.StringValue(cr.Spec.ForProvider.Target)}
+		if _, err := e.GlobalForwardingRules.SetTarget(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetTarget)
+		}
+	}
+	if !labels {
+		req := &compute.GlobalSetLabelsRequest{
+			Labels:           cr.Spec.ForProvider.Labels,
+			LabelFingerprint: observed.LabelFingerprint,
+		}
+		if _, err := e.GlobalForwardingRules.SetLabels(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetLabels)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *gfrExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.GlobalForwardingRule)
+	if !ok {
+		return errors.New(errNotGlobalForwardingRule)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.GlobalForwardingRules.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteGlobalForwardingRule)
+}
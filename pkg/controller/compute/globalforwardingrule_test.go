@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/globalforwardingrule"
+)
+
+const (
+	testGlobalForwardingRuleName = "test-globalforwardingrule"
+	testTarget                   = "https://www.googleapis.com/compute/v1/projects/cool/global/targetHttpsProxies/coolProxy"
+)
+
+var _ managed.ExternalConnecter = &gfrConnector{}
+var _ managed.ExternalClient = &gfrExternal{}
+
+type globalForwardingRuleModifier func(*v1alpha1.GlobalForwardingRule)
+
+func globalForwardingRuleWithConditions(c ...xpv1.Condition) globalForwardingRuleModifier {
+	return func(i *v1alpha1.GlobalForwardingRule) { i.Status.SetConditions(c...) }
+}
+
+func globalForwardingRuleObj(im ...globalForwardingRuleModifier) *v1alpha1.GlobalForwardingRule {
+	target := testTarget
+	i := &v1alpha1.GlobalForwardingRule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testGlobalForwardingRuleName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testGlobalForwardingRuleName,
+			},
+		},
+		Spec: v1alpha1.GlobalForwardingRuleSpec{
+			ForProvider: v1alpha1.GlobalForwardingRuleParameters{
+				Labels: map[string]string{"cool": "label"},
+				Target: &target,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestGlobalForwardingRuleObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotGlobalForwardingRule": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotGlobalForwardingRule),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.ForwardingRule{})
+			}),
+			mg: globalForwardingRuleObj(),
+			want: want{
+				mg: globalForwardingRuleObj(),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				p := &compute.ForwardingRule{}
+				globalforwardingrule.GenerateGlobalForwardingRule(testGlobalForwardingRuleName, globalForwardingRuleObj().Spec.ForProvider, p)
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: globalForwardingRuleObj(),
+			want: want{
+				mg:  globalForwardingRuleObj(globalForwardingRuleWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				p := &compute.ForwardingRule{}
+				globalforwardingrule.GenerateGlobalForwardingRule(testGlobalForwardingRuleName, globalForwardingRuleObj().Spec.ForProvider, p)
+				p.Target = "https://www.googleapis.com/compute/v1/projects/cool/global/targetHttpsProxies/other"
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: globalForwardingRuleObj(),
+			want: want{
+				mg:  globalForwardingRuleObj(globalForwardingRuleWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gfrExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGlobalForwardingRuleUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	observed := func(m func(*compute.ForwardingRule)) *compute.ForwardingRule {
+		p := &compute.ForwardingRule{}
+		globalforwardingrule.GenerateGlobalForwardingRule(testGlobalForwardingRuleName, globalForwardingRuleObj().Spec.ForProvider, p)
+		m(p)
+		return p
+	}
+
+	cases := map[string]struct {
+		observed *compute.ForwardingRule
+		status   int
+		want     want
+	}{
+		"TargetChanged": {
+			observed: observed(func(p *compute.ForwardingRule) { p.Target = "other" }),
+			status:   http.StatusOK,
+			want: want{
+				calls: []string{"setTarget"},
+			},
+		},
+		"TargetAndLabelsChanged": {
+			observed: observed(func(p *compute.ForwardingRule) {
+				p.Target = "other"
+				p.Labels = nil
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"setTarget", "setLabels"},
+			},
+		},
+		"SetLabelsFailed": {
+			observed: observed(func(p *compute.ForwardingRule) { p.Labels = nil }),
+			status:   http.StatusBadRequest,
+			want: want{
+				calls: []string{"setLabels"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errSetLabels),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := gfrExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), globalForwardingRuleObj())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
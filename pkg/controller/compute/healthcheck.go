@@ -0,0 +1,161 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/healthcheck"
+)
+
+// Error strings.
+const (
+	errNotHealthCheck           = "managed resource is not a HealthCheck"
+	errGetHealthCheck           = "cannot get external HealthCheck resource"
+	errCreateHealthCheck        = "cannot create external HealthCheck resource"
+	errUpdateHealthCheck        = "cannot update external HealthCheck resource"
+	errDeleteHealthCheck        = "cannot delete external HealthCheck resource"
+	errManagedHealthCheckUpdate = "cannot update managed HealthCheck resource"
+	errCheckHealthCheckUpToDate = "cannot determine if HealthCheck is up to date"
+)
+
+// SetupHealthCheck adds a controller that reconciles HealthCheck managed
+// resources.
+func SetupHealthCheck(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.HealthCheckGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.HealthCheck{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.HealthCheckGroupVersionKind),
+			managed.WithExternalConnecter(&healthCheckConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type healthCheckConnector struct {
+	kube client.Client
+}
+
+func (c *healthCheckConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &healthCheckExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type healthCheckExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *healthCheckExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotHealthCheck)
+	}
+	observed, err := e.HealthChecks.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetHealthCheck)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	healthcheck.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedHealthCheckUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = healthcheck.GenerateHealthCheckObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := healthcheck.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckHealthCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (e *healthCheckExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotHealthCheck)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	hc := &compute.HealthCheck{}
+	healthcheck.GenerateHealthCheck(meta.GetExternalName(cr), cr.Spec.ForProvider, hc)
+	_, err := e.HealthChecks.Insert(e.projectID, hc).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateHealthCheck)
+}
+
+func (e *healthCheckExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotHealthCheck)
+	}
+
+	hc := &compute.HealthCheck{}
+	healthcheck.GenerateHealthCheck(meta.GetExternalName(cr), cr.Spec.ForProvider, hc)
+	_, err := e.HealthChecks.Patch(e.projectID, meta.GetExternalName(cr), hc).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateHealthCheck)
+}
+
+func (e *healthCheckExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.HealthCheck)
+	if !ok {
+		return errors.New(errNotHealthCheck)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.HealthChecks.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteHealthCheck)
+}
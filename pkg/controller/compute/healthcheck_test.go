@@ -0,0 +1,468 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/healthcheck"
+)
+
+const (
+	testHealthCheckName = "test-healthcheck"
+)
+
+var _ managed.ExternalConnecter = &healthCheckConnector{}
+var _ managed.ExternalClient = &healthCheckExternal{}
+
+type healthCheckModifier func(*v1alpha1.HealthCheck)
+
+func healthCheckWithConditions(c ...xpv1.Condition) healthCheckModifier {
+	return func(i *v1alpha1.HealthCheck) { i.Status.SetConditions(c...) }
+}
+
+func healthCheckWithDescription(d string) healthCheckModifier {
+	return func(i *v1alpha1.HealthCheck) { i.Spec.ForProvider.Description = &d }
+}
+
+func healthCheckObj(im ...healthCheckModifier) *v1alpha1.HealthCheck {
+	i := &v1alpha1.HealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testHealthCheckName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testHealthCheckName,
+			},
+		},
+		Spec: v1alpha1.HealthCheckSpec{
+			ForProvider: v1alpha1.HealthCheckParameters{
+				Type: "TCP",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestHealthCheckObserve(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		args    args
+		want    want
+	}{
+		"NotHealthCheck": {
+			handler: nil,
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotHealthCheck),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.HealthCheck{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg: healthCheckObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.HealthCheck{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg:  healthCheckObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetHealthCheck),
+			},
+		},
+		"SpecUpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				hc := &compute.HealthCheck{}
+				healthcheck.GenerateHealthCheck(testHealthCheckName, healthCheckObj().Spec.ForProvider, hc)
+				hc.Description = "a very interesting description"
+				_ = json.NewEncoder(w).Encode(hc)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg:  healthCheckObj(healthCheckWithDescription("a very interesting description")),
+				err: errors.Wrap(errBoom, errManagedHealthCheckUpdate),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				hc := &compute.HealthCheck{}
+				healthcheck.GenerateHealthCheck(testHealthCheckName, healthCheckObj().Spec.ForProvider, hc)
+				_ = json.NewEncoder(w).Encode(hc)
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: healthCheckObj(healthCheckWithConditions(xpv1.Available())),
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				hc := &compute.HealthCheck{}
+				healthcheck.GenerateHealthCheck(testHealthCheckName, healthCheckObj().Spec.ForProvider, hc)
+				hc.Type = "HTTP"
+				_ = json.NewEncoder(w).Encode(hc)
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: healthCheckObj(healthCheckWithConditions(xpv1.Available())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := healthCheckExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthCheckCreate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotHealthCheck": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotHealthCheck),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				hc := &compute.HealthCheck{}
+				if err := json.NewDecoder(r.Body).Decode(hc); err != nil {
+					t.Error(err)
+				}
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testHealthCheckName, hc.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg: healthCheckObj(healthCheckWithConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg:  healthCheckObj(healthCheckWithConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateHealthCheck),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := healthCheckExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthCheckUpdate(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotHealthCheck": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotHealthCheck),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg: healthCheckObj(),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg:  healthCheckObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateHealthCheck),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := healthCheckExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHealthCheckDelete(t *testing.T) {
+	type args struct {
+		mg resource.Managed
+	}
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		args    args
+		want    want
+	}{
+		"NotHealthCheck": {
+			args: args{
+				mg: &v1beta1.Subnetwork{},
+			},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotHealthCheck),
+			},
+		},
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg: healthCheckObj(healthCheckWithConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg: healthCheckObj(healthCheckWithConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}),
+			args: args{
+				mg: healthCheckObj(),
+			},
+			want: want{
+				mg:  healthCheckObj(healthCheckWithConditions(xpv1.Deleting())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteHealthCheck),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := healthCheckExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			err := e.Delete(context.Background(), tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
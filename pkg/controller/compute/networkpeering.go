@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"path"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/networkpeering"
+)
+
+// Error strings.
+const (
+	errNotNetworkPeering           = "managed resource is not a NetworkPeering"
+	errNetworkPeeringNoNetwork     = "network of NetworkPeering must be specified"
+	errGetNetworkPeering           = "cannot get external NetworkPeering resource"
+	errCreateNetworkPeering        = "cannot create external NetworkPeering resource"
+	errUpdateNetworkPeering        = "cannot update external NetworkPeering resource"
+	errDeleteNetworkPeering        = "cannot delete external NetworkPeering resource"
+	errManagedNetworkPeeringUpdate = "cannot update managed NetworkPeering resource"
+)
+
+// msgPeerMissing is the message of the Unavailable condition of an inactive
+// peering.
+const msgPeerMissing = "peering is inactive: the peer network has no matching peering to this network"
+
+// SetupNetworkPeering adds a controller that reconciles NetworkPeering
+// managed resources.
+func SetupNetworkPeering(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.NetworkPeeringGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.NetworkPeering{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.NetworkPeeringGroupVersionKind),
+			managed.WithExternalConnecter(&networkPeeringConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type networkPeeringConnector struct {
+	kube client.Client
+}
+
+func (c *networkPeeringConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &networkPeeringExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type networkPeeringExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+// Peerings are not resources in their own right; they are observed as part
+// of the local network.
+func (e *networkPeeringExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPeering)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotNetworkPeering)
+	}
+	if cr.Spec.ForProvider.Network == nil {
+		return managed.ExternalObservation{}, errors.New(errNetworkPeeringNoNetwork)
+	}
+	n, err := e.Networks.Get(e.projectID, path.Base(*cr.Spec.ForProvider.Network)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNetworkPeering)
+	}
+	observed := networkpeering.Find(meta.GetExternalName(cr), n)
+	if observed == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	networkpeering.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedNetworkPeeringUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = networkpeering.GenerateNetworkPeeringObservation(*observed)
+
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.NetworkPeeringStateActive:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.NetworkPeeringStateInactive:
+		// A peering is inactive until a matching peering is configured
+		// from the peer network, which may be in another project.
+		msg := msgPeerMissing
+		if d := cr.Status.AtProvider.StateDetails; d != "" {
+			msg += ": " + d
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msg))
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: networkpeering.IsUpToDate(&cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *networkPeeringExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPeering)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotNetworkPeering)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	p := &compute.NetworkPeering{}
+	networkpeering.GenerateNetworkPeering(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	rq := &compute.NetworksAddPeeringRequest{NetworkPeering: p}
+	_, err := e.Networks.AddPeering(e.projectID, path.Base(gcp.StringValue(cr.Spec.ForProvider.Network)), rq).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateNetworkPeering)
+}
+
+func (e *networkPeeringExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.NetworkPeering)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotNetworkPeering)
+	}
+
+	p := &compute.NetworkPeering{}
+	networkpeering.GenerateNetworkPeering(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	rq := &compute.NetworksUpdatePeeringRequest{NetworkPeering: p}
+	_, err := e.Networks.UpdatePeering(e.projectID, path.Base(gcp.StringValue(cr.Spec.ForProvider.Network)), rq).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNetworkPeering)
+}
+
+func (e *networkPeeringExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.NetworkPeering)
+	if !ok {
+		return errors.New(errNotNetworkPeering)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	rq := &compute.NetworksRemovePeeringRequest{Name: meta.GetExternalName(cr)}
+	_, err := e.Networks.RemovePeering(e.projectID, path.Base(gcp.StringValue(cr.Spec.ForProvider.Network)), rq).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteNetworkPeering)
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/networkpeering"
+)
+
+const (
+	testNetworkPeeringName = "test-peering"
+	testPeeringNetwork     = "test-network"
+)
+
+var _ managed.ExternalConnecter = &networkPeeringConnector{}
+var _ managed.ExternalClient = &networkPeeringExternal{}
+
+type networkPeeringModifier func(*v1alpha1.NetworkPeering)
+
+func networkPeeringWithConditions(c ...xpv1.Condition) networkPeeringModifier {
+	return func(i *v1alpha1.NetworkPeering) { i.Status.SetConditions(c...) }
+}
+
+func networkPeeringWithObservation(o v1alpha1.NetworkPeeringObservation) networkPeeringModifier {
+	return func(i *v1alpha1.NetworkPeering) { i.Status.AtProvider = o }
+}
+
+func networkPeeringObj(im ...networkPeeringModifier) *v1alpha1.NetworkPeering {
+	i := &v1alpha1.NetworkPeering{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testNetworkPeeringName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testNetworkPeeringName,
+			},
+		},
+		Spec: v1alpha1.NetworkPeeringSpec{
+			ForProvider: v1alpha1.NetworkPeeringParameters{
+				Network:                        gcp.StringPtr("projects/" + projectID + "/global/networks/" + testPeeringNetwork),
+				PeerNetwork:                    gcp.StringPtr("projects/other/global/networks/peer"),
+				ExportCustomRoutes:             gcp.BoolPtr(true),
+				ImportCustomRoutes:             gcp.BoolPtr(true),
+				ExportSubnetRoutesWithPublicIP: gcp.BoolPtr(false),
+				ImportSubnetRoutesWithPublicIP: gcp.BoolPtr(false),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func observedNetworkWithPeering(m ...func(*compute.NetworkPeering)) *compute.Network {
+	p := &compute.NetworkPeering{}
+	networkpeering.GenerateNetworkPeering(testNetworkPeeringName, networkPeeringObj().Spec.ForProvider, p)
+	p.State = v1alpha1.NetworkPeeringStateActive
+	for _, f := range m {
+		f(p)
+	}
+	return &compute.Network{Name: testPeeringNetwork, Peerings: []*compute.NetworkPeering{p}}
+}
+
+func TestNetworkPeeringObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotNetworkPeering": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotNetworkPeering),
+			},
+		},
+		"NetworkNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Network{})
+			}),
+			mg: networkPeeringObj(),
+			want: want{
+				mg: networkPeeringObj(),
+			},
+		},
+		"PeeringNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/global/networks/"+testPeeringNetwork, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Network{Name: testPeeringNetwork})
+			}),
+			mg: networkPeeringObj(),
+			want: want{
+				mg: networkPeeringObj(),
+			},
+		},
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNetworkWithPeering())
+			}),
+			mg: networkPeeringObj(),
+			want: want{
+				mg: networkPeeringObj(
+					networkPeeringWithConditions(xpv1.Available()),
+					networkPeeringWithObservation(v1alpha1.NetworkPeeringObservation{State: v1alpha1.NetworkPeeringStateActive}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"PeerMissing": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNetworkWithPeering(func(p *compute.NetworkPeering) {
+					p.State = v1alpha1.NetworkPeeringStateInactive
+					p.StateDetails = "Waiting for peer network to connect."
+				}))
+			}),
+			mg: networkPeeringObj(),
+			want: want{
+				mg: networkPeeringObj(
+					networkPeeringWithConditions(xpv1.Unavailable().WithMessage(msgPeerMissing+": Waiting for peer network to connect.")),
+					networkPeeringWithObservation(v1alpha1.NetworkPeeringObservation{
+						State:        v1alpha1.NetworkPeeringStateInactive,
+						StateDetails: "Waiting for peer network to connect.",
+					}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RoutesNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedNetworkWithPeering(func(p *compute.NetworkPeering) {
+					p.ImportCustomRoutes = false
+				}))
+			}),
+			mg: networkPeeringObj(),
+			want: want{
+				mg: networkPeeringObj(
+					networkPeeringWithConditions(xpv1.Available()),
+					networkPeeringWithObservation(v1alpha1.NetworkPeeringObservation{State: v1alpha1.NetworkPeeringStateActive}),
+				),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := networkPeeringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkPeeringCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errCreateNetworkPeering),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/projects/"+projectID+"/global/networks/"+testPeeringNetwork+"/addPeering", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rq := &compute.NetworksAddPeeringRequest{}
+				_ = json.NewDecoder(r.Body).Decode(rq)
+				_ = r.Body.Close()
+				if diff := cmp.Diff(testNetworkPeeringName, rq.NetworkPeering.Name); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := networkPeeringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := networkPeeringObj()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(networkPeeringObj(networkPeeringWithConditions(xpv1.Creating())), mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNetworkPeeringDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteNetworkPeering),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/global/networks/"+testPeeringNetwork+"/removePeering", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := networkPeeringExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := networkPeeringObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(networkPeeringObj(networkPeeringWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/resourcepolicy"
+)
+
+const (
+	testResourcePolicyName   = "test-resourcepolicy"
+	testResourcePolicyRegion = "us-central1"
+)
+
+var _ managed.ExternalConnecter = &resourcePolicyConnector{}
+var _ managed.ExternalClient = &resourcePolicyExternal{}
+
+type resourcePolicyModifier func(*v1alpha1.ResourcePolicy)
+
+func resourcePolicyWithConditions(c ...xpv1.Condition) resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) { i.Status.SetConditions(c...) }
+}
+
+func resourcePolicyWithStatus(s string) resourcePolicyModifier {
+	return func(i *v1alpha1.ResourcePolicy) { i.Status.AtProvider.Status = s }
+}
+
+func resourcePolicyObj(im ...resourcePolicyModifier) *v1alpha1.ResourcePolicy {
+	i := &v1alpha1.ResourcePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testResourcePolicyName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testResourcePolicyName,
+			},
+		},
+		Spec: v1alpha1.ResourcePolicySpec{
+			ForProvider: v1alpha1.ResourcePolicyParameters{
+				Region: testResourcePolicyRegion,
+				SnapshotSchedulePolicy: v1alpha1.ResourcePolicySnapshotSchedulePolicy{
+					Schedule: v1alpha1.ResourcePolicySnapshotSchedule{
+						DailySchedule: &v1alpha1.ResourcePolicyDailyCycle{DaysInCycle: 1, StartTime: "04:00"},
+					},
+					RetentionPolicy: &v1alpha1.ResourcePolicySnapshotRetentionPolicy{MaxRetentionDays: 14},
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func observedResourcePolicy(m ...func(*compute.ResourcePolicy)) *compute.ResourcePolicy {
+	rp := &compute.ResourcePolicy{}
+	resourcepolicy.GenerateResourcePolicy(testResourcePolicyName, resourcePolicyObj().Spec.ForProvider, rp)
+	rp.Status = v1alpha1.ResourcePolicyStatusReady
+	for _, f := range m {
+		f(rp)
+	}
+	return rp
+}
+
+func TestResourcePolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotResourcePolicy": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotResourcePolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.ResourcePolicy{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg: resourcePolicyObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.ResourcePolicy{})
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetResourcePolicy),
+			},
+		},
+		"Available": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/regions/"+testResourcePolicyRegion+"/resourcePolicies/"+testResourcePolicyName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedResourcePolicy())
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(resourcePolicyWithConditions(xpv1.Available()), resourcePolicyWithStatus(v1alpha1.ResourcePolicyStatusReady)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Invalid": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedResourcePolicy(func(rp *compute.ResourcePolicy) {
+					rp.Status = v1alpha1.ResourcePolicyStatusInvalid
+				}))
+			}),
+			mg: resourcePolicyObj(),
+			want: want{
+				mg:  resourcePolicyObj(resourcePolicyWithConditions(xpv1.Unavailable()), resourcePolicyWithStatus(v1alpha1.ResourcePolicyStatusInvalid)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourcePolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourcePolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteResourcePolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := resourcePolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := resourcePolicyObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(resourcePolicyObj(resourcePolicyWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
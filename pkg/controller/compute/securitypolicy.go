@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/securitypolicy"
+)
+
+// Error strings.
+const (
+	errNotSecurityPolicy           = "managed resource is not a SecurityPolicy"
+	errGetSecurityPolicy           = "cannot get external SecurityPolicy resource"
+	errCreateSecurityPolicy        = "cannot create external SecurityPolicy resource"
+	errUpdateSecurityPolicy        = "cannot update external SecurityPolicy resource"
+	errDeleteSecurityPolicy        = "cannot delete external SecurityPolicy resource"
+	errManagedSecurityPolicyUpdate = "cannot update managed SecurityPolicy resource"
+	errAddRule                     = "cannot add rule to external SecurityPolicy resource"
+	errPatchRule                   = "cannot patch rule of external SecurityPolicy resource"
+	errRemoveRule                  = "cannot remove rule from external SecurityPolicy resource"
+)
+
+// SetupSecurityPolicy adds a controller that reconciles SecurityPolicy
+// managed resources.
+func SetupSecurityPolicy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SecurityPolicyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.SecurityPolicy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SecurityPolicyGroupVersionKind),
+			managed.WithExternalConnecter(&securityPolicyConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type securityPolicyConnector struct {
+	kube client.Client
+}
+
+func (c *securityPolicyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &securityPolicyExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type securityPolicyExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *securityPolicyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSecurityPolicy)
+	}
+	observed, err := e.SecurityPolicies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSecurityPolicy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	securitypolicy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedSecurityPolicyUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = securitypolicy.GenerateSecurityPolicyObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: securitypolicy.IsUpToDate(&cr.Spec.ForProvider, observed) &&
+			!securitypolicy.GetRuleUpdate(&cr.Spec.ForProvider, observed).Any(),
+	}, nil
+}
+
+func (e *securityPolicyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSecurityPolicy)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	sp := &compute.SecurityPolicy{}
+	securitypolicy.GenerateSecurityPolicy(meta.GetExternalName(cr), cr.Spec.ForProvider, sp)
+	_, err := e.SecurityPolicies.Insert(e.projectID, sp).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSecurityPolicy)
+}
+
+func (e *securityPolicyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSecurityPolicy)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.SecurityPolicies.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSecurityPolicy)
+	}
+
+	if !securitypolicy.IsUpToDate(&cr.Spec.ForProvider, observed) {
+		// Patching a security policy cannot change its rules.
+		sp := &compute.SecurityPolicy{
+			Description: gcp.StringValue(cr.Spec.ForProvider.Description),
+			Fingerprint: observed.Fingerprint,
+		}
+		if _, err := e.SecurityPolicies.Patch(e.projectID, name, sp).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateSecurityPolicy)
+		}
+	}
+
+	// Rules are added and patched before stale rules are removed so that
+	// traffic is never left unprotected while the policy converges.
+	u := securitypolicy.GetRuleUpdate(&cr.Spec.ForProvider, observed)
+	for _, r := range u.Add {
+		if _, err := e.SecurityPolicies.AddRule(e.projectID, name, r).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errAddRule)
+		}
+	}
+	for _, r := range u.Patch {
+		if _, err := e.SecurityPolicies.PatchRule(e.projectID, name, r).Priority(r.Priority).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errPatchRule)
+		}
+	}
+	for _, p := range u.Remove {
+		if _, err := e.SecurityPolicies.RemoveRule(e.projectID, name).Priority(p).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRemoveRule)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *securityPolicyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SecurityPolicy)
+	if !ok {
+		return errors.New(errNotSecurityPolicy)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.SecurityPolicies.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSecurityPolicy)
+}
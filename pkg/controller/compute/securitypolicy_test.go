@@ -0,0 +1,331 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/securitypolicy"
+)
+
+const (
+	testSecurityPolicyName = "test-securitypolicy"
+)
+
+var _ managed.ExternalConnecter = &securityPolicyConnector{}
+var _ managed.ExternalClient = &securityPolicyExternal{}
+
+type securityPolicyModifier func(*v1alpha1.SecurityPolicy)
+
+func securityPolicyWithConditions(c ...xpv1.Condition) securityPolicyModifier {
+	return func(i *v1alpha1.SecurityPolicy) { i.Status.SetConditions(c...) }
+}
+
+func securityPolicyObj(im ...securityPolicyModifier) *v1alpha1.SecurityPolicy {
+	description := "cool policy"
+	i := &v1alpha1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testSecurityPolicyName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testSecurityPolicyName,
+			},
+		},
+		Spec: v1alpha1.SecurityPolicySpec{
+			ForProvider: v1alpha1.SecurityPolicyParameters{
+				Description: &description,
+				Rules: []v1alpha1.SecurityPolicyRule{
+					{
+						Action:   "deny(403)",
+						Priority: 100,
+						Match: v1alpha1.SecurityPolicyRuleMatcher{
+							Expr: &v1alpha1.SecurityPolicyRuleExpr{Expression: "origin.region_code == 'AU'"},
+						},
+					},
+					{
+						Action:   "allow",
+						Priority: 200,
+						Match: v1alpha1.SecurityPolicyRuleMatcher{
+							Expr: &v1alpha1.SecurityPolicyRuleExpr{Expression: "origin.region_code == 'NZ'"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func observedSecurityPolicy(m ...func(*compute.SecurityPolicy)) *compute.SecurityPolicy {
+	sp := &compute.SecurityPolicy{}
+	securitypolicy.GenerateSecurityPolicy(testSecurityPolicyName, securityPolicyObj().Spec.ForProvider, sp)
+	sp.Rules = append(sp.Rules, &compute.SecurityPolicyRule{
+		Action:   "allow",
+		Priority: v1alpha1.DefaultSecurityPolicyRulePriority,
+		Match: &compute.SecurityPolicyRuleMatcher{
+			VersionedExpr: v1alpha1.VersionedExprSrcIPsV1,
+			Config:        &compute.SecurityPolicyRuleMatcherConfig{SrcIpRanges: []string{"*"}},
+		},
+	})
+	for _, f := range m {
+		f(sp)
+	}
+	return sp
+}
+
+func TestSecurityPolicyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSecurityPolicy": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSecurityPolicy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.SecurityPolicy{})
+			}),
+			mg: securityPolicyObj(),
+			want: want{
+				mg: securityPolicyObj(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.SecurityPolicy{})
+			}),
+			mg: securityPolicyObj(),
+			want: want{
+				mg:  securityPolicyObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSecurityPolicy),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSecurityPolicy())
+			}),
+			mg: securityPolicyObj(),
+			want: want{
+				mg:  securityPolicyObj(securityPolicyWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RuleNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSecurityPolicy(func(sp *compute.SecurityPolicy) {
+					sp.Rules[0].Preview = true
+				}))
+			}),
+			mg: securityPolicyObj(),
+			want: want{
+				mg:  securityPolicyObj(securityPolicyWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := securityPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecurityPolicyUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		observed *compute.SecurityPolicy
+		status   int
+		want     want
+	}{
+		"Description": {
+			observed: observedSecurityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Description = "old"
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"PATCH " + testSecurityPolicyName},
+			},
+		},
+		"RulesAddedBeforeRemoved": {
+			observed: observedSecurityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules[0].Preview = true
+				sp.Rules[1].Priority = 300
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{
+					"POST addRule",
+					"POST patchRule 100",
+					"POST removeRule 300",
+				},
+			},
+		},
+		"AddRuleFailed": {
+			observed: observedSecurityPolicy(func(sp *compute.SecurityPolicy) {
+				sp.Rules = sp.Rules[1:]
+			}),
+			status: http.StatusBadRequest,
+			want: want{
+				calls: []string{"POST addRule"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errAddRule),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				call := r.Method + " " + r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+				if p := r.URL.Query().Get("priority"); p != "" {
+					call += " " + p
+				}
+				calls = append(calls, call)
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := securityPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), securityPolicyObj())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecurityPolicyDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteSecurityPolicy),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := securityPolicyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := securityPolicyObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(securityPolicyObj(securityPolicyWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/sharedvpc"
+)
+
+// Error strings.
+const (
+	errNotSharedVPCHostProject     = "managed resource is not a SharedVPCHostProject"
+	errGetSharedVPCHostProject     = "cannot get Shared VPC host project"
+	errEnableSharedVPCHostProject  = "cannot enable Shared VPC host project"
+	errDisableSharedVPCHostProject = "cannot disable Shared VPC host project"
+)
+
+// SetupSharedVPCHostProject adds a controller that reconciles SharedVPCHostProject
+// managed resources.
+func SetupSharedVPCHostProject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SharedVPCHostProjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.SharedVPCHostProject{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SharedVPCHostProjectGroupVersionKind),
+			managed.WithExternalConnecter(&sharedVPCHostProjectConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type sharedVPCHostProjectConnector struct {
+	kube client.Client
+}
+
+func (c *sharedVPCHostProjectConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &sharedVPCHostProjectExternal{Service: s, projectID: projectID}, nil
+}
+
+type sharedVPCHostProjectExternal struct {
+	*compute.Service
+	projectID string
+}
+
+// The host project is the project of the ProviderConfig; it exists for as
+// long as that project is enabled as a Shared VPC host.
+func (e *sharedVPCHostProjectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SharedVPCHostProject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSharedVPCHostProject)
+	}
+	p, err := e.Projects.Get(e.projectID).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSharedVPCHostProject)
+	}
+	if !sharedvpc.IsHostProject(p) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *sharedVPCHostProjectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SharedVPCHostProject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSharedVPCHostProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	_, err := e.Projects.EnableXpnHost(e.projectID).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errEnableSharedVPCHostProject)
+}
+
+// A SharedVPCHostProject has no updatable fields.
+func (e *sharedVPCHostProjectExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+// Disabling a host project fails while service projects are still attached.
+func (e *sharedVPCHostProjectExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SharedVPCHostProject)
+	if !ok {
+		return errors.New(errNotSharedVPCHostProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.Projects.DisableXpnHost(e.projectID).Context(ctx).Do()
+	return errors.Wrap(err, errDisableSharedVPCHostProject)
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/sharedvpc"
+)
+
+var _ managed.ExternalConnecter = &sharedVPCHostProjectConnector{}
+var _ managed.ExternalClient = &sharedVPCHostProjectExternal{}
+
+type sharedVPCHostProjectModifier func(*v1alpha1.SharedVPCHostProject)
+
+func sharedVPCHostProjectWithConditions(c ...xpv1.Condition) sharedVPCHostProjectModifier {
+	return func(i *v1alpha1.SharedVPCHostProject) { i.Status.SetConditions(c...) }
+}
+
+func sharedVPCHostProjectObj(im ...sharedVPCHostProjectModifier) *v1alpha1.SharedVPCHostProject {
+	i := &v1alpha1.SharedVPCHostProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-host",
+			Finalizers: []string{},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestSharedVPCHostProjectObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSharedVPCHostProject": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSharedVPCHostProject),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.Project{})
+			}),
+			mg: sharedVPCHostProjectObj(),
+			want: want{
+				mg:  sharedVPCHostProjectObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSharedVPCHostProject),
+			},
+		},
+		"NotHost": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Project{Name: projectID})
+			}),
+			mg: sharedVPCHostProjectObj(),
+			want: want{
+				mg: sharedVPCHostProjectObj(),
+			},
+		},
+		"Host": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.Project{Name: projectID, XpnProjectStatus: sharedvpc.XpnProjectStatusHost})
+			}),
+			mg: sharedVPCHostProjectObj(),
+			want: want{
+				mg:  sharedVPCHostProjectObj(sharedVPCHostProjectWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCHostProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSharedVPCHostProjectCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errEnableSharedVPCHostProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/enableXpnHost", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCHostProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := sharedVPCHostProjectObj()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(sharedVPCHostProjectObj(sharedVPCHostProjectWithConditions(xpv1.Creating())), mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSharedVPCHostProjectDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"ServiceProjectsAttached": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDisableSharedVPCHostProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/disableXpnHost", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCHostProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := sharedVPCHostProjectObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(sharedVPCHostProjectObj(sharedVPCHostProjectWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
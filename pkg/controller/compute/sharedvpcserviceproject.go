@@ -0,0 +1,141 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/sharedvpc"
+)
+
+// Error strings.
+const (
+	errNotSharedVPCServiceProject    = "managed resource is not a SharedVPCServiceProject"
+	errGetSharedVPCServiceProjects   = "cannot list service projects of Shared VPC host project"
+	errAttachSharedVPCServiceProject = "cannot attach service project to Shared VPC host project"
+	errDetachSharedVPCServiceProject = "cannot detach service project from Shared VPC host project"
+)
+
+// SetupSharedVPCServiceProject adds a controller that reconciles SharedVPCServiceProject
+// managed resources.
+func SetupSharedVPCServiceProject(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SharedVPCServiceProjectGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.SharedVPCServiceProject{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SharedVPCServiceProjectGroupVersionKind),
+			managed.WithExternalConnecter(&sharedVPCServiceProjectConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type sharedVPCServiceProjectConnector struct {
+	kube client.Client
+}
+
+func (c *sharedVPCServiceProjectConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &sharedVPCServiceProjectExternal{Service: s, projectID: projectID}, nil
+}
+
+type sharedVPCServiceProjectExternal struct {
+	*compute.Service
+	projectID string
+}
+
+func (e *sharedVPCServiceProjectExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SharedVPCServiceProject)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSharedVPCServiceProject)
+	}
+	attached := false
+	err := e.Projects.GetXpnResources(e.projectID).Pages(ctx, func(r *compute.ProjectsGetXpnResources) error {
+		attached = attached || sharedvpc.HasServiceProject(r.Resources, cr.Spec.ForProvider.ServiceProject)
+		return nil
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetSharedVPCServiceProjects)
+	}
+	if !attached {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *sharedVPCServiceProjectExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SharedVPCServiceProject)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSharedVPCServiceProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	rq := &compute.ProjectsEnableXpnResourceRequest{XpnResource: sharedvpc.GenerateXpnResource(cr.Spec.ForProvider)}
+	_, err := e.Projects.EnableXpnResource(e.projectID, rq).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errAttachSharedVPCServiceProject)
+}
+
+// A SharedVPCServiceProject has no updatable fields.
+func (e *sharedVPCServiceProjectExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *sharedVPCServiceProjectExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SharedVPCServiceProject)
+	if !ok {
+		return errors.New(errNotSharedVPCServiceProject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	rq := &compute.ProjectsDisableXpnResourceRequest{XpnResource: sharedvpc.GenerateXpnResource(cr.Spec.ForProvider)}
+	_, err := e.Projects.DisableXpnResource(e.projectID, rq).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDetachSharedVPCServiceProject)
+}
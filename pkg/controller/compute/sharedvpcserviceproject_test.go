@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/sharedvpc"
+)
+
+const testServiceProject = "test-service-project"
+
+var _ managed.ExternalConnecter = &sharedVPCServiceProjectConnector{}
+var _ managed.ExternalClient = &sharedVPCServiceProjectExternal{}
+
+type sharedVPCServiceProjectModifier func(*v1alpha1.SharedVPCServiceProject)
+
+func sharedVPCServiceProjectWithConditions(c ...xpv1.Condition) sharedVPCServiceProjectModifier {
+	return func(i *v1alpha1.SharedVPCServiceProject) { i.Status.SetConditions(c...) }
+}
+
+func sharedVPCServiceProjectObj(im ...sharedVPCServiceProjectModifier) *v1alpha1.SharedVPCServiceProject {
+	i := &v1alpha1.SharedVPCServiceProject{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-service",
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.SharedVPCServiceProjectSpec{
+			ForProvider: v1alpha1.SharedVPCServiceProjectParameters{
+				ServiceProject: testServiceProject,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestSharedVPCServiceProjectObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSharedVPCServiceProject": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSharedVPCServiceProject),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&compute.ProjectsGetXpnResources{})
+			}),
+			mg: sharedVPCServiceProjectObj(),
+			want: want{
+				mg:  sharedVPCServiceProjectObj(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetSharedVPCServiceProjects),
+			},
+		},
+		"NotAttached": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(&compute.ProjectsGetXpnResources{
+					Resources: []*compute.XpnResourceId{{Id: "other", Type: sharedvpc.XpnResourceTypeProject}},
+				})
+			}),
+			mg: sharedVPCServiceProjectObj(),
+			want: want{
+				mg: sharedVPCServiceProjectObj(),
+			},
+		},
+		"AttachedOnLaterPage": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/getXpnResources", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if r.URL.Query().Get("pageToken") == "" {
+					_ = json.NewEncoder(w).Encode(&compute.ProjectsGetXpnResources{
+						Resources:     []*compute.XpnResourceId{{Id: "other", Type: sharedvpc.XpnResourceTypeProject}},
+						NextPageToken: "next",
+					})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&compute.ProjectsGetXpnResources{
+					Resources: []*compute.XpnResourceId{{Id: testServiceProject, Type: sharedvpc.XpnResourceTypeProject}},
+				})
+			}),
+			mg: sharedVPCServiceProjectObj(),
+			want: want{
+				mg:  sharedVPCServiceProjectObj(sharedVPCServiceProjectWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCServiceProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSharedVPCServiceProjectCreate(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errAttachSharedVPCServiceProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/projects/"+projectID+"/enableXpnResource", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				rq := &compute.ProjectsEnableXpnResourceRequest{}
+				_ = json.NewDecoder(r.Body).Decode(rq)
+				_ = r.Body.Close()
+				want := &compute.XpnResourceId{Id: testServiceProject, Type: sharedvpc.XpnResourceTypeProject}
+				if diff := cmp.Diff(want, rq.XpnResource); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCServiceProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := sharedVPCServiceProjectObj()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(sharedVPCServiceProjectObj(sharedVPCServiceProjectWithConditions(xpv1.Creating())), mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSharedVPCServiceProjectDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDetachSharedVPCServiceProject),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/projects/"+projectID+"/disableXpnResource", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := sharedVPCServiceProjectExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := sharedVPCServiceProjectObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(sharedVPCServiceProjectObj(sharedVPCServiceProjectWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/disk"
+	"github.com/crossplane/provider-gcp/pkg/clients/snapshot"
+)
+
+// Error strings.
+const (
+	errNotSnapshot           = "managed resource is not a Snapshot"
+	errGetSnapshot           = "cannot get external Snapshot resource"
+	errCreateSnapshot        = "cannot create external Snapshot resource"
+	errDeleteSnapshot        = "cannot delete external Snapshot resource"
+	errManagedSnapshotUpdate = "cannot update managed Snapshot resource"
+	errSetSnapshotLabels     = "cannot set labels of external Snapshot resource"
+	errParseSourceDisk       = "cannot parse source disk of Snapshot"
+)
+
+// SetupSnapshot adds a controller that reconciles Snapshot managed
+// resources.
+func SetupSnapshot(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SnapshotGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Snapshot{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SnapshotGroupVersionKind),
+			managed.WithExternalConnecter(&snapshotConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type snapshotConnector struct {
+	kube client.Client
+}
+
+func (c *snapshotConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &snapshotExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type snapshotExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *snapshotExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSnapshot)
+	}
+	observed, err := e.Snapshots.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSnapshot)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	snapshot.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedSnapshotUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = snapshot.GenerateSnapshotObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case v1alpha1.SnapshotStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.SnapshotStatusCreating, v1alpha1.SnapshotStatusUploading:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.SnapshotStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: snapshot.IsUpToDate(&cr.Spec.ForProvider, observed),
+	}, nil
+}
+
+func (e *snapshotExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSnapshot)
+	}
+
+	// Snapshots are created by the disk they snapshot, which may be zonal
+	// or regional and may reside in another project.
+	src, err := disk.ParseURL(gcp.StringValue(cr.Spec.ForProvider.SourceDisk))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errParseSourceDisk)
+	}
+	if src.Project == "" {
+		src.Project = e.projectID
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	s := &compute.Snapshot{}
+	snapshot.GenerateSnapshot(meta.GetExternalName(cr), cr.Spec.ForProvider, s)
+	if src.Region != "" {
+		_, err = e.RegionDisks.CreateSnapshot(src.Project, src.Region, src.Disk, s).Context(ctx).Do()
+	} else {
+		_, err = e.Disks.CreateSnapshot(src.Project, src.Zone, src.Disk, s).Context(ctx).Do()
+	}
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSnapshot)
+}
+
+func (e *snapshotExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotSnapshot)
+	}
+
+	name := meta.GetExternalName(cr)
+	observed, err := e.Snapshots.Get(e.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetSnapshot)
+	}
+
+	// Labels are the only field of a snapshot that may be updated.
+	rq := &compute.GlobalSetLabelsRequest{Labels: cr.Spec.ForProvider.Labels, LabelFingerprint: observed.LabelFingerprint}
+	_, err = e.Snapshots.SetLabels(e.projectID, name, rq).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errSetSnapshotLabels)
+}
+
+func (e *snapshotExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Snapshot)
+	if !ok {
+		return errors.New(errNotSnapshot)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.Snapshots.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSnapshot)
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/snapshot"
+)
+
+const (
+	testSnapshotName = "test-snapshot"
+)
+
+var _ managed.ExternalConnecter = &snapshotConnector{}
+var _ managed.ExternalClient = &snapshotExternal{}
+
+type snapshotModifier func(*v1alpha1.Snapshot)
+
+func snapshotWithConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(i *v1alpha1.Snapshot) { i.Status.SetConditions(c...) }
+}
+
+func snapshotWithSourceDisk(d string) snapshotModifier {
+	return func(i *v1alpha1.Snapshot) { i.Spec.ForProvider.SourceDisk = &d }
+}
+
+func snapshotWithStatus(s string) snapshotModifier {
+	return func(i *v1alpha1.Snapshot) { i.Status.AtProvider.Status = s }
+}
+
+func snapshotObj(im ...snapshotModifier) *v1alpha1.Snapshot {
+	sourceDisk := "projects/" + projectID + "/zones/us-central1-a/disks/" + testDiskName
+	i := &v1alpha1.Snapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testSnapshotName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testSnapshotName,
+			},
+		},
+		Spec: v1alpha1.SnapshotSpec{
+			ForProvider: v1alpha1.SnapshotParameters{
+				SourceDisk:       &sourceDisk,
+				Labels:           map[string]string{"app": "db"},
+				StorageLocations: []string{"us"},
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func observedSnapshot(m ...func(*compute.Snapshot)) *compute.Snapshot {
+	s := &compute.Snapshot{}
+	snapshot.GenerateSnapshot(testSnapshotName, snapshotObj().Spec.ForProvider, s)
+	s.SourceDisk = "https://www.googleapis.com/compute/v1/projects/" + projectID + "/zones/us-central1-a/disks/" + testDiskName
+	s.Status = v1alpha1.SnapshotStatusReady
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func TestSnapshotObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotSnapshot": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSnapshot),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.Snapshot{})
+			}),
+			mg: snapshotObj(),
+			want: want{
+				mg: snapshotObj(),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSnapshot())
+			}),
+			mg: snapshotObj(),
+			want: want{
+				mg:  snapshotObj(snapshotWithConditions(xpv1.Available()), snapshotWithStatus(v1alpha1.SnapshotStatusReady)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Uploading": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSnapshot(func(s *compute.Snapshot) {
+					s.Status = v1alpha1.SnapshotStatusUploading
+				}))
+			}),
+			mg: snapshotObj(),
+			want: want{
+				mg:  snapshotObj(snapshotWithConditions(xpv1.Creating()), snapshotWithStatus(v1alpha1.SnapshotStatusUploading)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"LabelsNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				_ = json.NewEncoder(w).Encode(observedSnapshot(func(s *compute.Snapshot) {
+					s.Labels = map[string]string{"app": "web"}
+				}))
+			}),
+			mg: snapshotObj(),
+			want: want{
+				mg:  snapshotObj(snapshotWithConditions(xpv1.Available()), snapshotWithStatus(v1alpha1.SnapshotStatusReady)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := snapshotExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotCreate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		mg     *v1alpha1.Snapshot
+		status int
+		want   want
+	}{
+		"Zonal": {
+			mg:     snapshotObj(),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"POST /projects/" + projectID + "/zones/us-central1-a/disks/" + testDiskName + "/createSnapshot"},
+			},
+		},
+		"RegionalInOtherProject": {
+			mg:     snapshotObj(snapshotWithSourceDisk("projects/other/regions/us-central1/disks/" + testDiskName)),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"POST /projects/other/regions/us-central1/disks/" + testDiskName + "/createSnapshot"},
+			},
+		},
+		"InvalidSourceDisk": {
+			mg:     snapshotObj(snapshotWithSourceDisk(testDiskName)),
+			status: http.StatusOK,
+			want: want{
+				err: errors.Wrap(errors.New("disk URL must be of the form [projects/<project>/](zones/<zone>|regions/<region>)/disks/<disk>"), errParseSourceDisk),
+			},
+		},
+		"Failed": {
+			mg:     snapshotObj(),
+			status: http.StatusBadRequest,
+			want: want{
+				calls: []string{"POST /projects/" + projectID + "/zones/us-central1-a/disks/" + testDiskName + "/createSnapshot"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errCreateSnapshot),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := snapshotExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Create(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	var calls []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(observedSnapshot(func(s *compute.Snapshot) { s.Labels = nil }))
+			return
+		}
+		calls = append(calls, r.Method+" "+r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(&compute.Operation{})
+	}))
+	defer server.Close()
+	s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := snapshotExternal{
+		projectID: projectID,
+		Service:   s,
+	}
+	if _, err := e.Update(context.Background(), snapshotObj()); err != nil {
+		t.Errorf("Update(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]string{"POST setLabels"}, calls); diff != "" {
+		t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+	}
+}
+
+func TestSnapshotDelete(t *testing.T) {
+	cases := map[string]struct {
+		status int
+		want   error
+	}{
+		"Successful": {
+			status: http.StatusOK,
+		},
+		"AlreadyGone": {
+			status: http.StatusNotFound,
+		},
+		"Failed": {
+			status: http.StatusBadRequest,
+			want:   errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteSnapshot),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := snapshotExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := snapshotObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(snapshotObj(snapshotWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/sslcertificate"
+)
+
+// Error strings.
+const (
+	errNotSSLCertificate           = "managed resource is not an SSLCertificate"
+	errGetSSLCertificate           = "cannot get external SslCertificate resource"
+	errCreateSSLCertificate        = "cannot create external SslCertificate resource"
+	errDeleteSSLCertificate        = "cannot delete external SslCertificate resource"
+	errManagedSSLCertificateUpdate = "cannot update managed SSLCertificate resource"
+	errGetCertificateSecret        = "cannot get certificate secret"
+	errGetPrivateKeySecret         = "cannot get private key secret"
+)
+
+// SetupSSLCertificate adds a controller that reconciles SSLCertificate
+// managed resources.
+func SetupSSLCertificate(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SSLCertificateGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.SSLCertificate{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SSLCertificateGroupVersionKind),
+			managed.WithExternalConnecter(&sslCertificateConnector{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type sslCertificateConnector struct {
+	kube client.Client
+}
+
+func (c *sslCertificateConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &sslCertificateExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type sslCertificateExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *sslCertificateExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SSLCertificate)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSSLCertificate)
+	}
+	observed, err := e.SslCertificates.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSSLCertificate)
+	}
+
+	// SSL certificates are always "up to date" because they can't be updated.
+	eo := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	sslcertificate.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return eo, errors.Wrap(err, errManagedSSLCertificateUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = sslcertificate.GenerateSSLCertificateObservation(*observed)
+
+	switch cr.Status.AtProvider.Status {
+	case "", v1alpha1.SSLCertificateStatusActive:
+		// Self-managed certificates have no status and are usable as soon
+		// as they exist.
+		cr.SetConditions(xpv1.Available())
+	case v1alpha1.SSLCertificateStatusProvisioning:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return eo, nil
+}
+
+func (e *sslCertificateExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SSLCertificate)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSSLCertificate)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+
+	var certificate, privateKey string
+	if sm := cr.Spec.ForProvider.SelfManaged; sm != nil {
+		c, err := e.getSecretValue(ctx, sm.CertificateSecretRef)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetCertificateSecret)
+		}
+		k, err := e.getSecretValue(ctx, sm.PrivateKeySecretRef)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetPrivateKeySecret)
+		}
+		certificate, privateKey = c, k
+	}
+
+	c := &compute.SslCertificate{}
+	sslcertificate.GenerateSSLCertificate(meta.GetExternalName(cr), cr.Spec.ForProvider, certificate, privateKey, c)
+	_, err := e.SslCertificates.Insert(e.projectID, c).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateSSLCertificate)
+}
+
+func (e *sslCertificateExternal) getSecretValue(ctx context.Context, ref xpv1.SecretKeySelector) (string, error) {
+	s := &v1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+func (e *sslCertificateExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// SSL certificates cannot be updated.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *sslCertificateExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SSLCertificate)
+	if !ok {
+		return errors.New(errNotSSLCertificate)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.SslCertificates.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteSSLCertificate)
+}
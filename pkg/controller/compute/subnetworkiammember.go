@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/subnetworkiammember"
+)
+
+// Error strings.
+const (
+	errNotSubnetworkIAMMember      = "managed resource is not a SubnetworkIAMMember"
+	errSubnetworkIAMMemberNoSubnet = "subnetwork of SubnetworkIAMMember must be specified"
+	errSubnetworkIAMMemberNoMember = "member of SubnetworkIAMMember must be specified"
+	errGetSubnetworkIAMPolicy      = "cannot get IAM policy of Subnetwork"
+	errSetSubnetworkIAMPolicy      = "cannot set IAM policy of Subnetwork"
+)
+
+// SetupSubnetworkIAMMember adds a controller that reconciles SubnetworkIAMMember
+// managed resources.
+func SetupSubnetworkIAMMember(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.SubnetworkIAMMemberGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.SubnetworkIAMMember{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.SubnetworkIAMMemberGroupVersionKind),
+			managed.WithExternalConnecter(&subnetworkIAMMemberConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type subnetworkIAMMemberConnector struct {
+	kube client.Client
+}
+
+func (c *subnetworkIAMMemberConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &subnetworkIAMMemberExternal{Service: s, projectID: projectID}, nil
+}
+
+type subnetworkIAMMemberExternal struct {
+	*compute.Service
+	projectID string
+}
+
+// subnetwork returns the project, region and name of the Subnetwork the
+// supplied SubnetworkIAMMember grants a role on. The project defaults to
+// that of the ProviderConfig.
+func (e *subnetworkIAMMemberExternal) subnetwork(cr *v1alpha1.SubnetworkIAMMember) (subnetworkiammember.URL, error) {
+	if cr.Spec.ForProvider.Subnetwork == nil {
+		return subnetworkiammember.URL{}, errors.New(errSubnetworkIAMMemberNoSubnet)
+	}
+	if cr.Spec.ForProvider.Member == nil {
+		return subnetworkiammember.URL{}, errors.New(errSubnetworkIAMMemberNoMember)
+	}
+	u, err := subnetworkiammember.ParseURL(*cr.Spec.ForProvider.Subnetwork)
+	if err != nil {
+		return subnetworkiammember.URL{}, err
+	}
+	if u.Project == "" {
+		u.Project = e.projectID
+	}
+	return u, nil
+}
+
+func (e *subnetworkIAMMemberExternal) getPolicy(ctx context.Context, u subnetworkiammember.URL) (*compute.Policy, error) {
+	p, err := e.Subnetworks.GetIamPolicy(u.Project, u.Region, u.Subnetwork).
+		OptionsRequestedPolicyVersion(iamv1alpha1.PolicyVersion).Context(ctx).Do()
+	return p, errors.Wrap(err, errGetSubnetworkIAMPolicy)
+}
+
+func (e *subnetworkIAMMemberExternal) setPolicy(ctx context.Context, u subnetworkiammember.URL, p *compute.Policy) error {
+	// The etag of the policy guards against concurrent modifications.
+	rq := &compute.RegionSetPolicyRequest{Policy: p, Etag: p.Etag}
+	_, err := e.Subnetworks.SetIamPolicy(u.Project, u.Region, u.Subnetwork, rq).Context(ctx).Do()
+	return errors.Wrap(err, errSetSubnetworkIAMPolicy)
+}
+
+// Only the member of this resource is managed; other members and bindings of
+// the Subnetwork's IAM policy are left untouched.
+func (e *subnetworkIAMMemberExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.SubnetworkIAMMember)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotSubnetworkIAMMember)
+	}
+	u, err := e.subnetwork(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	p, err := e.getPolicy(ctx, u)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !subnetworkiammember.IsMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *subnetworkIAMMemberExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.SubnetworkIAMMember)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotSubnetworkIAMMember)
+	}
+	cr.Status.SetConditions(xpv1.Creating())
+	u, err := e.subnetwork(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	p, err := e.getPolicy(ctx, u)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if !subnetworkiammember.AddMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, u, p)
+}
+
+// All fields of a SubnetworkIAMMember are immutable.
+func (e *subnetworkIAMMemberExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *subnetworkIAMMemberExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.SubnetworkIAMMember)
+	if !ok {
+		return errors.New(errNotSubnetworkIAMMember)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+	u, err := e.subnetwork(cr)
+	if err != nil {
+		return err
+	}
+	p, err := e.getPolicy(ctx, u)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, errors.Cause(err)), errGetSubnetworkIAMPolicy)
+	}
+	if !subnetworkiammember.RemoveMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, u, p)
+}
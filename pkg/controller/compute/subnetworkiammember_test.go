@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	iamv1alpha1 "github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	testIAMSubnetworkPath = "/projects/" + projectID + "/regions/us-central1/subnetworks/test-subnet"
+	testIAMRole           = "roles/compute.networkUser"
+	testIAMMember         = "serviceAccount:test@example.iam.gserviceaccount.com"
+	testIAMOtherMember    = "user:other@example.com"
+)
+
+var _ managed.ExternalConnecter = &subnetworkIAMMemberConnector{}
+var _ managed.ExternalClient = &subnetworkIAMMemberExternal{}
+
+type subnetworkIAMMemberModifier func(*v1alpha1.SubnetworkIAMMember)
+
+func subnetworkIAMMemberWithConditions(c ...xpv1.Condition) subnetworkIAMMemberModifier {
+	return func(i *v1alpha1.SubnetworkIAMMember) { i.Status.SetConditions(c...) }
+}
+
+func subnetworkIAMMemberObj(im ...subnetworkIAMMemberModifier) *v1alpha1.SubnetworkIAMMember {
+	i := &v1alpha1.SubnetworkIAMMember{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-member",
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.SubnetworkIAMMemberSpec{
+			ForProvider: v1alpha1.SubnetworkIAMMemberParameters{
+				Subnetwork: gcp.StringPtr("regions/us-central1/subnetworks/test-subnet"),
+				Role:       testIAMRole,
+				Member:     gcp.StringPtr(testIAMMember),
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// subnetworkIAMPolicyServer serves the supplied IAM policy and records the
+// policy that is set.
+func subnetworkIAMPolicyServer(t *testing.T, p *compute.Policy, set **compute.Policy) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			if diff := cmp.Diff(testIAMSubnetworkPath+"/getIamPolicy", r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = r.Body.Close()
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(p)
+		case strings.HasSuffix(r.URL.Path, "/setIamPolicy"):
+			rq := &compute.RegionSetPolicyRequest{}
+			_ = json.NewDecoder(r.Body).Decode(rq)
+			_ = r.Body.Close()
+			*set = rq.Policy
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(rq.Policy)
+		}
+	}))
+}
+
+func TestSubnetworkIAMMemberObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		policy *compute.Policy
+		mg     resource.Managed
+		want   want
+	}{
+		"NotSubnetworkIAMMember": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotSubnetworkIAMMember),
+			},
+		},
+		"NotMember": {
+			policy: &compute.Policy{Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember}}}},
+			mg:     subnetworkIAMMemberObj(),
+			want: want{
+				mg: subnetworkIAMMemberObj(),
+			},
+		},
+		"Member": {
+			policy: &compute.Policy{Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember, testIAMMember}}}},
+			mg:     subnetworkIAMMemberObj(),
+			want: want{
+				mg:  subnetworkIAMMemberObj(subnetworkIAMMemberWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *compute.Policy
+			server := subnetworkIAMPolicyServer(t, tc.policy, &set)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := subnetworkIAMMemberExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubnetworkIAMMemberCreate(t *testing.T) {
+	cases := map[string]struct {
+		policy *compute.Policy
+		want   *compute.Policy
+	}{
+		"AddToBinding": {
+			policy: &compute.Policy{
+				Etag:     "etag",
+				Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember}}},
+			},
+			want: &compute.Policy{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember, testIAMMember}}},
+			},
+		},
+		"AlreadyMember": {
+			policy: &compute.Policy{Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMMember}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *compute.Policy
+			server := subnetworkIAMPolicyServer(t, tc.policy, &set)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := subnetworkIAMMemberExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := subnetworkIAMMemberObj()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Create(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(subnetworkIAMMemberObj(subnetworkIAMMemberWithConditions(xpv1.Creating())), mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSubnetworkIAMMemberDelete(t *testing.T) {
+	cases := map[string]struct {
+		policy *compute.Policy
+		want   *compute.Policy
+	}{
+		"RemoveFromBinding": {
+			policy: &compute.Policy{
+				Etag: "etag",
+				Bindings: []*compute.Binding{
+					{Role: testIAMRole, Members: []string{testIAMOtherMember, testIAMMember}},
+				},
+			},
+			want: &compute.Policy{
+				Etag:     "etag",
+				Version:  iamv1alpha1.PolicyVersion,
+				Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember}}},
+			},
+		},
+		"AlreadyRemoved": {
+			policy: &compute.Policy{Bindings: []*compute.Binding{{Role: testIAMRole, Members: []string{testIAMOtherMember}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *compute.Policy
+			server := subnetworkIAMPolicyServer(t, tc.policy, &set)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := subnetworkIAMMemberExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			mg := subnetworkIAMMemberObj()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Delete(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(subnetworkIAMMemberObj(subnetworkIAMMemberWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/targethttpsproxy"
+)
+
+// Error strings.
+const (
+	errNotTargetHTTPSProxy           = "managed resource is not a TargetHTTPSProxy"
+	errGetTargetHTTPSProxy           = "cannot get external TargetHttpsProxy resource"
+	errCreateTargetHTTPSProxy        = "cannot create external TargetHttpsProxy resource"
+	errDeleteTargetHTTPSProxy        = "cannot delete external TargetHttpsProxy resource"
+	errManagedTargetHTTPSProxyUpdate = "cannot update managed TargetHTTPSProxy resource"
+	errSetURLMap                     = "cannot set URL map of external TargetHttpsProxy resource"
+	errSetSSLCertificates            = "cannot set SSL certificates of external TargetHttpsProxy resource"
+	errSetQuicOverride               = "cannot set QUIC override of external TargetHttpsProxy resource"
+	errSetSSLPolicy                  = "cannot set SSL policy of external TargetHttpsProxy resource"
+)
+
+// SetupTargetHTTPSProxy adds a controller that reconciles TargetHTTPSProxy
+// managed resources.
+func SetupTargetHTTPSProxy(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.TargetHTTPSProxyGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.TargetHTTPSProxy{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.TargetHTTPSProxyGroupVersionKind),
+			managed.WithExternalConnecter(&targetHTTPSProxyConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type targetHTTPSProxyConnector struct {
+	kube client.Client
+}
+
+func (c *targetHTTPSProxyConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &targetHTTPSProxyExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type targetHTTPSProxyExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *targetHTTPSProxyExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.TargetHTTPSProxy)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotTargetHTTPSProxy)
+	}
+	observed, err := e.TargetHttpsProxies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetTargetHTTPSProxy)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	targethttpsproxy.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedTargetHTTPSProxyUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = targethttpsproxy.GenerateTargetHTTPSProxyObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !targethttpsproxy.GetUpdate(&cr.Spec.ForProvider, observed).Any(),
+	}, nil
+}
+
+func (e *targetHTTPSProxyExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.TargetHTTPSProxy)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotTargetHTTPSProxy)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	p := &compute.TargetHttpsProxy{}
+	targethttpsproxy.GenerateTargetHTTPSProxy(meta.GetExternalName(cr), cr.Spec.ForProvider, p)
+	_, err := e.TargetHttpsProxies.Insert(e.projectID, p).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateTargetHTTPSProxy)
+}
+
+func (e *targetHTTPSProxyExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.TargetHTTPSProxy)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotTargetHTTPSProxy)
+	}
+
+	observed, err := e.TargetHttpsProxies.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetTargetHTTPSProxy)
+	}
+
+	// Target HTTPS proxies expose one call per updatable field
+	// rather than a general purpose patch.
+	name := meta.GetExternalName(cr)
+	p := cr.Spec.ForProvider
+	u := targethttpsproxy.GetUpdate(&p, observed)
+	if u.URLMap {
+		req := &compute.UrlMapReference{UrlMap: gcp.StringValue(p.URLMap)}
+		if _, err := e.TargetHttpsProxies.SetUrlMap(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetURLMap)
+		}
+	}
+	if u.SSLCertificates {
+		req := &compute.TargetHttpsProxiesSetSslCertificatesRequest{SslCertificates: p.SSLCertificates}
+		if _, err := e.TargetHttpsProxies.SetSslCertificates(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSSLCertificates)
+		}
+	}
+	if u.QuicOverride {
+		req := &compute.TargetHttpsProxiesSetQuicOverrideRequest{QuicOverride: gcp.StringValue(p.QuicOverride)}
+		if _, err := e.TargetHttpsProxies.SetQuicOverride(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetQuicOverride)
+		}
+	}
+	if u.SSLPolicy {
+		// An empty reference detaches the SSL policy.
+		req := &compute.SslPolicyReference{SslPolicy: gcp.StringValue(p.SSLPolicy)}
+		if _, err := e.TargetHttpsProxies.SetSslPolicy(e.projectID, name, req).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errSetSSLPolicy)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *targetHTTPSProxyExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.TargetHTTPSProxy)
+	if !ok {
+		return errors.New(errNotTargetHTTPSProxy)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.TargetHttpsProxies.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteTargetHTTPSProxy)
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	"github.com/crossplane/provider-gcp/apis/compute/v1beta1"
+	"github.com/crossplane/provider-gcp/pkg/clients/targethttpsproxy"
+)
+
+const (
+	testTargetHTTPSProxyName = "test-targethttpsproxy"
+	testURLMap               = "https://www.googleapis.com/compute/v1/projects/cool/global/urlMaps/coolMap"
+	testSSLCertificate       = "https://www.googleapis.com/compute/v1/projects/cool/global/sslCertificates/coolCert"
+)
+
+var _ managed.ExternalConnecter = &targetHTTPSProxyConnector{}
+var _ managed.ExternalClient = &targetHTTPSProxyExternal{}
+
+type targetHTTPSProxyModifier func(*v1alpha1.TargetHTTPSProxy)
+
+func targetHTTPSProxyWithConditions(c ...xpv1.Condition) targetHTTPSProxyModifier {
+	return func(i *v1alpha1.TargetHTTPSProxy) { i.Status.SetConditions(c...) }
+}
+
+func targetHTTPSProxyObj(im ...targetHTTPSProxyModifier) *v1alpha1.TargetHTTPSProxy {
+	urlMap := testURLMap
+	i := &v1alpha1.TargetHTTPSProxy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testTargetHTTPSProxyName,
+			Finalizers: []string{},
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: testTargetHTTPSProxyName,
+			},
+		},
+		Spec: v1alpha1.TargetHTTPSProxySpec{
+			ForProvider: v1alpha1.TargetHTTPSProxyParameters{
+				SSLCertificates: []string{testSSLCertificate},
+				URLMap:          &urlMap,
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+func TestTargetHTTPSProxyObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotTargetHTTPSProxy": {
+			mg: &v1beta1.Subnetwork{},
+			want: want{
+				mg:  &v1beta1.Subnetwork{},
+				err: errors.New(errNotTargetHTTPSProxy),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&compute.TargetHttpsProxy{})
+			}),
+			mg: targetHTTPSProxyObj(),
+			want: want{
+				mg: targetHTTPSProxyObj(),
+			},
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				p := &compute.TargetHttpsProxy{}
+				targethttpsproxy.GenerateTargetHTTPSProxy(testTargetHTTPSProxyName, targetHTTPSProxyObj().Spec.ForProvider, p)
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: targetHTTPSProxyObj(),
+			want: want{
+				mg:  targetHTTPSProxyObj(targetHTTPSProxyWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusOK)
+				p := &compute.TargetHttpsProxy{}
+				targethttpsproxy.GenerateTargetHTTPSProxy(testTargetHTTPSProxyName, targetHTTPSProxyObj().Spec.ForProvider, p)
+				p.UrlMap = "https://www.googleapis.com/compute/v1/projects/cool/global/urlMaps/other"
+				_ = json.NewEncoder(w).Encode(p)
+			}),
+			mg: targetHTTPSProxyObj(),
+			want: want{
+				mg:  targetHTTPSProxyObj(targetHTTPSProxyWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetHTTPSProxyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTargetHTTPSProxyUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	observed := func(m func(*compute.TargetHttpsProxy)) *compute.TargetHttpsProxy {
+		p := &compute.TargetHttpsProxy{}
+		targethttpsproxy.GenerateTargetHTTPSProxy(testTargetHTTPSProxyName, targetHTTPSProxyObj().Spec.ForProvider, p)
+		m(p)
+		return p
+	}
+
+	cases := map[string]struct {
+		observed *compute.TargetHttpsProxy
+		status   int
+		want     want
+	}{
+		"URLMapChanged": {
+			observed: observed(func(p *compute.TargetHttpsProxy) { p.UrlMap = "other" }),
+			status:   http.StatusOK,
+			want: want{
+				calls: []string{"setUrlMap"},
+			},
+		},
+		"CertificatesAndURLMapChanged": {
+			observed: observed(func(p *compute.TargetHttpsProxy) {
+				p.UrlMap = "other"
+				p.SslCertificates = nil
+			}),
+			status: http.StatusOK,
+			want: want{
+				calls: []string{"setUrlMap", "setSslCertificates"},
+			},
+		},
+		"SetURLMapFailed": {
+			observed: observed(func(p *compute.TargetHttpsProxy) { p.UrlMap = "other" }),
+			status:   http.StatusBadRequest,
+			want: want{
+				calls: []string{"setUrlMap"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errSetURLMap),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodGet {
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				}
+				calls = append(calls, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:])
+				w.WriteHeader(tc.status)
+				_ = json.NewEncoder(w).Encode(&compute.Operation{})
+			}))
+			defer server.Close()
+			s, _ := compute.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := targetHTTPSProxyExternal{
+				projectID: projectID,
+				Service:   s,
+			}
+			_, err := e.Update(context.Background(), targetHTTPSProxyObj())
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("Update(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	compute "google.golang.org/api/compute/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/compute/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/urlmap"
+)
+
+// Error strings.
+const (
+	errNotURLMap           = "managed resource is not a URLMap"
+	errGetURLMap           = "cannot get external URLMap resource"
+	errCreateURLMap        = "cannot create external URLMap resource"
+	errUpdateURLMap        = "cannot update external URLMap resource"
+	errDeleteURLMap        = "cannot delete external URLMap resource"
+	errManagedURLMapUpdate = "cannot update managed URLMap resource"
+	errCheckURLMapUpToDate = "cannot determine if URLMap is up to date"
+)
+
+// SetupURLMap adds a controller that reconciles URLMap managed
+// resources.
+func SetupURLMap(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.URLMapGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.URLMap{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.URLMapGroupVersionKind),
+			managed.WithExternalConnecter(&urlMapConnector{kube: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type urlMapConnector struct {
+	kube client.Client
+}
+
+func (c *urlMapConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := compute.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &urlMapExternal{kube: c.kube, Service: s, projectID: projectID}, nil
+}
+
+type urlMapExternal struct {
+	kube client.Client
+	*compute.Service
+	projectID string
+}
+
+func (e *urlMapExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.URLMap)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotURLMap)
+	}
+	observed, err := e.UrlMaps.Get(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetURLMap)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	urlmap.LateInitializeSpec(&cr.Spec.ForProvider, *observed)
+	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedURLMapUpdate)
+		}
+	}
+
+	cr.Status.AtProvider = urlmap.GenerateURLMapObservation(*observed)
+	cr.Status.SetConditions(xpv1.Available())
+
+	u, err := urlmap.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, observed)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckURLMapUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: u,
+	}, nil
+}
+
+func (e *urlMapExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.URLMap)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotURLMap)
+	}
+
+	cr.Status.SetConditions(xpv1.Creating())
+	um := &compute.UrlMap{}
+	urlmap.GenerateURLMap(meta.GetExternalName(cr), cr.Spec.ForProvider, um)
+	_, err := e.UrlMaps.Insert(e.projectID, um).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateURLMap)
+}
+
+func (e *urlMapExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.URLMap)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotURLMap)
+	}
+
+	um := &compute.UrlMap{}
+	urlmap.GenerateURLMap(meta.GetExternalName(cr), cr.Spec.ForProvider, um)
+	um.Fingerprint = cr.Status.AtProvider.Fingerprint
+	_, err := e.UrlMaps.Patch(e.projectID, meta.GetExternalName(cr), um).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateURLMap)
+}
+
+func (e *urlMapExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.URLMap)
+	if !ok {
+		return errors.New(errNotURLMap)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	_, err := e.UrlMaps.Delete(e.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteURLMap)
+}
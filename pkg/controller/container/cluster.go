@@ -18,10 +18,15 @@ package container
 
 import (
 	"context"
+	"encoding/base64"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 	container "google.golang.org/api/container/v1"
+	"google.golang.org/api/option"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -51,12 +56,20 @@ const (
 	errUpdateCluster        = "cannot update GKE cluster"
 	errDeleteCluster        = "cannot delete GKE cluster"
 	errCheckClusterUpToDate = "cannot determine if GKE cluster is up to date"
+	errGetToken             = "cannot get access token for GKE cluster"
+	errGetOperation         = "cannot get GKE cluster update operation"
+)
+
+// Event reasons.
+const (
+	reasonGetToken event.Reason = "CannotGetToken"
 )
 
 // SetupCluster adds a controller that reconciles Cluster
 // managed resources.
 func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1beta2.ClusterGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -66,32 +79,39 @@ func SetupCluster(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter)
 		For(&v1beta2.Cluster{}).
 		Complete(managed.NewReconciler(mgr,
 			resource.ManagedKind(v1beta2.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient()}),
+			managed.WithExternalConnecter(&clusterConnector{kube: mgr.GetClient(), record: recorder, tokens: gke.NewTokenCache()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithLogger(l.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+			managed.WithRecorder(recorder)))
 }
 
 type clusterConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
+	tokens *gke.TokenCache
 }
 
 func (c *clusterConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
-	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	projectID, data, err := gcp.GetCredentials(ctx, c.kube, mg)
 	if err != nil {
 		return nil, err
 	}
-	s, err := container.NewService(ctx, opts)
+	s, err := container.NewService(ctx, option.WithCredentialsJSON(data))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &clusterExternal{cluster: s, projectID: projectID, kube: c.kube}, errors.Wrap(err, errNewClient)
+	return &clusterExternal{cluster: s, projectID: projectID, kube: c.kube, record: c.record, tokens: c.tokens.TokenSource(ctx, data)}, errors.Wrap(err, errNewClient)
 }
 
 type clusterExternal struct {
 	kube      client.Client
+	record    event.Recorder
 	cluster   *container.Service
 	projectID string
+
+	// tokens returns the access token that the published kubeconfig
+	// authenticates with.
+	tokens oauth2.TokenSource
 }
 
 func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -100,12 +120,16 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalObservation{}, errors.New(errNotCluster)
 	}
 
+	// The update operation and the progress of the upgrade of the control
+	// plane are not part of the observed cluster.
+	op := cr.Status.AtProvider.Operation
+	upgrade := cr.Status.AtProvider.MasterUpgrade
+
 	existing, err := e.cluster.Projects.Locations.Clusters.Get(gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetCluster)
 	}
 
-	cr.Status.AtProvider = gke.GenerateObservation(*existing)
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	gke.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -114,6 +138,36 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		}
 	}
 
+	// Follow the update operation we started until it is done, so that
+	// the next pending update is applied as soon as it can be.
+	if gke.IsOperationActive(op) {
+		o, err := e.cluster.Projects.Locations.Operations.Get(gke.GetFullyQualifiedOperation(e.projectID, cr.Spec.ForProvider, op.Name)).Context(ctx).Do()
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetOperation)
+		}
+		op = gke.GenerateOperation(op.Update, o)
+	}
+
+	updates, err := gke.GetUpdates(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
+	}
+
+	cr.Status.AtProvider = gke.GenerateObservation(*existing)
+	cr.Status.AtProvider.Operation = op
+	cr.Status.AtProvider.MasterUpgrade = gke.GenerateMasterUpgradeStatus(&cr.Spec.ForProvider, existing, op, upgrade)
+	updates = gke.FilterMasterUpgrade(updates, cr.Status.AtProvider.MasterUpgrade)
+	cr.Status.AtProvider.PendingUpdates = gke.UpdateNames(updates)
+
+	// A refused upgrade is not a pending update, so the cluster is still
+	// reported as up to date.
+	switch u := cr.Status.AtProvider.MasterUpgrade; {
+	case u != nil && u.State == v1beta2.MasterUpgradeStateRefused:
+		cr.Status.SetConditions(v1beta2.MasterUpgradeRefused(u.Message))
+	case u != nil || cr.Status.GetCondition(v1beta2.TypeMasterUpgradeRefused).Status == corev1.ConditionTrue:
+		cr.Status.SetConditions(v1beta2.NoMasterUpgradeRefused())
+	}
+
 	switch cr.Status.AtProvider.Status {
 	case v1beta2.ClusterStateRunning, v1beta2.ClusterStateReconciling:
 		cr.Status.SetConditions(xpv1.Available())
@@ -123,15 +177,18 @@ func (e *clusterExternal) Observe(ctx context.Context, mg resource.Managed) (man
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
-	u, _, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	// The access token is replaced before it expires, so the published
+	// kubeconfig stays usable as long as the cluster is observed. Only the
+	// exec kubeconfig is published if no token can be minted.
+	token, err := e.tokens.Token()
 	if err != nil {
-		return managed.ExternalObservation{}, errors.Wrap(err, errCheckClusterUpToDate)
+		e.record.Event(cr, event.Warning(reasonGetToken, errors.Wrap(err, errGetToken)))
 	}
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  u,
-		ConnectionDetails: connectionDetails(existing),
+		ResourceUpToDate:  len(updates) == 0,
+		ConnectionDetails: connectionDetails(&cr.Spec.ForProvider, existing, token),
 	}, nil
 }
 
@@ -153,11 +210,12 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 
 	// When autopilot is enabled, node pools cannot be specified.
 	if cluster.Autopilot == nil || !cluster.Autopilot.Enabled {
-		// Insert default node pool for bootstrapping cluster. This is required
-		// to create a GKE cluster. After successful creation we delete the
-		// bootstrap node pool immediately and provision any subsequent node
-		// pools using the NodePool resource type.
-		gke.AddNodePoolForCreate(cluster)
+		// Insert node pool for bootstrapping cluster. This is required to
+		// create a GKE cluster. Unless the bootstrap node pool is configured
+		// to be kept, it is deleted immediately after successful creation
+		// and any subsequent node pools are provisioned using the NodePool
+		// resource type.
+		gke.AddNodePoolForCreate(cr.Spec.ForProvider.BootstrapNodePool, cluster)
 	}
 
 	create := &container.CreateClusterRequest{
@@ -165,7 +223,15 @@ func (e *clusterExternal) Create(ctx context.Context, mg resource.Managed) (mana
 	}
 
 	_, err := e.cluster.Projects.Locations.Clusters.Create(gke.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), create).Context(ctx).Do()
-	return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateCluster)
+	}
+	// The cluster is created with the desired master version, so an alias
+	// is not passed to GKE again by an upgrade.
+	if v := cr.Spec.ForProvider.MasterVersion; v != nil && gke.IsVersionAlias(*v) {
+		cr.Status.AtProvider.MasterUpgrade = &v1beta2.MasterUpgradeStatus{DesiredVersion: *v, State: v1beta2.MasterUpgradeStateComplete}
+	}
+	return managed.ExternalCreation{}, nil
 }
 
 func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -173,8 +239,8 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotCluster)
 	}
-	// Do not issue another update until the cluster finishes the previous one.
-	if cr.Status.AtProvider.Status == v1beta2.ClusterStateReconciling || cr.Status.AtProvider.Status == v1beta2.ClusterStateProvisioning {
+	// Do not issue another update until the previous one is done.
+	if gke.IsOperationActive(cr.Status.AtProvider.Operation) || cr.Status.AtProvider.Status == v1beta2.ClusterStateProvisioning {
 		return managed.ExternalUpdate{}, nil
 	}
 	// We have to get the cluster again here to determine how to update.
@@ -183,21 +249,28 @@ func (e *clusterExternal) Update(ctx context.Context, mg resource.Managed) (mana
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetCluster)
 	}
 
-	u, fn, err := gke.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	updates, err := gke.GetUpdates(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckClusterUpToDate)
 	}
-	if u {
+	updates = gke.FilterMasterUpgrade(updates, cr.Status.AtProvider.MasterUpgrade)
+	if len(updates) == 0 {
 		return managed.ExternalUpdate{}, nil
 	}
 
 	// GKE uses different update methods depending on the field that is being
-	// changed. gke.IsUpToDate returns the appropriate update operation based on
-	// the difference in the desired and existing spec. Only one field can be
-	// updated at a time, so if there are multiple diffs, the next one will be
-	// handled after the current one is completed.
-	_, err = fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	// changed, and only one field can be updated at a time. The first pending
+	// update is applied and its operation is tracked in the status; the next
+	// one is applied once the operation is done.
+	op, err := updates[0].Fn(ctx, e.cluster, gke.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateCluster)
+	}
+	cr.Status.AtProvider.Operation = gke.GenerateOperation(updates[0].Name, op)
+	if u := cr.Status.AtProvider.MasterUpgrade; u != nil && updates[0].Name == gke.UpdateMasterVersion {
+		u.State = v1beta2.MasterUpgradeStateInProgress
+	}
+	return managed.ExternalUpdate{}, nil
 }
 
 func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -215,24 +288,77 @@ func (e *clusterExternal) Delete(ctx context.Context, mg resource.Managed) error
 	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteCluster)
 }
 
-// connectionSecret return secret object for cluster instance
-func connectionDetails(cluster *container.Cluster) managed.ConnectionDetails {
-	config, err := gke.GenerateClientConfig(cluster)
+// connectionDetails returns the connection details of the supplied cluster.
+// The kubeconfig authenticates with the supplied access token, and is omitted
+// along with the token if there is none. Basic authentication and client
+// certificate credentials are only published for clusters that still have
+// them enabled.
+func connectionDetails(in *v1beta2.ClusterParameters, cluster *container.Cluster, token *oauth2.Token) managed.ConnectionDetails {
+	endpoint, private := gke.ConnectionEndpoints(in, cluster)
+	cd := kubeconfigs(cluster, endpoint, token,
+		xpv1.ResourceCredentialsSecretEndpointKey,
+		xpv1.ResourceCredentialsSecretKubeconfigKey,
+		v1beta2.ConnectionSecretExecKubeconfigKey)
+	if cd == nil {
+		return nil
+	}
+	if private != "" {
+		p := kubeconfigs(cluster, private, token,
+			v1beta2.ConnectionSecretPrivateEndpointKey,
+			v1beta2.ConnectionSecretPrivateKubeconfigKey,
+			v1beta2.ConnectionSecretPrivateExecKubeconfigKey)
+		for k, v := range p {
+			cd[k] = v
+		}
+	}
+	if token != nil {
+		cd[xpv1.ResourceCredentialsSecretTokenKey] = []byte(token.AccessToken)
+		if !token.Expiry.IsZero() {
+			cd[v1beta2.ConnectionSecretTokenExpiryKey] = []byte(token.Expiry.UTC().Format(time.RFC3339))
+		}
+	}
+	if u := cluster.MasterAuth.Username; u != "" {
+		cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(u)
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(cluster.MasterAuth.Password)
+	}
+	if cert, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClientCertificate); err == nil && len(cert) > 0 {
+		cd[xpv1.ResourceCredentialsSecretClientCertKey] = cert
+	}
+	if key, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClientKey); err == nil && len(key) > 0 {
+		cd[xpv1.ResourceCredentialsSecretClientKeyKey] = key
+	}
+	return cd
+}
+
+// kubeconfigs returns the server, token kubeconfig and exec kubeconfig for
+// the supplied endpoint of the supplied cluster under the supplied keys,
+// along with the cluster's CA certificate. The token kubeconfig is omitted if
+// there is no token.
+func kubeconfigs(cluster *container.Cluster, endpoint string, token *oauth2.Token, endpointKey, kubeconfigKey, execKubeconfigKey string) managed.ConnectionDetails {
+	execConfig, err := gke.GenerateExecClientConfig(cluster, endpoint)
 	if err != nil {
 		return nil
 	}
-	rawConfig, err := clientcmd.Write(config)
+	rawExecConfig, err := clientcmd.Write(execConfig)
 	if err != nil {
 		return nil
 	}
 	cd := managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(config.Clusters[cluster.Name].Server),
-		xpv1.ResourceCredentialsSecretUserKey:       []byte(config.AuthInfos[cluster.Name].Username),
-		xpv1.ResourceCredentialsSecretPasswordKey:   []byte(config.AuthInfos[cluster.Name].Password),
-		xpv1.ResourceCredentialsSecretCAKey:         config.Clusters[cluster.Name].CertificateAuthorityData,
-		xpv1.ResourceCredentialsSecretClientCertKey: config.AuthInfos[cluster.Name].ClientCertificateData,
-		xpv1.ResourceCredentialsSecretClientKeyKey:  config.AuthInfos[cluster.Name].ClientKeyData,
-		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
+		endpointKey:                         []byte(execConfig.Clusters[cluster.Name].Server),
+		execKubeconfigKey:                   rawExecConfig,
+		xpv1.ResourceCredentialsSecretCAKey: execConfig.Clusters[cluster.Name].CertificateAuthorityData,
+	}
+	if token == nil {
+		return cd
+	}
+	config, err := gke.GenerateClientConfig(cluster, endpoint, token.AccessToken)
+	if err != nil {
+		return nil
+	}
+	rawConfig, err := clientcmd.Write(config)
+	if err != nil {
+		return nil
 	}
+	cd[kubeconfigKey] = rawConfig
 	return cd
 }
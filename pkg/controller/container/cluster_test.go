@@ -24,23 +24,29 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
 	container "google.golang.org/api/container/v1"
 	"google.golang.org/api/googleapi"
 	"google.golang.org/api/option"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	gke "github.com/crossplane/provider-gcp/pkg/clients/cluster"
 )
 
@@ -53,6 +59,8 @@ const (
 
 var errBoom = errors.New("boom")
 
+var testToken = &oauth2.Token{AccessToken: "token"}
+
 var _ managed.ExternalConnecter = &clusterConnector{}
 var _ managed.ExternalClient = &clusterExternal{}
 
@@ -78,6 +86,29 @@ func withLocations(l []string) clusterModifier {
 	return func(i *v1beta2.Cluster) { i.Spec.ForProvider.Locations = l }
 }
 
+func withOperation(o *v1beta2.ClusterOperation) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Status.AtProvider.Operation = o }
+}
+
+func withPendingUpdates(u ...string) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Status.AtProvider.PendingUpdates = u }
+}
+
+func withMasterVersion(v string) clusterModifier {
+	return func(i *v1beta2.Cluster) {
+		i.Spec.ForProvider.MasterVersion = &v
+		i.Spec.ForProvider.InitialClusterVersion = &v
+	}
+}
+
+func withMasterUpgrade(u *v1beta2.MasterUpgradeStatus) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Status.AtProvider.MasterUpgrade = u }
+}
+
+func withCurrentMasterVersion(v string) clusterModifier {
+	return func(i *v1beta2.Cluster) { i.Status.AtProvider.CurrentMasterVersion = v }
+}
+
 func withUsername(u string) clusterModifier {
 	return func(i *v1beta2.Cluster) {
 		i.Spec.ForProvider.MasterAuth = &v1beta2.MasterAuth{
@@ -112,14 +143,16 @@ func TestObserve(t *testing.T) {
 		mg resource.Managed
 	}
 	type want struct {
-		mg  resource.Managed
-		obs managed.ExternalObservation
-		err error
+		mg     resource.Managed
+		obs    managed.ExternalObservation
+		err    error
+		events []event.Event
 	}
 
 	cases := map[string]struct {
 		handler http.Handler
 		kube    client.Client
+		tokens  oauth2.TokenSource
 		args    args
 		want    want
 	}{
@@ -203,13 +236,13 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: true,
-					ConnectionDetails: connectionDetails(&container.Cluster{
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{
 						Name: name,
 						MasterAuth: &container.MasterAuth{
 							Username: "admin",
 							Password: "admin",
 						},
-					}),
+					}, testToken),
 				},
 				mg: cluster(withUsername("admin"), withProviderStatus(v1beta2.ClusterStateProvisioning), withConditions(xpv1.Creating())),
 			},
@@ -233,11 +266,89 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, testToken),
 				},
 				mg: cluster(withProviderStatus(v1beta2.ClusterStateError), withConditions(xpv1.Unavailable())),
 			},
 		},
+		"OperationDone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if strings.Contains(r.URL.Path, "/operations/") {
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", Status: v1beta2.OperationStateDone})
+					return
+				}
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				c.Status = v1beta2.ClusterStateRunning
+				_ = json.NewEncoder(w).Encode(c)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: cluster(
+					withLocations([]string{"loc-1"}),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Update: gke.UpdateLocations, Status: v1beta2.OperationStateRunning}),
+				),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, testToken),
+				},
+				mg: cluster(
+					withLocations([]string{"loc-1"}),
+					withProviderStatus(v1beta2.ClusterStateRunning),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Update: gke.UpdateLocations, Status: v1beta2.OperationStateDone}),
+					withPendingUpdates(gke.UpdateLocations),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"MasterUpgradeRefused": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster(withMasterVersion("1.22")).Spec.ForProvider, c)
+				c.Status = v1beta2.ClusterStateRunning
+				c.CurrentMasterVersion = "1.20.8-gke.900"
+				_ = json.NewEncoder(w).Encode(c)
+			}),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: cluster(withMasterVersion("1.22")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, testToken),
+				},
+				mg: cluster(
+					withMasterVersion("1.22"),
+					withProviderStatus(v1beta2.ClusterStateRunning),
+					withCurrentMasterVersion("1.20.8-gke.900"),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{
+						DesiredVersion: "1.22",
+						State:          v1beta2.MasterUpgradeStateRefused,
+						Message:        "cannot upgrade the control plane from 1.20.8-gke.900 to 1.22, it can only be upgraded one minor version at a time",
+					}),
+					withConditions(
+						v1beta2.MasterUpgradeRefused("cannot upgrade the control plane from 1.20.8-gke.900 to 1.22, it can only be upgraded one minor version at a time"),
+						xpv1.Available())),
+			},
+		},
 		"RunnableUnbound": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -260,13 +371,44 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, testToken),
 				},
 				mg: cluster(
 					withProviderStatus(v1beta2.ClusterStateRunning),
 					withConditions(xpv1.Available())),
 			},
 		},
+		"TokenFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				c := &container.Cluster{}
+				gke.GenerateCluster(name, cluster().Spec.ForProvider, c)
+				c.Status = v1beta2.ClusterStateRunning
+				_ = json.NewEncoder(w).Encode(c)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			tokens: tokenSourceFn(func() (*oauth2.Token, error) { return nil, errBoom }),
+			args: args{
+				mg: cluster(),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, nil),
+				},
+				mg: cluster(
+					withProviderStatus(v1beta2.ClusterStateRunning),
+					withConditions(xpv1.Available())),
+				events: []event.Event{event.Warning(reasonGetToken, errors.Wrap(errBoom, errGetToken))},
+			},
+		},
 		"BoundUnavailable": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -292,7 +434,7 @@ func TestObserve(t *testing.T) {
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connectionDetails(&container.Cluster{}),
+					ConnectionDetails: connectionDetails(&v1beta2.ClusterParameters{}, &container.Cluster{}, testToken),
 				},
 				mg: cluster(
 					withProviderStatus(v1beta2.ClusterStateError),
@@ -306,10 +448,16 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := container.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			if tc.tokens == nil {
+				tc.tokens = oauth2.StaticTokenSource(testToken)
+			}
+			record := &eventRecorder{}
 			e := clusterExternal{
 				kube:      tc.kube,
+				record:    record,
 				projectID: projectID,
 				cluster:   s,
+				tokens:    tc.tokens,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -328,10 +476,29 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.events, record.events, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want events, +got events:\n%s", diff)
+			}
 		})
 	}
 }
 
+// eventRecorder records the events it is sent.
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+// tokenSourceFn is an oauth2.TokenSource backed by a function.
+type tokenSourceFn func() (*oauth2.Token, error)
+
+func (fn tokenSourceFn) Token() (*oauth2.Token, error) { return fn() }
+
 func TestCreate(t *testing.T) {
 	wantRandom := "i-want-random-data-not-this-special-string"
 
@@ -380,6 +547,38 @@ func TestCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulAlias": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				i := &container.Cluster{}
+				b, err := ioutil.ReadAll(r.Body)
+				if diff := cmp.Diff(err, nil); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				err = json.Unmarshal(b, i)
+				if diff := cmp.Diff(err, nil); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}),
+			args: args{
+				mg: cluster(withMasterVersion(gke.VersionDefault)),
+			},
+			want: want{
+				mg: cluster(
+					withMasterVersion(gke.VersionDefault),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{DesiredVersion: gke.VersionDefault, State: v1beta2.MasterUpgradeStateComplete}),
+					withConditions(xpv1.Creating())),
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(wantRandom),
+				}},
+				err: nil,
+			},
+		},
 		"SuccessfulSkipCreate": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
@@ -619,7 +818,7 @@ func TestUpdate(t *testing.T) {
 					_ = json.NewEncoder(w).Encode(&container.Cluster{})
 				case http.MethodPut:
 					w.WriteHeader(http.StatusOK)
-					_ = json.NewEncoder(w).Encode(&container.Operation{})
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", Status: v1beta2.OperationStateRunning})
 				default:
 					w.WriteHeader(http.StatusBadRequest)
 					_ = json.NewEncoder(w).Encode(&container.Operation{})
@@ -632,11 +831,14 @@ func TestUpdate(t *testing.T) {
 				mg: cluster(withLocations([]string{"loc-1"})),
 			},
 			want: want{
-				mg:  cluster(withLocations([]string{"loc-1"})),
+				mg: cluster(
+					withLocations([]string{"loc-1"}),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Update: gke.UpdateLocations, Status: v1beta2.OperationStateRunning}),
+				),
 				err: nil,
 			},
 		},
-		"SuccessfulSkipUpdateReconciling": {
+		"SuccessfulSkipUpdateOperationActive": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
 				switch r.Method {
@@ -661,13 +863,13 @@ func TestUpdate(t *testing.T) {
 			args: args{
 				mg: cluster(
 					withLocations([]string{"loc-1"}),
-					withProviderStatus(v1beta2.ClusterStateReconciling),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Status: v1beta2.OperationStateRunning}),
 				),
 			},
 			want: want{
 				mg: cluster(
 					withLocations([]string{"loc-1"}),
-					withProviderStatus(v1beta2.ClusterStateReconciling),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Status: v1beta2.OperationStateRunning}),
 				),
 				err: nil,
 			},
@@ -736,6 +938,76 @@ func TestUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulMasterUpgradeAlias": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					c := &container.Cluster{}
+					gke.GenerateCluster(name, cluster(withMasterVersion(gke.VersionLatest)).Spec.ForProvider, c)
+					c.CurrentMasterVersion = "1.20.8-gke.900"
+					_ = json.NewEncoder(w).Encode(c)
+				case http.MethodPost:
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Operation{Name: "op", Status: v1beta2.OperationStateRunning})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&container.Operation{})
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: cluster(
+					withMasterVersion(gke.VersionLatest),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{DesiredVersion: gke.VersionLatest, State: v1beta2.MasterUpgradeStatePending}),
+				),
+			},
+			want: want{
+				mg: cluster(
+					withMasterVersion(gke.VersionLatest),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{DesiredVersion: gke.VersionLatest, State: v1beta2.MasterUpgradeStateInProgress}),
+					withOperation(&v1beta2.ClusterOperation{Name: "op", Update: gke.UpdateMasterVersion, Status: v1beta2.OperationStateRunning}),
+				),
+				err: nil,
+			},
+		},
+		"SuccessfulSkipMasterUpgradeAliasComplete": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					c := &container.Cluster{}
+					gke.GenerateCluster(name, cluster(withMasterVersion(gke.VersionLatest)).Spec.ForProvider, c)
+					c.CurrentMasterVersion = "1.21.5-gke.1302"
+					_ = json.NewEncoder(w).Encode(c)
+				default:
+					// Return bad request for any update to demonstrate
+					// that the completed upgrade is not applied again.
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&container.Operation{})
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: cluster(
+					withMasterVersion(gke.VersionLatest),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{DesiredVersion: gke.VersionLatest, State: v1beta2.MasterUpgradeStateComplete}),
+				),
+			},
+			want: want{
+				mg: cluster(
+					withMasterVersion(gke.VersionLatest),
+					withMasterUpgrade(&v1beta2.MasterUpgradeStatus{DesiredVersion: gke.VersionLatest, State: v1beta2.MasterUpgradeStateComplete}),
+				),
+				err: nil,
+			},
+		},
 		"GetFails": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -836,6 +1108,8 @@ func TestConnectionDetails(t *testing.T) {
 	clientCert, _ := base64.StdEncoding.DecodeString("clientCert")
 	clientKey, _ := base64.StdEncoding.DecodeString("clientKey")
 	server := fmt.Sprintf("https://%s", endpoint)
+	privateServer := "https://10.0.0.2"
+	token := &oauth2.Token{AccessToken: "token", Expiry: time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)}
 	rawConfig :=
 		`apiVersion: v1
 clusters:
@@ -854,15 +1128,43 @@ preferences: {}
 users:
 - name: gke-cluster
   user:
-    client-certificate-data: clientCe
-    client-key-data: clientKe
-    password: password
-    username: username
+    token: token
+`
+	rawExecConfig :=
+		`apiVersion: v1
+clusters:
+- cluster:
+    certificate-authority-data: clusterC
+    server: https://endpoint
+  name: gke-cluster
+contexts:
+- context:
+    cluster: gke-cluster
+    user: gke-cluster
+  name: gke-cluster
+current-context: gke-cluster
+kind: Config
+preferences: {}
+users:
+- name: gke-cluster
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      args: null
+      command: gke-gcloud-auth-plugin
+      env: null
+      installHint: ` + gke.AuthPluginInstallHint + `
+      provideClusterInfo: true
 `
 
+	rawPrivateConfig := strings.ReplaceAll(rawConfig, server, privateServer)
+	rawPrivateExecConfig := strings.ReplaceAll(rawExecConfig, server, privateServer)
+
 	cases := map[string]struct {
-		args *container.Cluster
-		want managed.ConnectionDetails
+		params  *v1beta2.ClusterParameters
+		args    *container.Cluster
+		noToken bool
+		want    managed.ConnectionDetails
 	}{
 		"Full": {
 			args: &container.Cluster{
@@ -883,7 +1185,83 @@ users:
 				xpv1.ResourceCredentialsSecretCAKey:         clusterCA,
 				xpv1.ResourceCredentialsSecretClientCertKey: clientCert,
 				xpv1.ResourceCredentialsSecretClientKeyKey:  clientKey,
+				xpv1.ResourceCredentialsSecretTokenKey:      []byte("token"),
 				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
+				v1beta2.ConnectionSecretExecKubeconfigKey:   []byte(rawExecConfig),
+				v1beta2.ConnectionSecretTokenExpiryKey:      []byte("2021-06-01T12:00:00Z"),
+			},
+		},
+		"TokenOnly": {
+			args: &container.Cluster{
+				Name:     name,
+				Endpoint: endpoint,
+				MasterAuth: &container.MasterAuth{
+					ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+				},
+			},
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey:   []byte(server),
+				xpv1.ResourceCredentialsSecretCAKey:         clusterCA,
+				xpv1.ResourceCredentialsSecretTokenKey:      []byte("token"),
+				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawConfig),
+				v1beta2.ConnectionSecretExecKubeconfigKey:   []byte(rawExecConfig),
+				v1beta2.ConnectionSecretTokenExpiryKey:      []byte("2021-06-01T12:00:00Z"),
+			},
+		},
+		"NoToken": {
+			args: &container.Cluster{
+				Name:     name,
+				Endpoint: endpoint,
+				MasterAuth: &container.MasterAuth{
+					ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+				},
+			},
+			noToken: true,
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey: []byte(server),
+				xpv1.ResourceCredentialsSecretCAKey:       clusterCA,
+				v1beta2.ConnectionSecretExecKubeconfigKey: []byte(rawExecConfig),
+			},
+		},
+		"PrivateEndpoint": {
+			params: &v1beta2.ClusterParameters{ConnectionEndpoint: gcp.StringPtr(v1beta2.ConnectionEndpointPrivate)},
+			args: &container.Cluster{
+				Name:                 name,
+				Endpoint:             endpoint,
+				PrivateClusterConfig: &container.PrivateClusterConfig{PrivateEndpoint: "10.0.0.2"},
+				MasterAuth: &container.MasterAuth{
+					ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+				},
+			},
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey:   []byte(privateServer),
+				xpv1.ResourceCredentialsSecretCAKey:         clusterCA,
+				xpv1.ResourceCredentialsSecretTokenKey:      []byte("token"),
+				xpv1.ResourceCredentialsSecretKubeconfigKey: []byte(rawPrivateConfig),
+				v1beta2.ConnectionSecretExecKubeconfigKey:   []byte(rawPrivateExecConfig),
+				v1beta2.ConnectionSecretTokenExpiryKey:      []byte("2021-06-01T12:00:00Z"),
+			},
+		},
+		"BothEndpoints": {
+			params: &v1beta2.ClusterParameters{ConnectionEndpoint: gcp.StringPtr(v1beta2.ConnectionEndpointBoth)},
+			args: &container.Cluster{
+				Name:                 name,
+				Endpoint:             endpoint,
+				PrivateClusterConfig: &container.PrivateClusterConfig{PrivateEndpoint: "10.0.0.2"},
+				MasterAuth: &container.MasterAuth{
+					ClusterCaCertificate: base64.StdEncoding.EncodeToString(clusterCA),
+				},
+			},
+			want: map[string][]byte{
+				xpv1.ResourceCredentialsSecretEndpointKey:        []byte(server),
+				xpv1.ResourceCredentialsSecretCAKey:              clusterCA,
+				xpv1.ResourceCredentialsSecretTokenKey:           []byte("token"),
+				xpv1.ResourceCredentialsSecretKubeconfigKey:      []byte(rawConfig),
+				v1beta2.ConnectionSecretExecKubeconfigKey:        []byte(rawExecConfig),
+				v1beta2.ConnectionSecretPrivateEndpointKey:       []byte(privateServer),
+				v1beta2.ConnectionSecretPrivateKubeconfigKey:     []byte(rawPrivateConfig),
+				v1beta2.ConnectionSecretPrivateExecKubeconfigKey: []byte(rawPrivateExecConfig),
+				v1beta2.ConnectionSecretTokenExpiryKey:           []byte("2021-06-01T12:00:00Z"),
 			},
 		},
 		"Empty": {
@@ -894,7 +1272,14 @@ users:
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			d := connectionDetails(tc.args)
+			if tc.params == nil {
+				tc.params = &v1beta2.ClusterParameters{}
+			}
+			tok := token
+			if tc.noToken {
+				tok = nil
+			}
+			d := connectionDetails(tc.params, tc.args, tok)
 			if diff := cmp.Diff(tc.want, d); diff != "" {
 				t.Errorf("connectionDetails(...): -want, +got:\n%s", diff)
 			}
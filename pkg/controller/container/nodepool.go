@@ -103,7 +103,9 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetNodePool)
 	}
 
+	target := cr.Status.AtProvider.UpgradeActionTarget
 	cr.Status.AtProvider = np.GenerateObservation(*existing)
+	cr.Status.AtProvider.UpgradeActionTarget = np.UpgradeActionTarget(cr.Spec.ForProvider.UpgradeAction, target, cr.Status.AtProvider.UpdateInfo)
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
 	np.LateInitializeSpec(&cr.Spec.ForProvider, *existing)
 	if !cmp.Equal(currentSpec, &cr.Spec.ForProvider) {
@@ -121,14 +123,26 @@ func (e *nodePoolExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
-	u, _, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	params, err := e.desiredParameters(ctx, cr, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	u, _, err := np.IsUpToDate(meta.GetExternalName(cr), params, existing)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckNodePoolUpToDate)
 	}
+	fields, err := np.ReplacementRequired(meta.GetExternalName(cr), params, existing)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckNodePoolUpToDate)
+	}
+	cr.Status.SetConditions(v1beta1.NoReplacementRequired())
+	if len(fields) > 0 {
+		cr.Status.SetConditions(v1beta1.ReplacementRequired(fields...))
+	}
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: u,
+		ResourceUpToDate: u && !np.UpgradeActionPending(cr.Spec.ForProvider.UpgradeAction, cr.Status.AtProvider.UpgradeActionTarget, cr.Status.AtProvider.UpdateInfo),
 	}, nil
 }
 
@@ -161,8 +175,12 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if !ok {
 		return managed.ExternalUpdate{}, errors.New(errNotNodePool)
 	}
-	// Do not issue another update until the node pool finishes the previous
-	// one.
+	// Complete or roll back the ongoing upgrade if requested. Do not issue
+	// any other update until the node pool finishes the previous one.
+	if np.UpgradeActionPending(cr.Spec.ForProvider.UpgradeAction, cr.Status.AtProvider.UpgradeActionTarget, cr.Status.AtProvider.UpdateInfo) {
+		_, err := np.NewUpgradeActionFn(*cr.Spec.ForProvider.UpgradeAction)(ctx, e.container, np.GetFullyQualifiedName(cr.Spec.ForProvider, meta.GetExternalName(cr)))
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNodePool)
+	}
 	if cr.Status.AtProvider.Status == v1beta1.NodePoolStateReconciling || cr.Status.AtProvider.Status == v1beta1.NodePoolStateProvisioning {
 		return managed.ExternalUpdate{}, nil
 	}
@@ -173,7 +191,11 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalUpdate{}, errors.Wrap(err, errGetNodePool)
 	}
 
-	u, fn, err := np.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, existing)
+	params, err := e.desiredParameters(ctx, cr, existing)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	u, fn, err := np.IsUpToDate(meta.GetExternalName(cr), params, existing)
 	if err != nil {
 		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckNodePoolUpToDate)
 	}
@@ -190,6 +212,22 @@ func (e *nodePoolExternal) Update(ctx context.Context, mg resource.Managed) (man
 	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateNodePool)
 }
 
+// desiredParameters returns the parameters the existing node pool is compared
+// against, which track the version of the control plane of the cluster if
+// the node pool is configured to do so.
+func (e *nodePoolExternal) desiredParameters(ctx context.Context, cr *v1beta1.NodePool, existing *container.NodePool) (*v1beta1.NodePoolParameters, error) {
+	if !gcp.BoolValue(cr.Spec.ForProvider.TrackMasterVersion) {
+		return &cr.Spec.ForProvider, nil
+	}
+	c, err := e.container.Projects.Locations.Clusters.Get(np.GetFullyQualifiedClusterName(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCluster)
+	}
+	p := cr.Spec.ForProvider.DeepCopy()
+	np.TrackMasterVersion(p, c, existing)
+	return p, nil
+}
+
 func (e *nodePoolExternal) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.NodePool)
 	if !ok {
@@ -22,6 +22,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -38,9 +39,13 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/container/v1beta1"
+	"github.com/crossplane/provider-gcp/apis/container/v1beta2"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	np "github.com/crossplane/provider-gcp/pkg/clients/nodepool"
 )
 
+const trackedCluster = "projects/cool-project/locations/us-central1/clusters/cool-cluster"
+
 type nodePoolModifier func(*v1beta1.NodePool)
 
 func npWithConditions(c ...xpv1.Condition) nodePoolModifier {
@@ -55,6 +60,38 @@ func npWithLocations(l []string) nodePoolModifier {
 	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.Locations = l }
 }
 
+func npWithUpgradeAction(a string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Spec.ForProvider.UpgradeAction = &a }
+}
+
+const blueGroup = "blue-group"
+
+func npWithUpdateInfo(phase string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) {
+		i.Status.AtProvider.UpdateInfo = &v1beta1.UpdateInfo{
+			BlueGreenInfo: &v1beta1.BlueGreenInfo{Phase: phase, BlueInstanceGroupUrls: []string{blueGroup}},
+		}
+	}
+}
+
+func npWithUpgradeActionTarget(target ...string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) { i.Status.AtProvider.UpgradeActionTarget = target }
+}
+
+func npWithMachineType(t string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) {
+		i.Spec.ForProvider.Config = &v1beta1.NodeConfig{MachineType: &t}
+	}
+}
+
+func npWithTrackMasterVersion(cluster, version string) nodePoolModifier {
+	return func(i *v1beta1.NodePool) {
+		i.Spec.ForProvider.Cluster = cluster
+		i.Spec.ForProvider.TrackMasterVersion = gcp.BoolPtr(true)
+		i.Spec.ForProvider.Version = &version
+	}
+}
+
 func nodePool(im ...nodePoolModifier) *v1beta1.NodePool {
 	i := &v1beta1.NodePool{
 		ObjectMeta: metav1.ObjectMeta{
@@ -173,7 +210,7 @@ func TestNodePoolObserve(t *testing.T) {
 					ResourceExists:   true,
 					ResourceUpToDate: true,
 				},
-				mg: nodePool(npWithProviderStatus(v1beta1.NodePoolStateProvisioning), npWithConditions(xpv1.Creating())),
+				mg: nodePool(npWithProviderStatus(v1beta1.NodePoolStateProvisioning), npWithConditions(xpv1.Creating(), v1beta1.NoReplacementRequired())),
 			},
 		},
 		"Unavailable": {
@@ -196,7 +233,7 @@ func TestNodePoolObserve(t *testing.T) {
 					ResourceExists:   true,
 					ResourceUpToDate: true,
 				},
-				mg: nodePool(npWithProviderStatus(v1beta1.NodePoolStateError), npWithConditions(xpv1.Unavailable())),
+				mg: nodePool(npWithProviderStatus(v1beta1.NodePoolStateError), npWithConditions(xpv1.Unavailable(), v1beta1.NoReplacementRequired())),
 			},
 		},
 		"RunnableUnbound": {
@@ -224,7 +261,84 @@ func TestNodePoolObserve(t *testing.T) {
 				},
 				mg: nodePool(
 					npWithProviderStatus(v1beta1.NodePoolStateRunning),
-					npWithConditions(xpv1.Available())),
+					npWithConditions(xpv1.Available(), v1beta1.NoReplacementRequired())),
+			},
+		},
+		"UpgradeActionTargetRecorded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				n := &container.NodePool{}
+				np.GenerateNodePool(name, nodePool().Spec.ForProvider, n)
+				n.Status = v1beta1.NodePoolStateReconciling
+				n.UpdateInfo = &container.UpdateInfo{
+					BlueGreenInfo: &container.BlueGreenInfo{
+						Phase:                 v1beta1.BlueGreenPhaseNodePoolSoaking,
+						BlueInstanceGroupUrls: []string{blueGroup},
+					},
+				}
+				_ = json.NewEncoder(w).Encode(n)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(npWithUpgradeAction(v1beta1.UpgradeActionComplete)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget(blueGroup),
+					npWithConditions(xpv1.Available(), v1beta1.NoReplacementRequired())),
+			},
+		},
+		"UpgradeActionIgnoredForLaterUpgrade": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				n := &container.NodePool{}
+				np.GenerateNodePool(name, nodePool().Spec.ForProvider, n)
+				n.Status = v1beta1.NodePoolStateReconciling
+				n.UpdateInfo = &container.UpdateInfo{
+					BlueGreenInfo: &container.BlueGreenInfo{
+						Phase:                 v1beta1.BlueGreenPhaseNodePoolSoaking,
+						BlueInstanceGroupUrls: []string{blueGroup},
+					},
+				}
+				_ = json.NewEncoder(w).Encode(n)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithUpgradeActionTarget("old-group"),
+				),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget("old-group"),
+					npWithConditions(xpv1.Available(), v1beta1.NoReplacementRequired())),
 			},
 		},
 		"BoundUnavailable": {
@@ -255,7 +369,72 @@ func TestNodePoolObserve(t *testing.T) {
 				},
 				mg: nodePool(
 					npWithProviderStatus(v1beta1.NodePoolStateError),
-					npWithConditions(xpv1.Unavailable())),
+					npWithConditions(xpv1.Unavailable(), v1beta1.NoReplacementRequired())),
+			},
+		},
+		"ReplacementRequired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				n := &container.NodePool{}
+				np.GenerateNodePool(name, nodePool(npWithMachineType("n1-standard-1")).Spec.ForProvider, n)
+				n.Status = v1beta1.NodePoolStateRunning
+				_ = json.NewEncoder(w).Encode(n)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(npWithMachineType("e2-standard-4")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				mg: nodePool(
+					npWithMachineType("e2-standard-4"),
+					npWithProviderStatus(v1beta1.NodePoolStateRunning),
+					npWithConditions(xpv1.Available(), v1beta1.ReplacementRequired("config.machineType"))),
+			},
+		},
+		"TrackMasterVersionNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				if !strings.Contains(r.URL.Path, "/nodePools/") {
+					_ = json.NewEncoder(w).Encode(&container.Cluster{
+						Status:               v1beta2.ClusterStateRunning,
+						CurrentMasterVersion: "1.21.1-gke.2200",
+					})
+					return
+				}
+				n := &container.NodePool{}
+				np.GenerateNodePool(name, nodePool(npWithTrackMasterVersion(trackedCluster, "1.20.8-gke.900")).Spec.ForProvider, n)
+				n.Status = v1beta1.NodePoolStateRunning
+				_ = json.NewEncoder(w).Encode(n)
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(npWithTrackMasterVersion(trackedCluster, "1.20.8-gke.900")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				mg: nodePool(
+					npWithTrackMasterVersion(trackedCluster, "1.20.8-gke.900"),
+					npWithProviderStatus(v1beta1.NodePoolStateRunning),
+					npWithConditions(xpv1.Available(), v1beta1.NoReplacementRequired())),
 			},
 		},
 	}
@@ -627,6 +806,80 @@ func TestNodePoolUpdate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SuccessfulCompleteUpgradeWhileReconciling": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					w.WriteHeader(http.StatusOK)
+					n := &container.NodePool{}
+					np.GenerateNodePool(name, nodePool().Spec.ForProvider, n)
+					n.Status = v1beta1.NodePoolStateReconciling
+					n.UpdateInfo = &container.UpdateInfo{
+						BlueGreenInfo: &container.BlueGreenInfo{Phase: v1beta1.BlueGreenPhaseNodePoolSoaking},
+					}
+					_ = json.NewEncoder(w).Encode(n)
+				case http.MethodPost:
+					if diff := cmp.Diff(true, strings.HasSuffix(r.URL.Path, ":completeUpgrade")); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					w.WriteHeader(http.StatusOK)
+					_ = json.NewEncoder(w).Encode(&container.Empty{})
+				default:
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&container.Operation{})
+				}
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget(blueGroup),
+				),
+			},
+			want: want{
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget(blueGroup),
+				),
+				err: nil,
+			},
+		},
+		"SuccessfulSkipUpgradeActionForLaterUpgrade": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				// Return bad request for any call to demonstrate that no
+				// http call is made.
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&container.Operation{})
+			}),
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			args: args{
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget("old-group"),
+				),
+			},
+			want: want{
+				mg: nodePool(
+					npWithUpgradeAction(v1beta1.UpgradeActionComplete),
+					npWithProviderStatus(v1beta1.NodePoolStateReconciling),
+					npWithUpdateInfo(v1beta1.BlueGreenPhaseNodePoolSoaking),
+					npWithUpgradeActionTarget("old-group"),
+				),
+				err: nil,
+			},
+		},
 		"SuccessfulSkipWhileProvisioning": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -18,10 +18,13 @@ package database
 
 import (
 	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	monitoring "google.golang.org/api/monitoring/v3"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
 	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -47,27 +50,55 @@ const (
 	errManagedUpdateFailed = "cannot update CloudSQLInstance custom resource"
 
 	errNewClient        = "cannot create new Sqladmin Service"
+	errNewMetricsClient = "cannot create new Monitoring Service"
 	errCreateFailed     = "cannot create new CloudSQL instance"
 	errNameInUse        = "cannot create new CloudSQL instance, resource name is unavailable because it is in use or was used recently"
 	errDeleteFailed     = "cannot delete the CloudSQL instance"
 	errUpdateFailed     = "cannot update the CloudSQL instance"
 	errGetFailed        = "cannot get the CloudSQL instance"
 	errGeneratePassword = "cannot generate root password"
+	errGetPassword      = "cannot get root password"
+	errUpdatePassword   = "cannot update root password"
+	errListFlags        = "cannot list CloudSQL database flags"
 	errCheckUpToDate    = "cannot determine if CloudSQL instance is up to date"
+	errPromoteReplica   = "cannot promote the CloudSQL read replica"
+	errCloneFailed      = "cannot clone the CloudSQL instance"
+	errRestoreBackupRun = "cannot restore the CloudSQL backup run"
+	errGetRestore       = "cannot get the CloudSQL restore operation"
+	errGetReplicaLag    = "cannot get the replication lag of the CloudSQL read replica"
+	errFinalBackup      = "cannot take the final backup of the CloudSQL instance"
+	errGetFinalBackup   = "cannot get the final backup operation of the CloudSQL instance"
+
+	errFinalBackupFailed   = "the final backup of the CloudSQL instance failed and is taken again, remove finalBackup to delete the instance without one"
+	errFinalBackupNoBucket = "the final backup of the CloudSQL instance needs finalBackup.bucket, because Cloud SQL deletes backup runs along with the instance; set it or remove finalBackup"
+
+	errRotateSuppliedPassword = "the root password is not rotated because it is read from rootPasswordSecretRef, change the secret instead"
 )
 
+// Event reasons.
+const (
+	reasonFinalBackup        event.Reason = "TookFinalBackup"
+	reasonRotateRootPassword event.Reason = "CannotRotateRootPassword"
+	reasonReplicaLag         event.Reason = "CannotGetReplicaLag"
+)
+
+// replicaLagPeriod is how far back the replication lag of a read replica is
+// looked up. The lag is sampled every minute.
+const replicaLagPeriod = 5 * time.Minute
+
 // SetupCloudSQLInstance adds a controller that reconciles
 // CloudSQLInstance managed resources.
 func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	name := managed.ControllerName(v1beta1.CloudSQLInstanceGroupKind)
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1beta1.CloudSQLInstanceGroupVersionKind),
-		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient()}),
+		managed.WithExternalConnecter(&cloudsqlConnector{kube: mgr.GetClient(), record: recorder}),
 		managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &cloudsqlTagger{kube: mgr.GetClient()}),
 		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 		managed.WithLogger(l.WithValues("controller", name)),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+		managed.WithRecorder(recorder))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
@@ -79,7 +110,8 @@ func SetupCloudSQLInstance(mgr ctrl.Manager, l logging.Logger, rl workqueue.Rate
 }
 
 type cloudsqlConnector struct {
-	kube client.Client
+	kube   client.Client
+	record event.Recorder
 }
 
 func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -91,13 +123,22 @@ func (c *cloudsqlConnector) Connect(ctx context.Context, mg resource.Managed) (m
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
-	return &cloudsqlExternal{kube: c.kube, db: s.Instances, projectID: projectID}, nil
+	m, err := monitoring.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewMetricsClient)
+	}
+	return &cloudsqlExternal{kube: c.kube, record: c.record, db: s.Instances, users: s.Users, flags: s.Flags, operations: s.Operations, metrics: m.Projects.TimeSeries, projectID: projectID}, nil
 }
 
 type cloudsqlExternal struct {
-	kube      client.Client
-	db        *sqladmin.InstancesService
-	projectID string
+	kube       client.Client
+	record     event.Recorder
+	db         *sqladmin.InstancesService
+	users      *sqladmin.UsersService
+	flags      *sqladmin.FlagsService
+	operations *sqladmin.OperationsService
+	metrics    *monitoring.ProjectsTimeSeriesService
+	projectID  string
 }
 
 func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -119,6 +160,23 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		}
 	}
 	cr.Status.AtProvider = cloudsql.GenerateObservation(*instance)
+	if cr.Status.AtProvider.Replication != nil {
+		// The replication lag is informational only, so the instance is still
+		// reconciled if Cloud Monitoring is unavailable or we lack the
+		// monitoring.viewer role.
+		lag, err := c.getReplicaLag(ctx, meta.GetExternalName(cr))
+		if err != nil {
+			c.record.Event(cr, event.Warning(reasonReplicaLag, errors.Wrap(err, errGetReplicaLag)))
+		}
+		cr.Status.AtProvider.Replication.LagSeconds = lag
+	}
+	var restore *sqladmin.Operation
+	if op := cr.GetAnnotations()[v1beta1.AnnotationKeyRestoreOperation]; op != "" {
+		if restore, err = c.operations.Get(c.projectID, op).Context(ctx).Do(); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGetRestore)
+		}
+	}
+	cr.Status.AtProvider.Source = cloudsql.GenerateSourceStatus(cr.Spec.ForProvider, restore)
 	switch cr.Status.AtProvider.State {
 	case v1beta1.StateRunnable:
 		cr.Status.SetConditions(xpv1.Available())
@@ -128,13 +186,28 @@ func (c *cloudsqlExternal) Observe(ctx context.Context, mg resource.Managed) (ma
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
 
-	upToDate, err := cloudsql.IsUpToDate(meta.GetExternalName(cr), &cr.Spec.ForProvider, instance)
+	desired, changes, err := c.getChanges(ctx, cr, instance)
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
 	}
+	apply, pending := cloudsql.FilterChanges(cr.Spec.ForProvider.RestartPolicy, desired.Settings.MaintenanceWindow, changes, time.Now())
+	cr.Status.AtProvider.PendingRestartChanges = pending
+	_, changed, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.RootPasswordSecretRef, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+	// A supplied root password is never rotated, so the rotation request is
+	// removed and reported once rather than on every poll.
+	if _, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyRotateRootPassword]; ok && cr.Spec.ForProvider.RootPasswordSecretRef != nil {
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyRotateRootPassword)
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errManagedUpdateFailed)
+		}
+		c.record.Event(cr, event.Warning(reasonRotateRootPassword, errors.New(errRotateSuppliedPassword)))
+	}
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  upToDate,
+		ResourceUpToDate:  len(apply) == 0 && !changed && !rotateRootPassword(cr) && !cloudsql.NeedsPromotion(cr.Spec.ForProvider, *instance) && !needsRestore(cr, instance),
 		ConnectionDetails: getConnectionDetails(cr, instance),
 	}, nil
 }
@@ -145,11 +218,29 @@ func (c *cloudsqlExternal) Create(ctx context.Context, mg resource.Managed) (man
 		return managed.ExternalCreation{}, errors.New(errNotCloudSQL)
 	}
 	cr.SetConditions(xpv1.Creating())
+	// A clone keeps the users of its source instance, so we have no root
+	// password to publish unless one is supplied, in which case it is set by
+	// a subsequent update.
+	if cloudsql.IsClone(cr.Spec.ForProvider) {
+		req := cloudsql.GenerateCloneRequest(meta.GetExternalName(cr), *cr.Spec.ForProvider.Source)
+		if _, err := c.db.Clone(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Source.Instance), req).Context(ctx).Do(); err != nil {
+			if gcp.IsErrorAlreadyExists(err) {
+				return managed.ExternalCreation{}, errors.Wrap(err, errNameInUse)
+			}
+			return managed.ExternalCreation{}, errors.Wrap(err, errCloneFailed)
+		}
+		return managed.ExternalCreation{}, nil
+	}
 	instance := &sqladmin.DatabaseInstance{}
 	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
-	pw, err := password.Generate()
+	pw, _, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.RootPasswordSecretRef, nil)
 	if err != nil {
-		return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	if pw == "" {
+		if pw, err = password.Generate(); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
+		}
 	}
 
 	instance.RootPassword = pw
@@ -176,12 +267,144 @@ func (c *cloudsqlExternal) Update(ctx context.Context, mg resource.Managed) (man
 	if cr.Status.AtProvider.State == v1beta1.StateCreating {
 		return managed.ExternalUpdate{}, nil
 	}
-	instance := &sqladmin.DatabaseInstance{}
-	cloudsql.GenerateDatabaseInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, instance)
-	// TODO(muvaf): the returned operation handle could help us not to send Patch
-	// request aggressively.
-	_, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), instance).Context(ctx).Do()
-	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+	instance, err := c.db.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetFailed)
+	}
+	// The instance is unavailable while it is being promoted, so any other
+	// changes are applied once the promotion has completed.
+	if cloudsql.NeedsPromotion(cr.Spec.ForProvider, *instance) {
+		_, err := c.db.PromoteReplica(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+		return managed.ExternalUpdate{}, errors.Wrap(err, errPromoteReplica)
+	}
+	if needsRestore(cr, instance) {
+		return managed.ExternalUpdate{}, errors.Wrap(c.restore(ctx, cr), errRestoreBackupRun)
+	}
+	desired, changes, err := c.getChanges(ctx, cr, instance)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	// Only the changed fields are sent so that fields that are not managed
+	// by us, or changes that are withheld, are left alone.
+	apply, _ := cloudsql.FilterChanges(cr.Spec.ForProvider.RestartPolicy, desired.Settings.MaintenanceWindow, changes, time.Now())
+	if len(apply) > 0 {
+		// TODO(muvaf): the returned operation handle could help us not to send Patch
+		// request aggressively.
+		if _, err := c.db.Patch(c.projectID, meta.GetExternalName(cr), cloudsql.GeneratePatch(desired, apply)).Context(ctx).Do(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateFailed)
+		}
+	}
+
+	pw, changed, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.RootPasswordSecretRef, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	rotate := rotateRootPassword(cr)
+	if rotate {
+		if pw, err = password.Generate(); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errGeneratePassword)
+		}
+	}
+	if !changed && !rotate {
+		return managed.ExternalUpdate{}, nil
+	}
+	user := &sqladmin.User{
+		Name:     cloudsql.DatabaseUserName(cr.Spec.ForProvider),
+		Host:     cloudsql.DatabaseUserHost(cr.Spec.ForProvider),
+		Password: pw,
+	}
+	call := c.users.Update(c.projectID, meta.GetExternalName(cr), user).Name(user.Name)
+	if user.Host != "" {
+		call = call.Host(user.Host)
+	}
+	if _, err := call.Context(ctx).Do(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdatePassword)
+	}
+	// The rotation request is only removed once the password has been
+	// rotated, so that it is retried if updating the user fails. A supplied
+	// password is recorded so that it is not applied again.
+	if rotate {
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyRotateRootPassword)
+	} else {
+		cloudsql.SetPasswordHash(cr, pw)
+	}
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	// The new password is published together with the rest of the connection
+	// details once the update has succeeded.
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}}, nil
+}
+
+// getChanges returns the desired state of the supplied observed instance and
+// the changes that are needed to reach it.
+func (c *cloudsqlExternal) getChanges(ctx context.Context, cr *v1beta1.CloudSQLInstance, observed *sqladmin.DatabaseInstance) (*sqladmin.DatabaseInstance, []cloudsql.Change, error) {
+	desired, err := cloudsql.GenerateDesiredInstance(meta.GetExternalName(cr), cr.Spec.ForProvider, observed)
+	if err != nil {
+		return nil, nil, err
+	}
+	var restartFlags map[string]bool
+	if len(cloudsql.ChangedFlags(desired, observed)) > 0 {
+		rsp, err := c.flags.List().DatabaseVersion(observed.DatabaseVersion).Context(ctx).Do()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, errListFlags)
+		}
+		restartFlags = map[string]bool{}
+		for _, f := range rsp.Items {
+			restartFlags[f.Name] = f.RequiresRestart
+		}
+	}
+	return desired, cloudsql.GetChanges(desired, observed, restartFlags), nil
+}
+
+// getReplicaLag returns the most recently reported replication lag of the
+// read replica with the supplied name, if any.
+func (c *cloudsqlExternal) getReplicaLag(ctx context.Context, name string) (*int64, error) {
+	now := time.Now()
+	rsp, err := c.metrics.List("projects/" + c.projectID).
+		Filter(cloudsql.ReplicaLagFilter(c.projectID, name)).
+		IntervalStartTime(now.Add(-replicaLagPeriod).Format(time.RFC3339)).
+		IntervalEndTime(now.Format(time.RFC3339)).
+		Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return cloudsql.GetReplicaLag(rsp), nil
+}
+
+// restore restores the backup run of the supplied instance and records the
+// restore operation. The backup run is restored again if the operation cannot
+// be recorded.
+func (c *cloudsqlExternal) restore(ctx context.Context, cr *v1beta1.CloudSQLInstance) error {
+	req, err := cloudsql.GenerateRestoreRequest(c.projectID, *cr.Spec.ForProvider.Source)
+	if err != nil {
+		return err
+	}
+	op, err := c.db.RestoreBackup(c.projectID, meta.GetExternalName(cr), req).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyRestoreOperation: op.Name})
+	return errors.Wrap(c.kube.Update(ctx, cr), errManagedUpdateFailed)
+}
+
+// needsRestore returns true if the supplied instance should be restored from
+// its backup run. Backup runs can only be restored to a running instance.
+func needsRestore(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) bool {
+	_, restored := cr.GetAnnotations()[v1beta1.AnnotationKeyRestoreOperation]
+	return cloudsql.IsRestore(cr.Spec.ForProvider) && !restored && instance.State == v1beta1.StateRunnable
+}
+
+// rotateRootPassword returns true if a new root password should be generated
+// for the supplied instance.
+func rotateRootPassword(cr *v1beta1.CloudSQLInstance) bool {
+	if cr.Spec.ForProvider.RootPasswordSecretRef != nil {
+		return false
+	}
+	_, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyRotateRootPassword]
+	return ok
 }
 
 func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) error {
@@ -190,6 +413,12 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 		return errors.New(errNotCloudSQL)
 	}
 	cr.SetConditions(xpv1.Deleting())
+	if cr.Spec.ForProvider.FinalBackup != nil {
+		done, err := c.finalBackup(ctx, cr)
+		if err != nil || !done {
+			return err
+		}
+	}
 	_, err := c.db.Delete(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
 	if gcp.IsErrorNotFound(err) {
 		return nil
@@ -197,28 +426,117 @@ func (c *cloudsqlExternal) Delete(ctx context.Context, mg resource.Managed) erro
 	return errors.Wrap(err, errDeleteFailed)
 }
 
+// finalBackup takes the final backup of the supplied instance and returns true
+// once it has completed. The backup is taken again if its operation cannot be
+// recorded, or if it fails.
+func (c *cloudsqlExternal) finalBackup(ctx context.Context, cr *v1beta1.CloudSQLInstance) (bool, error) {
+	name, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyFinalBackupOperation]
+	if !ok {
+		if cr.Spec.ForProvider.FinalBackup.Bucket == nil {
+			return false, errors.New(errFinalBackupNoBucket)
+		}
+		op, err := c.startFinalBackup(ctx, cr)
+		if gcp.IsErrorNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, errFinalBackup)
+		}
+		meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyFinalBackupOperation: op.Name})
+		return false, errors.Wrap(c.kube.Update(ctx, cr), errManagedUpdateFailed)
+	}
+	op, err := c.operations.Get(c.projectID, name).Context(ctx).Do()
+	if err != nil {
+		return false, errors.Wrap(err, errGetFinalBackup)
+	}
+	if op.Status != cloudsql.OperationDone {
+		return false, nil
+	}
+	if msg := cloudsql.OperationError(op); msg != "" {
+		// The failed backup is forgotten so that a new one is taken the next
+		// time we try to delete the instance.
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyFinalBackupOperation)
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return false, errors.Wrap(err, errManagedUpdateFailed)
+		}
+		return false, errors.Wrap(errors.New(msg), errFinalBackupFailed)
+	}
+	c.record.Event(cr, event.Normal(reasonFinalBackup, fmt.Sprintf("Took final backup %s", cloudsql.FinalBackupName(op))))
+	return true, nil
+}
+
+// startFinalBackup exports the supplied instance to the bucket of its final
+// backup.
+func (c *cloudsqlExternal) startFinalBackup(ctx context.Context, cr *v1beta1.CloudSQLInstance) (*sqladmin.Operation, error) {
+	name := meta.GetExternalName(cr)
+	req := cloudsql.GenerateFinalExportRequest(name, *cr.Spec.ForProvider.FinalBackup, time.Now())
+	return c.db.Export(c.projectID, name, req).Context(ctx).Do()
+}
+
 func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
+	m := instanceConnectionDetails(cr.Spec.ForProvider.ConnectionEndpoint, cr.Status.AtProvider.IPAddresses, instance)
+	m[xpv1.ResourceCredentialsSecretUserKey] = []byte(cloudsql.DatabaseUserName(cr.Spec.ForProvider))
+	return m
+}
+
+// ipKeys are the connection secret keys under which the IP addresses of each
+// type are published. Any but the first address of a type is published under
+// the key suffixed with its index, e.g. publicIP-1.
+var ipKeys = map[string]string{
+	v1beta1.PrivateIPType:  v1beta1.PrivateIPKey,
+	v1beta1.PublicIPType:   v1beta1.PublicIPKey,
+	v1beta1.OutgoingIPType: v1beta1.OutgoingIPKey,
+}
+
+// instanceConnectionDetails returns the connection details that are shared by
+// all users of the supplied instance, i.e. its addresses, endpoint and server
+// CA certificate.
+func instanceConnectionDetails(endpoint *string, ips []*v1beta1.IPMapping, instance *sqladmin.DatabaseInstance) managed.ConnectionDetails {
 	m := managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretUserKey: []byte(cloudsql.DatabaseUserName(cr.Spec.ForProvider)),
-		v1beta1.CloudSQLSecretConnectionName:  []byte(instance.ConnectionName),
-	}
-
-	// TODO(muvaf): There might be cases where more than 1 private and/or public IP address has been assigned. We should
-	// somehow show all addresses that are possible to use.
-	for _, ip := range cr.Status.AtProvider.IPAddresses {
-		if ip.Type == v1beta1.PrivateIPType {
-			m[v1beta1.PrivateIPKey] = []byte(ip.IPAddress)
-			// TODO(muvaf): we explicitly enforce use of private IP if it's available. But this should be configured
-			// by resource class or claim.
-			m[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(ip.IPAddress)
-		}
-		if ip.Type == v1beta1.PublicIPType {
-			m[v1beta1.PublicIPKey] = []byte(ip.IPAddress)
-			if len(m[xpv1.ResourceCredentialsSecretEndpointKey]) == 0 {
-				m[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(ip.IPAddress)
-			}
+		v1beta1.CloudSQLSecretConnectionName: []byte(instance.ConnectionName),
+	}
+
+	n := map[string]int{}
+	for _, ip := range ips {
+		key, ok := ipKeys[ip.Type]
+		if !ok {
+			continue
+		}
+		if n[ip.Type] > 0 {
+			key = fmt.Sprintf("%s-%d", key, n[ip.Type])
+		}
+		n[ip.Type]++
+		m[key] = []byte(ip.IPAddress)
+	}
+
+	// The DNS name of an instance that has Private Service Connect enabled
+	// is published if it is preferred. Otherwise the private address is
+	// preferred unless the public one is requested.
+	preferred, fallback := v1beta1.PrivateIPKey, v1beta1.PublicIPKey
+	if gcp.StringValue(endpoint) == v1beta1.ConnectionEndpointPublic {
+		preferred, fallback = fallback, preferred
+	}
+	proxyFlag := ""
+	switch {
+	case gcp.StringValue(endpoint) == v1beta1.ConnectionEndpointPSC && cloudsql.IsPSCEnabled(instance):
+		m[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(instance.DnsName)
+		proxyFlag = "--psc"
+	case len(m[preferred]) > 0:
+		m[xpv1.ResourceCredentialsSecretEndpointKey] = m[preferred]
+		if preferred == v1beta1.PrivateIPKey {
+			proxyFlag = "--private-ip"
+		}
+	case len(m[fallback]) > 0:
+		m[xpv1.ResourceCredentialsSecretEndpointKey] = m[fallback]
+		if fallback == v1beta1.PrivateIPKey {
+			proxyFlag = "--private-ip"
 		}
 	}
+
+	if instance.ConnectionName != "" {
+		m[v1beta1.CloudSQLSecretAuthProxyCommandKey] = []byte(authProxyCommand(instance.ConnectionName, proxyFlag))
+	}
+
 	serverCACert := cloudsql.GetServerCACertificate(*instance)
 	for k, v := range serverCACert {
 		m[k] = v
@@ -227,6 +545,16 @@ func getConnectionDetails(cr *v1beta1.CloudSQLInstance, instance *sqladmin.Datab
 	return m
 }
 
+// authProxyCommand returns the Cloud SQL Auth Proxy invocation that connects
+// to the instance with the supplied connection name over the same type of
+// endpoint as the published one, which is selected by the supplied flag.
+func authProxyCommand(connectionName, flag string) string {
+	if flag == "" {
+		return "cloud-sql-proxy " + connectionName
+	}
+	return "cloud-sql-proxy " + flag + " " + connectionName
+}
+
 type cloudsqlTagger struct {
 	kube client.Client
 }
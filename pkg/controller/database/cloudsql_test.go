@@ -22,23 +22,29 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"google.golang.org/api/googleapi"
+	monitoring "google.golang.org/api/monitoring/v3"
 	"google.golang.org/api/option"
 	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
 	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
 )
 
@@ -46,6 +52,11 @@ const (
 	name = "test-sql"
 
 	projectID      = "myproject-id-1234"
+	master         = "test-sql-master"
+	pointInTime    = "2021-06-01T00:00:00Z"
+	restoreOp      = "restore-operation"
+	finalBackupOp  = "final-backup-operation"
+	bucket         = "test-bucket"
 	connectionName = "some:connection:name"
 )
 
@@ -94,6 +105,27 @@ func withBackupConfigurationStartTime(h string) instanceModifier {
 	}
 }
 
+func withConnectionEndpoint(e string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.ConnectionEndpoint = &e }
+}
+
+func withRootPasswordSecretRef() instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.RootPasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		}
+	}
+}
+
+func withPasswordHash(pw string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { cloudsql.SetPasswordHash(i, pw) }
+}
+
+func withAnnotations(a map[string]string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { meta.AddAnnotations(i, a) }
+}
+
 func instance(im ...instanceModifier) *v1beta1.CloudSQLInstance {
 	i := &v1beta1.CloudSQLInstance{
 		ObjectMeta: metav1.ObjectMeta{
@@ -152,9 +184,10 @@ func TestObserve(t *testing.T) {
 		mg resource.Managed
 	}
 	type want struct {
-		mg  resource.Managed
-		obs managed.ExternalObservation
-		err error
+		mg     resource.Managed
+		obs    managed.ExternalObservation
+		events []event.Event
+		err    error
 	}
 
 	cases := map[string]struct {
@@ -269,6 +302,36 @@ func TestObserve(t *testing.T) {
 				mg: instance(withProviderState(v1beta1.StateMaintenance), withConditions(xpv1.Unavailable())),
 			},
 		},
+		"RestartBlocked": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				w.WriteHeader(http.StatusOK)
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.Settings.Tier = "db-old"
+				db.State = v1beta1.StateMaintenance
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			args: args{
+				mg: instance(withTier("db-new"), withRestartPolicy(v1beta1.RestartPolicyBlock)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withTier("db-new"),
+					withRestartPolicy(v1beta1.RestartPolicyBlock),
+					withProviderState(v1beta1.StateMaintenance),
+					withPendingRestartChanges("settings.tier"),
+					withConditions(xpv1.Unavailable())),
+			},
+		},
 		"RunnableUnbound": {
 			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				_ = r.Body.Close()
@@ -288,16 +351,255 @@ func TestObserve(t *testing.T) {
 			args: args{
 				mg: instance(),
 			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: connDetails("", "", map[string][]byte{
+						v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+						v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy " + connectionName),
+					}),
+				},
+				mg: instance(
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withConnectionName(connectionName)),
+			},
+		},
+		"RootPasswordChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			kube: &test.MockClient{
+				MockGet: passwordSecret("new"),
+			},
+			args: args{
+				mg: instance(withRootPasswordSecretRef(), withPasswordHash("old")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withRootPasswordSecretRef(),
+					withPasswordHash("old"),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"RootPasswordUnchanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			kube: &test.MockClient{
+				MockGet: passwordSecret("new"),
+			},
+			args: args{
+				mg: instance(withRootPasswordSecretRef(), withPasswordHash("new")),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withRootPasswordSecretRef(),
+					withPasswordHash("new"),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"RotateSuppliedRootPassword": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			kube: &test.MockClient{
+				MockGet:    passwordSecret("new"),
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(
+					withRootPasswordSecretRef(),
+					withPasswordHash("new"),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"})),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withRootPasswordSecretRef(),
+					withPasswordHash("new"),
+					withAnnotations(map[string]string{}),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+				events: []event.Event{event.Warning(reasonRotateRootPassword, errors.New(errRotateSuppliedPassword))},
+			},
+		},
+		"RotateRootPassword": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				db := &sqladmin.DatabaseInstance{}
+				cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), instance().Spec.ForProvider, db)
+				db.State = v1beta1.StateRunnable
+				_ = json.NewEncoder(w).Encode(db)
+			}),
+			args: args{
+				mg: instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"})),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"}),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"RestoreNeeded": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(observed(instance().Spec.ForProvider, func(db *sqladmin.DatabaseInstance) {
+					db.State = v1beta1.StateRunnable
+				}))
+			}),
+			args: args{
+				mg: instance(withSource(restoreSource())),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withSource(restoreSource()),
+					withProviderState(v1beta1.StateRunnable),
+					withSettingsVersion(3),
+					withSourceStatus(&v1beta1.InstanceSourceStatus{Instance: master, BackupRunID: backupRunID}),
+					withConditions(xpv1.Available())),
+			},
+		},
+		"Restoring": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if strings.HasSuffix(r.URL.Path, "/operations/"+restoreOp) {
+					_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: restoreOp, Status: "RUNNING"})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(observed(instance().Spec.ForProvider, func(db *sqladmin.DatabaseInstance) {
+					db.State = v1beta1.StateMaintenance
+				}))
+			}),
+			args: args{
+				mg: instance(withSource(restoreSource()), withAnnotations(map[string]string{v1beta1.AnnotationKeyRestoreOperation: restoreOp})),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withSource(restoreSource()),
+					withAnnotations(map[string]string{v1beta1.AnnotationKeyRestoreOperation: restoreOp}),
+					withProviderState(v1beta1.StateMaintenance),
+					withSettingsVersion(3),
+					withSourceStatus(&v1beta1.InstanceSourceStatus{
+						Instance:    master,
+						BackupRunID: backupRunID,
+						Operation:   restoreOp,
+						Status:      "RUNNING",
+					}),
+					withConditions(xpv1.Unavailable())),
+			},
+		},
+		"Replica": {
+			handler: replicaServer(t, http.StatusOK, 2.4),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(withMasterInstanceName(master)),
+			},
 			want: want{
 				obs: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: connDetails("", "", map[string][]byte{v1beta1.CloudSQLSecretConnectionName: []byte(connectionName)}),
+					ConnectionDetails: connDetails("", ""),
 				},
 				mg: instance(
+					withMasterInstanceName(master),
+					withDatabaseReplicationEnabled(),
 					withProviderState(v1beta1.StateRunnable),
 					withConditions(xpv1.Available()),
-					withConnectionName(connectionName)),
+					withReplication(master, &v1beta1.ReplicationStatus{Enabled: true, LagSeconds: gcp.Int64Ptr(2)})),
+			},
+		},
+		"PromotionRequested": {
+			handler: replicaServer(t, http.StatusOK, 0),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(withMasterInstanceName(master), withPromoteReplica()),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withMasterInstanceName(master),
+					withPromoteReplica(),
+					withDatabaseReplicationEnabled(),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withReplication(master, &v1beta1.ReplicationStatus{Enabled: true, LagSeconds: gcp.Int64Ptr(0)})),
+			},
+		},
+		"GetReplicaLagFailed": {
+			handler: replicaServer(t, http.StatusForbidden, 0),
+			kube: &test.MockClient{
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			args: args{
+				mg: instance(withMasterInstanceName(master)),
+			},
+			want: want{
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connDetails("", ""),
+				},
+				mg: instance(
+					withMasterInstanceName(master),
+					withDatabaseReplicationEnabled(),
+					withProviderState(v1beta1.StateRunnable),
+					withConditions(xpv1.Available()),
+					withReplication(master, &v1beta1.ReplicationStatus{Enabled: true})),
+				events: []event.Event{event.Warning(reasonReplicaLag, errors.Wrap(gError(http.StatusForbidden, ""), errGetReplicaLag))},
 			},
 		},
 	}
@@ -307,10 +609,15 @@ func TestObserve(t *testing.T) {
 			server := httptest.NewServer(tc.handler)
 			defer server.Close()
 			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			m, _ := monitoring.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			record := &eventRecorder{}
 			e := cloudsqlExternal{
-				kube:      tc.kube,
-				projectID: projectID,
-				db:        s.Instances,
+				kube:       tc.kube,
+				record:     record,
+				projectID:  projectID,
+				db:         s.Instances,
+				operations: s.Operations,
+				metrics:    m.Projects.TimeSeries,
 			}
 			obs, err := e.Observe(context.Background(), tc.args.mg)
 			if tc.want.err != nil && err != nil {
@@ -329,6 +636,9 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
 				t.Errorf("Observe(...): -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.events, record.events, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want events, +got events:\n%s", diff)
+			}
 		})
 	}
 }
@@ -418,6 +728,43 @@ func TestCreate(t *testing.T) {
 				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateFailed),
 			},
 		},
+		"Clone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+master+"/clone", r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				req := &sqladmin.InstancesCloneRequest{}
+				_ = json.NewDecoder(r.Body).Decode(req)
+				want := &sqladmin.InstancesCloneRequest{CloneContext: &sqladmin.CloneContext{
+					DestinationInstanceName: name,
+					PointInTime:             pointInTime,
+				}}
+				if diff := cmp.Diff(want, req); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withSource(cloneSource())),
+			},
+			want: want{
+				mg: instance(withSource(cloneSource()), withConditions(xpv1.Creating())),
+			},
+		},
+		"CloneFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			args: args{
+				mg: instance(withSource(cloneSource())),
+			},
+			want: want{
+				mg:  instance(withSource(cloneSource()), withConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCloneFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -566,108 +913,694 @@ func TestDelete(t *testing.T) {
 	}
 }
 
-func TestUpdate(t *testing.T) {
-	type args struct {
-		mg resource.Managed
-	}
+// eventRecorder records the events it is sent.
+type eventRecorder struct {
+	events []event.Event
+}
+
+func (r *eventRecorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *eventRecorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func withFinalBackup(b *v1beta1.FinalBackup) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.FinalBackup = b }
+}
+
+func TestDeleteFinalBackup(t *testing.T) {
+	started := map[string]string{v1beta1.AnnotationKeyFinalBackupOperation: finalBackupOp}
+	exported := &v1beta1.FinalBackup{Bucket: gcp.StringPtr(bucket), Databases: []string{"db"}}
+
 	type want struct {
-		mg  resource.Managed
-		upd managed.ExternalUpdate
-		err error
+		mg      resource.Managed
+		started string
+		deleted bool
+		events  []event.Event
+		err     error
 	}
-
 	cases := map[string]struct {
-		handler http.Handler
-		kube    client.Client
-		args    args
-		want    want
+		code int
+		op   *sqladmin.Operation
+		kube client.Client
+		mg   *v1beta1.CloudSQLInstance
+		want want
 	}{
-		"Successful": {
-			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
-				}
-				w.WriteHeader(http.StatusOK)
-				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
-			}),
-			kube: &test.MockClient{
-				MockGet: test.NewMockGetFn(nil),
-			},
-			args: args{
-				mg: instance(),
-			},
+		"NoBucket": {
+			mg: instance(withFinalBackup(&v1beta1.FinalBackup{})),
 			want: want{
-				mg:  instance(),
-				err: nil,
+				mg:  instance(withFinalBackup(&v1beta1.FinalBackup{}), withConditions(xpv1.Deleting())),
+				err: errors.New(errFinalBackupNoBucket),
 			},
 		},
-		"NoUpdateNecessary": {
-			args: args{
-				mg: instance(withProviderState(v1beta1.StateCreating)),
-			},
+		"Export": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   instance(withFinalBackup(exported)),
 			want: want{
-				mg:  instance(withProviderState(v1beta1.StateCreating)),
-				err: nil,
+				mg:      instance(withFinalBackup(exported), withAnnotations(started), withConditions(xpv1.Deleting())),
+				started: "/export",
 			},
 		},
-		"PatchFails": {
-			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				_ = r.Body.Close()
-				if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
-					t.Errorf("r: -want, +got:\n%s", diff)
+		"StartFailed": {
+			code: http.StatusBadRequest,
+			mg:   instance(withFinalBackup(exported)),
+			want: want{
+				mg:      instance(withFinalBackup(exported), withConditions(xpv1.Deleting())),
+				started: "/export",
+				err:     errors.Wrap(gError(http.StatusBadRequest, ""), errFinalBackup),
+			},
+		},
+		"AlreadyGone": {
+			code: http.StatusNotFound,
+			mg:   instance(withFinalBackup(exported)),
+			want: want{
+				mg:      instance(withFinalBackup(exported), withConditions(xpv1.Deleting())),
+				started: "/export",
+				deleted: true,
+			},
+		},
+		"RecordBackupFails": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   instance(withFinalBackup(exported)),
+			want: want{
+				mg:      instance(withFinalBackup(exported), withAnnotations(started), withConditions(xpv1.Deleting())),
+				started: "/export",
+				err:     errors.Wrap(errBoom, errManagedUpdateFailed),
+			},
+		},
+		"BackupRunning": {
+			op: &sqladmin.Operation{Name: finalBackupOp, Status: "RUNNING"},
+			mg: instance(withFinalBackup(exported), withAnnotations(started)),
+			want: want{
+				mg: instance(withFinalBackup(exported), withAnnotations(started), withConditions(xpv1.Deleting())),
+			},
+		},
+		"BackupFailed": {
+			op: &sqladmin.Operation{Name: finalBackupOp, Status: cloudsql.OperationDone, Error: &sqladmin.OperationErrors{
+				Errors: []*sqladmin.OperationError{{Message: "boom"}},
+			}},
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   instance(withFinalBackup(exported), withAnnotations(started)),
+			want: want{
+				mg:  instance(withFinalBackup(exported), withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errBoom, errFinalBackupFailed),
+			},
+		},
+		"ExportDone": {
+			op: &sqladmin.Operation{Name: finalBackupOp, Status: cloudsql.OperationDone, ExportContext: &sqladmin.ExportContext{Uri: "gs://" + bucket + "/final.sql.gz"}},
+			mg: instance(withFinalBackup(exported), withAnnotations(started)),
+			want: want{
+				mg:      instance(withFinalBackup(exported), withAnnotations(started), withConditions(xpv1.Deleting())),
+				deleted: true,
+				events:  []event.Event{event.Normal(reasonFinalBackup, "Took final backup gs://"+bucket+"/final.sql.gz")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			started, deleted := "", false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					if diff := cmp.Diff("/operations/"+finalBackupOp, r.URL.Path[strings.LastIndex(r.URL.Path, "/operations/"):]); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(tc.op)
+					return
+				case http.MethodPost:
+					started = r.URL.Path[strings.LastIndex(r.URL.Path, "/"):]
+					if started == "/export" {
+						req := &sqladmin.InstancesExportRequest{}
+						_ = json.NewDecoder(r.Body).Decode(req)
+						if !strings.HasPrefix(req.ExportContext.Uri, "gs://"+bucket+"/"+meta.GetExternalName(tc.mg)+"-final-") {
+							t.Errorf("r: unexpected export URI %s", req.ExportContext.Uri)
+						}
+						if diff := cmp.Diff(exported.Databases, req.ExportContext.Databases); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+					}
+				case http.MethodDelete:
+					_ = r.Body.Close()
+					deleted = true
+				default:
+					t.Errorf("r: unexpected %s request", r.Method)
 				}
-				w.WriteHeader(http.StatusBadRequest)
-				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
-			}),
+				if tc.code != 0 {
+					w.WriteHeader(tc.code)
+					_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: finalBackupOp})
+			}))
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			record := &eventRecorder{}
+			e := cloudsqlExternal{
+				kube:       tc.kube,
+				record:     record,
+				projectID:  projectID,
+				db:         s.Instances,
+				operations: s.Operations,
+			}
+			err := e.Delete(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			} else {
+				if diff := cmp.Diff(tc.want.err, err); diff != "" {
+					t.Errorf("Delete(...): -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.started, started); diff != "" {
+				t.Errorf("Delete(...): -want started, +got started:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("Delete(...): -want deleted, +got deleted:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.events, record.events, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want events, +got events:\n%s", diff)
+			}
+		})
+	}
+}
+
+// updateServer serves the supplied instance and records the patch and user
+// update requests it receives. It responds with the supplied status code to
+// requests other than GET.
+func updateServer(t *testing.T, observed *sqladmin.DatabaseInstance, code int, patch *sqladmin.DatabaseInstance, user *sqladmin.User) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = r.Body.Close()
+			_ = json.NewEncoder(w).Encode(observed)
+			return
+		case http.MethodPatch:
+			_ = json.NewDecoder(r.Body).Decode(patch)
+		case http.MethodPut:
+			if diff := cmp.Diff(v1beta1.MysqlDefaultUser, r.URL.Query().Get("name")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(v1beta1.MysqlDefaultUserHost, r.URL.Query().Get("host")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewDecoder(r.Body).Decode(user)
+		}
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	})
+}
+
+// observed returns the instance that results from the supplied parameters.
+func observed(p v1beta1.CloudSQLInstanceParameters, m ...func(*sqladmin.DatabaseInstance)) *sqladmin.DatabaseInstance {
+	db := &sqladmin.DatabaseInstance{}
+	cloudsql.GenerateDatabaseInstance(name, p, db)
+	db.Settings.SettingsVersion = 3
+	for _, f := range m {
+		f(db)
+	}
+	return db
+}
+
+func withTier(tier string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.Settings.Tier = tier }
+}
+
+func withMasterInstanceName(n string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.MasterInstanceName = &n }
+}
+
+func withDatabaseReplicationEnabled() instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Spec.ForProvider.Settings.DatabaseReplicationEnabled = gcp.BoolPtr(true)
+	}
+}
+
+func withPromoteReplica() instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.PromoteReplica = gcp.BoolPtr(true) }
+}
+
+func withReplication(master string, r *v1beta1.ReplicationStatus) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) {
+		i.Status.AtProvider.MasterInstanceName = master
+		i.Status.AtProvider.Replication = r
+	}
+}
+
+// replicaServer serves a read replica of the master instance, and responds
+// to requests for its replication lag with the supplied status code and lag.
+func replicaServer(t *testing.T, code int, lag float64) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if !strings.HasSuffix(r.URL.Path, "/timeSeries") {
+			p := instance(withMasterInstanceName(master)).Spec.ForProvider
+			db := &sqladmin.DatabaseInstance{}
+			cloudsql.GenerateDatabaseInstance(meta.GetExternalName(instance()), p, db)
+			db.Settings.DatabaseReplicationEnabled = true
+			db.State = v1beta1.StateRunnable
+			_ = json.NewEncoder(w).Encode(db)
+			return
+		}
+		if diff := cmp.Diff(cloudsql.ReplicaLagFilter(projectID, name), r.URL.Query().Get("filter")); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		w.WriteHeader(code)
+		if code != http.StatusOK {
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&monitoring.ListTimeSeriesResponse{
+			TimeSeries: []*monitoring.TimeSeries{{Points: []*monitoring.Point{{Value: &monitoring.TypedValue{DoubleValue: &lag}}}}},
+		})
+	})
+}
+
+func withSettingsVersion(v int64) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Status.AtProvider.SettingsVersion = v }
+}
+
+func withSource(src *v1beta1.InstanceSource) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.Source = src }
+}
+
+func withSourceStatus(src *v1beta1.InstanceSourceStatus) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Status.AtProvider.Source = src }
+}
+
+func cloneSource() *v1beta1.InstanceSource {
+	return &v1beta1.InstanceSource{Instance: gcp.StringPtr(master), PointInTime: gcp.StringPtr(pointInTime)}
+}
+
+func restoreSource() *v1beta1.InstanceSource {
+	return &v1beta1.InstanceSource{Instance: gcp.StringPtr(master), BackupRunID: gcp.StringPtr(backupRunID)}
+}
+
+func withPendingRestartChanges(p ...string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Status.AtProvider.PendingRestartChanges = p }
+}
+
+func withRestartPolicy(p string) instanceModifier {
+	return func(i *v1beta1.CloudSQLInstance) { i.Spec.ForProvider.RestartPolicy = &p }
+}
+
+func TestUpdate(t *testing.T) {
+	oldTier := func(db *sqladmin.DatabaseInstance) { db.Settings.Tier = "db-old" }
+	oldLabels := func(db *sqladmin.DatabaseInstance) { db.Settings.UserLabels = map[string]string{"old": "label"} }
+
+	type args struct {
+		mg       resource.Managed
+		observed *sqladmin.DatabaseInstance
+		code     int
+	}
+	type want struct {
+		mg    resource.Managed
+		upd   managed.ExternalUpdate
+		patch *sqladmin.DatabaseInstance
+		user  *sqladmin.User
+		err   error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		args args
+		want want
+	}{
+		"Successful": {
+			args: args{
+				mg:       instance(withTier("db-new")),
+				observed: observed(instance(withTier("db-new")).Spec.ForProvider, oldTier),
+				code:     http.StatusOK,
+			},
+			want: want{
+				mg:    instance(withTier("db-new")),
+				patch: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{Tier: "db-new", SettingsVersion: 3}},
+				user:  &sqladmin.User{},
+			},
+		},
+		"OnlyChangedFields": {
+			args: args{
+				mg:       instance(withTier("db-new")),
+				observed: observed(instance(withTier("db-new")).Spec.ForProvider, oldTier, oldLabels),
+				code:     http.StatusOK,
+			},
+			want: want{
+				mg: instance(withTier("db-new")),
+				patch: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{
+					Tier:            "db-new",
+					SettingsVersion: 3,
+				}},
+				user: &sqladmin.User{},
+			},
+		},
+		"RestartBlocked": {
+			args: args{
+				mg: instance(withTier("db-new"), withRestartPolicy(v1beta1.RestartPolicyBlock)),
+				observed: observed(instance(withTier("db-new")).Spec.ForProvider, oldTier, func(db *sqladmin.DatabaseInstance) {
+					db.MaxDiskSize = 10
+				}),
+				code: http.StatusOK,
+			},
+			want: want{
+				mg:    instance(withTier("db-new"), withRestartPolicy(v1beta1.RestartPolicyBlock)),
+				patch: &sqladmin.DatabaseInstance{},
+				user:  &sqladmin.User{},
+			},
+		},
+		"RootPasswordChanged": {
 			kube: &test.MockClient{
-				MockGet: test.NewMockGetFn(nil),
+				MockGet:    passwordSecret("new"),
+				MockUpdate: test.NewMockUpdateFn(nil),
 			},
 			args: args{
-				mg: instance(),
+				mg:       instance(withRootPasswordSecretRef(), withPasswordHash("old")),
+				observed: observed(instance().Spec.ForProvider),
+				code:     http.StatusOK,
 			},
 			want: want{
+				mg:    instance(withRootPasswordSecretRef(), withPasswordHash("new")),
+				patch: &sqladmin.DatabaseInstance{},
+				user:  &sqladmin.User{Name: v1beta1.MysqlDefaultUser, Host: v1beta1.MysqlDefaultUserHost, Password: "new"},
 				upd: managed.ExternalUpdate{
-					ConnectionDetails: map[string][]byte{
-						xpv1.ResourceCredentialsSecretUserKey: []byte(v1beta1.MysqlDefaultUser),
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
 					},
 				},
-				mg:  instance(),
-				err: errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFailed),
+			},
+		},
+		"UpdateRootPasswordFails": {
+			kube: &test.MockClient{
+				MockGet: passwordSecret("new"),
+			},
+			args: args{
+				mg:       instance(withRootPasswordSecretRef(), withPasswordHash("old")),
+				observed: observed(instance().Spec.ForProvider),
+				code:     http.StatusBadRequest,
+			},
+			want: want{
+				mg:    instance(withRootPasswordSecretRef(), withPasswordHash("old")),
+				patch: &sqladmin.DatabaseInstance{},
+				user:  &sqladmin.User{Name: v1beta1.MysqlDefaultUser, Host: v1beta1.MysqlDefaultUserHost, Password: "new"},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePassword),
+			},
+		},
+		"NoUpdateNecessary": {
+			args: args{
+				mg: instance(withProviderState(v1beta1.StateCreating)),
+			},
+			want: want{
+				mg:    instance(withProviderState(v1beta1.StateCreating)),
+				patch: &sqladmin.DatabaseInstance{},
+				user:  &sqladmin.User{},
+			},
+		},
+		"PatchFails": {
+			args: args{
+				mg:       instance(withTier("db-new")),
+				observed: observed(instance(withTier("db-new")).Spec.ForProvider, oldTier),
+				code:     http.StatusBadRequest,
+			},
+			want: want{
+				mg:    instance(withTier("db-new")),
+				patch: &sqladmin.DatabaseInstance{Settings: &sqladmin.Settings{Tier: "db-new", SettingsVersion: 3}},
+				user:  &sqladmin.User{},
+				err:   errors.Wrap(gError(http.StatusBadRequest, ""), errUpdateFailed),
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			server := httptest.NewServer(tc.handler)
+			patch, user := &sqladmin.DatabaseInstance{}, &sqladmin.User{}
+			server := httptest.NewServer(updateServer(t, tc.args.observed, tc.args.code, patch, user))
 			defer server.Close()
 			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
 			e := cloudsqlExternal{
 				kube:      tc.kube,
 				projectID: projectID,
 				db:        s.Instances,
+				users:     s.Users,
+				flags:     s.Flags,
 			}
 			upd, err := e.Update(context.Background(), tc.args.mg)
-			if tc.want.err != nil && err != nil {
-				// the case where our mock server returns error.
-				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
-					t.Errorf("Update(...): -want, +got:\n%s", diff)
-				}
-			} else {
-				if diff := cmp.Diff(tc.want.err, err); diff != "" {
-					t.Errorf("Update(...): -want, +got:\n%s", diff)
-				}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
 			}
-			if tc.want.err == nil {
-				if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
-					t.Errorf("Update(...): -want, +got:\n%s", diff)
-				}
-				if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
-					t.Errorf("Update(...): -want, +got:\n%s", diff)
+			if diff := cmp.Diff(tc.want.mg, tc.args.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.patch, patch); diff != "" {
+				t.Errorf("Update(...): -want patch, +got patch:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.user, user); diff != "" {
+				t.Errorf("Update(...): -want user, +got user:\n%s", diff)
+			}
+		})
+	}
+}
+
+// passwordSecret returns a Get function that serves the referenced password
+// Secret with the supplied password.
+func passwordSecret(desired string) test.MockGetFn {
+	return func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte(desired)}
+		return nil
+	}
+}
+
+func TestUpdateRotateRootPassword(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(observed(instance().Spec.ForProvider))
+			return
+		case http.MethodPut:
+			u := &sqladmin.User{}
+			_ = json.NewDecoder(r.Body).Decode(u)
+			sent = u.Password
+		}
+		_ = r.Body.Close()
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	}))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlExternal{
+		kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+		projectID: projectID,
+		db:        s.Instances,
+		users:     s.Users,
+	}
+	cr := instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"}))
+	upd, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if sent == "" || sent != string(upd.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) {
+		t.Errorf("Update(...): published password %q does not match sent password %q", upd.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey], sent)
+	}
+	if diff := cmp.Diff(instance(), cr); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdateRotateRootPasswordFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(observed(instance().Spec.ForProvider))
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	}))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlExternal{
+		kube:      &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+		projectID: projectID,
+		db:        s.Instances,
+		users:     s.Users,
+	}
+	cr := instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"}))
+	_, err := e.Update(context.Background(), cr)
+	if diff := cmp.Diff(errors.Wrap(gError(http.StatusBadRequest, ""), errUpdatePassword).Error(), err.Error()); diff != "" {
+		t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+	}
+	// The rotation is retried once the user can be updated.
+	if diff := cmp.Diff(instance(withAnnotations(map[string]string{v1beta1.AnnotationKeyRotateRootPassword: "true"})), cr); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdatePromoteReplica(t *testing.T) {
+	replica := observed(instance(withMasterInstanceName(master)).Spec.ForProvider)
+	promoted := observed(instance().Spec.ForProvider, func(db *sqladmin.DatabaseInstance) {
+		db.InstanceType = v1beta1.InstanceTypeCloudSQL
+	})
+
+	type want struct {
+		promoted bool
+		err      error
+	}
+	cases := map[string]struct {
+		observed *sqladmin.DatabaseInstance
+		code     int
+		want     want
+	}{
+		"Promote": {
+			observed: replica,
+			code:     http.StatusOK,
+			want:     want{promoted: true},
+		},
+		"AlreadyPromoted": {
+			observed: promoted,
+			code:     http.StatusOK,
+		},
+		"PromoteFails": {
+			observed: replica,
+			code:     http.StatusBadRequest,
+			want: want{
+				promoted: true,
+				err:      errors.Wrap(gError(http.StatusBadRequest, ""), errPromoteReplica),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			promotedReplica := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				case http.MethodPost:
+					promotedReplica = strings.HasSuffix(r.URL.Path, "/promoteReplica")
+				default:
+					t.Errorf("r: unexpected %s request", r.Method)
 				}
+				w.WriteHeader(tc.code)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}))
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlExternal{
+				projectID: projectID,
+				db:        s.Instances,
+				flags:     s.Flags,
 			}
+			_, err := e.Update(context.Background(), instance(withMasterInstanceName(master), withPromoteReplica()))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.promoted, promotedReplica); diff != "" {
+				t.Errorf("Update(...): -want promoted, +got promoted:\n%s", diff)
+			}
+		})
+	}
+}
 
+func TestUpdateRestoreBackupRun(t *testing.T) {
+	restored := map[string]string{v1beta1.AnnotationKeyRestoreOperation: restoreOp}
+	runnable := func(db *sqladmin.DatabaseInstance) { db.State = v1beta1.StateRunnable }
+
+	type want struct {
+		mg       resource.Managed
+		restored bool
+		err      error
+	}
+	cases := map[string]struct {
+		observed *sqladmin.DatabaseInstance
+		kube     client.Client
+		mg       *v1beta1.CloudSQLInstance
+		want     want
+	}{
+		"Restore": {
+			observed: observed(instance().Spec.ForProvider, runnable),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:       instance(withSource(restoreSource())),
+			want: want{
+				mg:       instance(withSource(restoreSource()), withAnnotations(restored)),
+				restored: true,
+			},
+		},
+		"AlreadyRestored": {
+			observed: observed(instance().Spec.ForProvider, runnable),
+			mg:       instance(withSource(restoreSource()), withAnnotations(restored)),
+			want: want{
+				mg: instance(withSource(restoreSource()), withAnnotations(restored)),
+			},
+		},
+		"NotRunnable": {
+			observed: observed(instance().Spec.ForProvider),
+			mg:       instance(withSource(restoreSource())),
+			want: want{
+				mg: instance(withSource(restoreSource())),
+			},
+		},
+		"RecordRestoreFails": {
+			observed: observed(instance().Spec.ForProvider, runnable),
+			kube:     &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:       instance(withSource(restoreSource())),
+			want: want{
+				mg:       instance(withSource(restoreSource()), withAnnotations(restored)),
+				restored: true,
+				err:      errors.Wrap(errors.Wrap(errBoom, errManagedUpdateFailed), errRestoreBackupRun),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			restoredBackup := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodGet:
+					_ = r.Body.Close()
+					_ = json.NewEncoder(w).Encode(tc.observed)
+					return
+				case http.MethodPost:
+					req := &sqladmin.InstancesRestoreBackupRequest{}
+					_ = json.NewDecoder(r.Body).Decode(req)
+					want := &sqladmin.InstancesRestoreBackupRequest{RestoreBackupContext: &sqladmin.RestoreBackupContext{
+						BackupRunId: 1234,
+						InstanceId:  master,
+						Project:     projectID,
+					}}
+					if diff := cmp.Diff(want, req); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					restoredBackup = strings.HasSuffix(r.URL.Path, "/restoreBackup")
+				default:
+					t.Errorf("r: unexpected %s request", r.Method)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: restoreOp})
+			}))
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlExternal{
+				kube:      tc.kube,
+				projectID: projectID,
+				db:        s.Instances,
+				flags:     s.Flags,
+			}
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.restored, restoredBackup); diff != "" {
+				t.Errorf("Update(...): -want restored, +got restored:\n%s", diff)
+			}
 		})
 	}
 }
@@ -716,6 +1649,98 @@ func TestGetConnectionDetails(t *testing.T) {
 				}),
 			},
 		},
+		"PreferPublic": {
+			args: args{
+				cr: instance(
+					withConnectionEndpoint(v1beta1.ConnectionEndpointPublic),
+					withPrivateIP(privateIP),
+					withPublicIP(publicIP),
+				),
+				i: &sqladmin.DatabaseInstance{ConnectionName: connectionName},
+			},
+			want: want{
+				conn: connDetails(privateIP, publicIP, map[string][]byte{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte(publicIP),
+					v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+					v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy " + connectionName),
+				}),
+			},
+		},
+		"PSC": {
+			args: args{
+				cr: instance(
+					withConnectionEndpoint(v1beta1.ConnectionEndpointPSC),
+					withPrivateIP(privateIP),
+				),
+				i: &sqladmin.DatabaseInstance{
+					ConnectionName: connectionName,
+					DnsName:        "abc.psc.sql.goog.",
+					Settings: &sqladmin.Settings{IpConfiguration: &sqladmin.IpConfiguration{
+						PscConfig: &sqladmin.PscConfig{PscEnabled: true},
+					}},
+				},
+			},
+			want: want{
+				conn: connDetails(privateIP, "", map[string][]byte{
+					xpv1.ResourceCredentialsSecretEndpointKey: []byte("abc.psc.sql.goog."),
+					v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+					v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy --psc " + connectionName),
+				}),
+			},
+		},
+		"PSCNotEnabled": {
+			args: args{
+				cr: instance(
+					withConnectionEndpoint(v1beta1.ConnectionEndpointPSC),
+					withPrivateIP(privateIP),
+					withPublicIP(publicIP),
+				),
+				i: &sqladmin.DatabaseInstance{ConnectionName: connectionName},
+			},
+			want: want{
+				conn: connDetails(privateIP, publicIP, map[string][]byte{
+					v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+					v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy --private-ip " + connectionName),
+				}),
+			},
+		},
+		"PreferredTypeMissing": {
+			args: args{
+				cr: instance(
+					withConnectionEndpoint(v1beta1.ConnectionEndpointPublic),
+					withPrivateIP(privateIP),
+				),
+				i: &sqladmin.DatabaseInstance{ConnectionName: connectionName},
+			},
+			want: want{
+				conn: connDetails(privateIP, "", map[string][]byte{
+					v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+					v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy --private-ip " + connectionName),
+				}),
+			},
+		},
+		"AllAddresses": {
+			args: args{
+				cr: instance(
+					withPrivateIP(privateIP),
+					withPublicIP(publicIP),
+					withPublicIP("243.2.220.3"),
+					func(i *v1beta1.CloudSQLInstance) {
+						i.Status.AtProvider.IPAddresses = append(i.Status.AtProvider.IPAddresses, &v1beta1.IPMapping{
+							IPAddress: "243.2.220.4",
+							Type:      v1beta1.OutgoingIPType,
+						})
+					},
+				),
+				i: &sqladmin.DatabaseInstance{},
+			},
+			want: want{
+				conn: connDetails(privateIP, publicIP, map[string][]byte{
+					v1beta1.PublicIPKey + "-1": []byte("243.2.220.3"),
+					v1beta1.OutgoingIPKey:      []byte("243.2.220.4"),
+				}),
+			},
+		},
 	}
 
 	for name, tc := range cases {
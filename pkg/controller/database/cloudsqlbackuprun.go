@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqlbackuprun"
+)
+
+const (
+	errNotCloudSQLBackupRun = "managed resource is not a CloudSQLBackupRun custom resource"
+	errBackupRunNoInstance  = "CloudSQLBackupRun does not specify an instance"
+	errBackupRunID          = "cannot parse the ID of the CloudSQL backup run"
+	errBackupRunNoID        = "CloudSQL did not return the ID of the backup run"
+	errGetBackupRun         = "cannot get the CloudSQL backup run"
+	errCreateBackupRun      = "cannot create the CloudSQL backup run"
+	errDeleteBackupRun      = "cannot delete the CloudSQL backup run"
+	errExpireBackupRun      = "cannot delete the expired CloudSQL backup run"
+)
+
+// SetupCloudSQLBackupRun adds a controller that reconciles CloudSQLBackupRun
+// managed resources.
+func SetupCloudSQLBackupRun(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.CloudSQLBackupRunGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.CloudSQLBackupRunGroupVersionKind),
+		managed.WithExternalConnecter(&cloudsqlBackupRunConnector{kube: mgr.GetClient()}),
+		// The external name is the ID assigned by GCP.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.CloudSQLBackupRun{}).
+		Complete(r)
+}
+
+type cloudsqlBackupRunConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlBackupRunConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlBackupRunExternal{runs: s.BackupRuns, projectID: projectID}, nil
+}
+
+type cloudsqlBackupRunExternal struct {
+	runs      *sqladmin.BackupRunsService
+	projectID string
+}
+
+func (c *cloudsqlBackupRunExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLBackupRun)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLBackupRun)
+	}
+	if cr.Spec.ForProvider.Instance == nil {
+		return managed.ExternalObservation{}, errors.New(errBackupRunNoInstance)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errBackupRunID)
+	}
+	run, err := c.runs.Get(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), id).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) && cloudsqlbackuprun.IsExpired(cr.Status.AtProvider, time.Now()) {
+		// The backup run was deleted at the end of its retention period. It
+		// must not be taken anew.
+		cr.Status.AtProvider.Status = v1beta1.BackupRunStatusExpired
+		cr.Status.SetConditions(xpv1.Unavailable())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetBackupRun)
+	}
+	cr.Status.AtProvider = cloudsqlbackuprun.GenerateObservation(cr.Spec.ForProvider, *run)
+	switch run.Status {
+	case v1beta1.BackupRunStatusSuccessful:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1beta1.BackupRunStatusFailed, v1beta1.BackupRunStatusSkipped:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	default:
+		cr.Status.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// Backup runs are immutable, but they are deleted at the end of
+		// their retention period.
+		ResourceUpToDate: !cloudsqlbackuprun.IsExpired(cr.Status.AtProvider, time.Now()),
+	}, nil
+}
+
+func (c *cloudsqlBackupRunExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLBackupRun)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLBackupRun)
+	}
+	cr.SetConditions(xpv1.Creating())
+	op, err := c.runs.Insert(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), cloudsqlbackuprun.GenerateBackupRun(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBackupRun)
+	}
+	if op.BackupContext == nil || op.BackupContext.BackupId == 0 {
+		return managed.ExternalCreation{}, errors.New(errBackupRunNoID)
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(op.BackupContext.BackupId, 10))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update deletes a backup run that has reached the end of its retention
+// period.
+func (c *cloudsqlBackupRunExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLBackupRun)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLBackupRun)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(c.delete(ctx, cr), errExpireBackupRun)
+}
+
+func (c *cloudsqlBackupRunExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.CloudSQLBackupRun)
+	if !ok {
+		return errors.New(errNotCloudSQLBackupRun)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return errors.Wrap(c.delete(ctx, cr), errDeleteBackupRun)
+}
+
+func (c *cloudsqlBackupRunExternal) delete(ctx context.Context, cr *v1beta1.CloudSQLBackupRun) error {
+	id, err := strconv.ParseInt(meta.GetExternalName(cr), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, errBackupRunID)
+	}
+	_, err = c.runs.Delete(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), id).Context(ctx).Do()
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
@@ -0,0 +1,333 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	backupRunName = "test-backup"
+	backupRunID   = "1234"
+	longAgo       = "2000-01-01T00:00:00Z"
+	recently      = "2099-01-01T00:00:00Z"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlBackupRunConnector{}
+var _ managed.ExternalClient = &cloudsqlBackupRunExternal{}
+
+type backupRunModifier func(*v1beta1.CloudSQLBackupRun)
+
+func withBackupRunConditions(c ...xpv1.Condition) backupRunModifier {
+	return func(b *v1beta1.CloudSQLBackupRun) { b.Status.SetConditions(c...) }
+}
+
+func withBackupRunID(id string) backupRunModifier {
+	return func(b *v1beta1.CloudSQLBackupRun) { meta.SetExternalName(b, id) }
+}
+
+func withRetentionDays(d int64) backupRunModifier {
+	return func(b *v1beta1.CloudSQLBackupRun) { b.Spec.ForProvider.RetentionDays = &d }
+}
+
+func withBackupRunObservation(o v1beta1.CloudSQLBackupRunObservation) backupRunModifier {
+	return func(b *v1beta1.CloudSQLBackupRun) { b.Status.AtProvider = o }
+}
+
+func backupRun(m ...backupRunModifier) *v1beta1.CloudSQLBackupRun {
+	b := &v1beta1.CloudSQLBackupRun{
+		ObjectMeta: metav1.ObjectMeta{Name: backupRunName},
+		Spec: v1beta1.CloudSQLBackupRunSpec{
+			ForProvider: v1beta1.CloudSQLBackupRunParameters{
+				Instance:    gcp.StringPtr(name),
+				Description: gcp.StringPtr("on demand"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(b)
+	}
+	return b
+}
+
+// backupRunServer serves a backup run with the supplied status that ended at
+// the supplied time, and inserts backup runs with a known ID.
+func backupRunServer(t *testing.T, status, end string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path := "/sql/v1beta4/projects/" + projectID + "/instances/" + name + "/backupRuns"
+		switch r.Method {
+		case http.MethodGet, http.MethodDelete:
+			_ = r.Body.Close()
+			if diff := cmp.Diff(path+"/"+backupRunID, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if r.Method == http.MethodDelete {
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.BackupRun{Instance: name, Status: status, EndTime: end})
+		case http.MethodPost:
+			req := &sqladmin.BackupRun{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			if diff := cmp.Diff(&sqladmin.BackupRun{Description: "on demand"}, req); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{BackupContext: &sqladmin.BackupContext{BackupId: 1234}})
+		}
+	})
+}
+
+func TestCloudSQLBackupRunObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NoExternalName": {
+			mg: backupRun(),
+			want: want{
+				mg: backupRun(),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.BackupRun{})
+			}),
+			mg: backupRun(withBackupRunID(backupRunID)),
+			want: want{
+				mg: backupRun(withBackupRunID(backupRunID)),
+			},
+		},
+		"Running": {
+			handler: backupRunServer(t, "RUNNING", ""),
+			mg:      backupRun(withBackupRunID(backupRunID)),
+			want: want{
+				mg: backupRun(
+					withBackupRunID(backupRunID),
+					withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{Instance: name, Status: "RUNNING"}),
+					withBackupRunConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Successful": {
+			handler: backupRunServer(t, v1beta1.BackupRunStatusSuccessful, recently),
+			mg:      backupRun(withBackupRunID(backupRunID), withRetentionDays(7)),
+			want: want{
+				mg: backupRun(
+					withBackupRunID(backupRunID),
+					withRetentionDays(7),
+					withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{
+						Instance:       name,
+						Status:         v1beta1.BackupRunStatusSuccessful,
+						EndTime:        recently,
+						ExpirationTime: "2099-01-08T00:00:00Z",
+					}),
+					withBackupRunConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Expiring": {
+			handler: backupRunServer(t, v1beta1.BackupRunStatusSuccessful, longAgo),
+			mg:      backupRun(withBackupRunID(backupRunID), withRetentionDays(7)),
+			want: want{
+				mg: backupRun(
+					withBackupRunID(backupRunID),
+					withRetentionDays(7),
+					withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{
+						Instance:       name,
+						Status:         v1beta1.BackupRunStatusSuccessful,
+						EndTime:        longAgo,
+						ExpirationTime: "2000-01-08T00:00:00Z",
+					}),
+					withBackupRunConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Expired": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.BackupRun{})
+			}),
+			mg: backupRun(
+				withBackupRunID(backupRunID),
+				withRetentionDays(7),
+				withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{ExpirationTime: "2000-01-08T00:00:00Z"})),
+			want: want{
+				mg: backupRun(
+					withBackupRunID(backupRunID),
+					withRetentionDays(7),
+					withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{
+						Status:         v1beta1.BackupRunStatusExpired,
+						ExpirationTime: "2000-01-08T00:00:00Z",
+					}),
+					withBackupRunConditions(xpv1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			handler: backupRunServer(t, v1beta1.BackupRunStatusFailed, recently),
+			mg:      backupRun(withBackupRunID(backupRunID)),
+			want: want{
+				mg: backupRun(
+					withBackupRunID(backupRunID),
+					withBackupRunObservation(v1beta1.CloudSQLBackupRunObservation{
+						Instance: name,
+						Status:   v1beta1.BackupRunStatusFailed,
+						EndTime:  recently,
+					}),
+					withBackupRunConditions(xpv1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlBackupRunExternal{projectID: projectID, runs: s.BackupRuns}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLBackupRunCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    *v1beta1.CloudSQLBackupRun
+		err     error
+	}{
+		"Successful": {
+			handler: backupRunServer(t, "", ""),
+			want:    backupRun(withBackupRunID(backupRunID), withBackupRunConditions(xpv1.Creating())),
+		},
+		"NoBackupID": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: backupRun(withBackupRunConditions(xpv1.Creating())),
+			err:  errors.New(errBackupRunNoID),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: backupRun(withBackupRunConditions(xpv1.Creating())),
+			err:  errors.Wrap(gError(http.StatusBadRequest, ""), errCreateBackupRun),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlBackupRunExternal{projectID: projectID, runs: s.BackupRuns}
+			cr := backupRun()
+			cre, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: tc.err == nil}, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, cr); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLBackupRunDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    error
+	}{
+		"Successful": {
+			handler: backupRunServer(t, "", ""),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteBackupRun),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlBackupRunExternal{projectID: projectID, runs: s.BackupRuns}
+			err := e.Delete(context.Background(), backupRun(withBackupRunID(backupRunID)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqldatabase"
+)
+
+const (
+	errNotCloudSQLDatabase = "managed resource is not a CloudSQLDatabase custom resource"
+	errDatabaseNoInstance  = "CloudSQLDatabase does not specify an instance"
+	errGetDatabase         = "cannot get the CloudSQL database"
+	errCreateDatabase      = "cannot create the CloudSQL database"
+	errUpdateDatabase      = "cannot update the CloudSQL database"
+	errDeleteDatabase      = "cannot delete the CloudSQL database"
+)
+
+// SetupCloudSQLDatabase adds a controller that reconciles
+// CloudSQLDatabase managed resources.
+func SetupCloudSQLDatabase(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.CloudSQLDatabaseGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.CloudSQLDatabaseGroupVersionKind),
+		managed.WithExternalConnecter(&cloudsqlDatabaseConnector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.CloudSQLDatabase{}).
+		Complete(r)
+}
+
+type cloudsqlDatabaseConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlDatabaseConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlDatabaseExternal{db: s.Databases, projectID: projectID}, nil
+}
+
+type cloudsqlDatabaseExternal struct {
+	db        *sqladmin.DatabasesService
+	projectID string
+}
+
+func (c *cloudsqlDatabaseExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLDatabase)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLDatabase)
+	}
+	if cr.Spec.ForProvider.Instance == nil {
+		return managed.ExternalObservation{}, errors.New(errDatabaseNoInstance)
+	}
+	db, err := c.db.Get(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetDatabase)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cloudsqldatabase.LateInitializeSpec(&cr.Spec.ForProvider, *db)
+
+	cr.Status.AtProvider = cloudsqldatabase.GenerateObservation(*db)
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cloudsqldatabase.IsUpToDate(&cr.Spec.ForProvider, *db),
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (c *cloudsqlDatabaseExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLDatabase)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLDatabase)
+	}
+	cr.SetConditions(xpv1.Creating())
+	db := &sqladmin.Database{}
+	cloudsqldatabase.GenerateDatabase(meta.GetExternalName(cr), cr.Spec.ForProvider, db)
+	_, err := c.db.Insert(c.projectID, db.Instance, db).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreateDatabase)
+}
+
+func (c *cloudsqlDatabaseExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLDatabase)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLDatabase)
+	}
+	db := &sqladmin.Database{}
+	cloudsqldatabase.GenerateDatabase(meta.GetExternalName(cr), cr.Spec.ForProvider, db)
+	_, err := c.db.Patch(c.projectID, db.Instance, db.Name, db).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateDatabase)
+}
+
+func (c *cloudsqlDatabaseExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.CloudSQLDatabase)
+	if !ok {
+		return errors.New(errNotCloudSQLDatabase)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := c.db.Delete(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), meta.GetExternalName(cr)).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteDatabase)
+}
@@ -0,0 +1,306 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	databaseName = "test-database"
+	charset      = "utf8mb4"
+	collation    = "utf8mb4_general_ci"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlDatabaseConnector{}
+var _ managed.ExternalClient = &cloudsqlDatabaseExternal{}
+
+type databaseModifier func(*v1beta1.CloudSQLDatabase)
+
+func withDatabaseConditions(c ...xpv1.Condition) databaseModifier {
+	return func(d *v1beta1.CloudSQLDatabase) { d.Status.SetConditions(c...) }
+}
+
+func withCharset(c string) databaseModifier {
+	return func(d *v1beta1.CloudSQLDatabase) { d.Spec.ForProvider.Charset = gcp.StringPtr(c) }
+}
+
+func withCollation(c string) databaseModifier {
+	return func(d *v1beta1.CloudSQLDatabase) { d.Spec.ForProvider.Collation = gcp.StringPtr(c) }
+}
+
+func withSelfLink(l string) databaseModifier {
+	return func(d *v1beta1.CloudSQLDatabase) { d.Status.AtProvider.SelfLink = l }
+}
+
+func database(m ...databaseModifier) *v1beta1.CloudSQLDatabase {
+	d := &v1beta1.CloudSQLDatabase{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: databaseName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: databaseName,
+			},
+		},
+		Spec: v1beta1.CloudSQLDatabaseSpec{
+			ForProvider: v1beta1.CloudSQLDatabaseParameters{
+				Instance: gcp.StringPtr(name),
+			},
+		},
+	}
+	for _, f := range m {
+		f(d)
+	}
+	return d
+}
+
+func TestCloudSQLDatabaseObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NoInstance": {
+			mg: &v1beta1.CloudSQLDatabase{},
+			want: want{
+				mg:  &v1beta1.CloudSQLDatabase{},
+				err: errors.New(errDatabaseNoInstance),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: database(),
+			want: want{
+				mg: database(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: database(),
+			want: want{
+				mg:  database(),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetDatabase),
+			},
+		},
+		"LateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/databases/"+databaseName, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Database{Charset: charset, Collation: collation, SelfLink: "link"})
+			}),
+			mg: database(),
+			want: want{
+				mg: database(withCharset(charset), withCollation(collation), withSelfLink("link"), withDatabaseConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&sqladmin.Database{Charset: charset, Collation: "utf8mb4_bin"})
+			}),
+			mg: database(withCharset(charset), withCollation(collation)),
+			want: want{
+				mg: database(withCharset(charset), withCollation(collation), withDatabaseConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlDatabaseExternal{projectID: projectID, db: s.Databases}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLDatabaseCreate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &sqladmin.Database{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				want := &sqladmin.Database{Name: databaseName, Instance: name, Charset: charset}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: database(withCharset(charset)),
+			want: want{
+				mg: database(withCharset(charset), withDatabaseConditions(xpv1.Creating())),
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: database(),
+			want: want{
+				mg:  database(withDatabaseConditions(xpv1.Creating())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateDatabase),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlDatabaseExternal{projectID: projectID, db: s.Databases}
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLDatabaseUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		got := &sqladmin.Database{}
+		_ = json.NewDecoder(r.Body).Decode(got)
+		want := &sqladmin.Database{Name: databaseName, Instance: name, Collation: collation}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	}))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlDatabaseExternal{projectID: projectID, db: s.Databases}
+	if _, err := e.Update(context.Background(), database(withCollation(collation))); err != nil {
+		t.Errorf("Update(...): %s", err)
+	}
+}
+
+func TestCloudSQLDatabaseDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteDatabase),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlDatabaseExternal{projectID: projectID, db: s.Databases}
+			err := e.Delete(context.Background(), database())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
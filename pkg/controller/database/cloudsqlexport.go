@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqlexport"
+)
+
+const (
+	errNotCloudSQLExport = "managed resource is not a CloudSQLExport custom resource"
+	errExportNoInstance  = "CloudSQLExport does not specify an instance"
+	errGetExport         = "cannot get the CloudSQL export operation"
+	errCreateExport      = "cannot start the CloudSQL export"
+	errUpdateExport      = "cannot restart the CloudSQL export"
+)
+
+// SetupCloudSQLExport adds a controller that reconciles CloudSQLExport
+// managed resources.
+func SetupCloudSQLExport(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.CloudSQLExportGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.CloudSQLExportGroupVersionKind),
+		managed.WithExternalConnecter(&cloudsqlExportConnector{kube: mgr.GetClient()}),
+		// The external name is the name of the operation assigned by GCP.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.CloudSQLExport{}).
+		Complete(r)
+}
+
+type cloudsqlExportConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlExportConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlExportExternal{kube: c.kube, db: s.Instances, operations: s.Operations, projectID: projectID}, nil
+}
+
+type cloudsqlExportExternal struct {
+	kube       client.Client
+	db         *sqladmin.InstancesService
+	operations *sqladmin.OperationsService
+	projectID  string
+}
+
+func (c *cloudsqlExportExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLExport)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLExport)
+	}
+	// The exported file is kept, so there is nothing to delete.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{}, nil
+	}
+	if cr.Spec.ForProvider.Instance == nil {
+		return managed.ExternalObservation{}, errors.New(errExportNoInstance)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	op, err := c.operations.Get(c.projectID, meta.GetExternalName(cr)).Context(ctx).Do()
+	if gcp.IsErrorNotFound(err) {
+		// Cloud SQL eventually forgets its operations. The export is
+		// considered complete unless its parameters have changed since.
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: cloudsqlexport.IsUpToDate(cr.Spec.ForProvider, cr.GetAnnotations()[v1beta1.AnnotationKeyParametersHash]),
+		}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetExport)
+	}
+	cr.Status.AtProvider = cloudsqlexport.GenerateObservation(*op)
+	setOperationConditions(cr, op)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// The export is run again once it has completed if its parameters
+		// have changed since it was started.
+		ResourceUpToDate: op.Status != cloudsql.OperationDone || cloudsqlexport.IsUpToDate(cr.Spec.ForProvider, cr.GetAnnotations()[v1beta1.AnnotationKeyParametersHash]),
+	}, nil
+}
+
+func (c *cloudsqlExportExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLExport)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLExport)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if err := c.start(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateExport)
+	}
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+// Update runs the export again with the current parameters.
+func (c *cloudsqlExportExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLExport)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLExport)
+	}
+	if err := c.start(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateExport)
+	}
+	return managed.ExternalUpdate{}, errors.Wrap(c.kube.Update(ctx, cr), errManagedUpdateFailed)
+}
+
+func (c *cloudsqlExportExternal) Delete(_ context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.CloudSQLExport)
+	if !ok {
+		return errors.New(errNotCloudSQLExport)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
+
+// start starts the export and records its operation as the external name of
+// the supplied CloudSQLExport, along with the hash of its parameters.
+func (c *cloudsqlExportExternal) start(ctx context.Context, cr *v1beta1.CloudSQLExport) error {
+	op, err := c.db.Export(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), cloudsqlexport.GenerateExportRequest(cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return err
+	}
+	meta.SetExternalName(cr, op.Name)
+	meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyParametersHash: cloudsqlexport.Hash(cr.Spec.ForProvider)})
+	return nil
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqlexport"
+)
+
+const (
+	exportName = "test-export"
+	exportOp   = "export-operation"
+	exportURI  = "gs://" + bucket + "/exports/users.csv"
+	query      = "SELECT * FROM users"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlExportConnector{}
+var _ managed.ExternalClient = &cloudsqlExportExternal{}
+
+type exportModifier func(*v1beta1.CloudSQLExport)
+
+func withExportConditions(c ...xpv1.Condition) exportModifier {
+	return func(e *v1beta1.CloudSQLExport) { e.Status.SetConditions(c...) }
+}
+
+// withExportOperation records the supplied operation as started with the
+// parameters set by the modifiers applied before it.
+func withExportOperation(op string) exportModifier {
+	return func(e *v1beta1.CloudSQLExport) {
+		meta.SetExternalName(e, op)
+		meta.AddAnnotations(e, map[string]string{v1beta1.AnnotationKeyParametersHash: cloudsqlexport.Hash(e.Spec.ForProvider)})
+	}
+}
+
+func withExportObservation(o v1beta1.CloudSQLExportObservation) exportModifier {
+	return func(e *v1beta1.CloudSQLExport) { e.Status.AtProvider = o }
+}
+
+func withExportQuery(q string) exportModifier {
+	return func(e *v1beta1.CloudSQLExport) { e.Spec.ForProvider.CSVExportOptions.SelectQuery = q }
+}
+
+func cloudsqlExport(m ...exportModifier) *v1beta1.CloudSQLExport {
+	e := &v1beta1.CloudSQLExport{
+		ObjectMeta: metav1.ObjectMeta{Name: exportName},
+		Spec: v1beta1.CloudSQLExportSpec{
+			ForProvider: v1beta1.CloudSQLExportParameters{
+				Instance:         gcp.StringPtr(name),
+				Bucket:           gcp.StringPtr(bucket),
+				Object:           "exports/users.csv",
+				FileType:         gcp.StringPtr(v1beta1.FileTypeCSV),
+				Databases:        []string{"db"},
+				CSVExportOptions: &v1beta1.CSVExportOptions{SelectQuery: query},
+			},
+		},
+	}
+	for _, f := range m {
+		f(e)
+	}
+	return e
+}
+
+// exportServer serves a CSV export operation with the supplied status and
+// starts new exports of the supplied query.
+func exportServer(t *testing.T, status, q string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = r.Body.Close()
+			if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/operations/"+exportOp, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{
+				Name:     exportOp,
+				TargetId: name,
+				Status:   status,
+				ExportContext: &sqladmin.ExportContext{
+					Kind:             "sql#exportContext",
+					Uri:              exportURI,
+					FileType:         v1beta1.FileTypeCSV,
+					Databases:        []string{"db"},
+					CsvExportOptions: &sqladmin.ExportContextCsvExportOptions{SelectQuery: query},
+				},
+			})
+		case http.MethodPost:
+			if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/export", r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			req := &sqladmin.InstancesExportRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			want := &sqladmin.InstancesExportRequest{ExportContext: &sqladmin.ExportContext{
+				Uri:              exportURI,
+				FileType:         v1beta1.FileTypeCSV,
+				Databases:        []string{"db"},
+				CsvExportOptions: &sqladmin.ExportContextCsvExportOptions{SelectQuery: q},
+			}}
+			if diff := cmp.Diff(want, req); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: newOp})
+		default:
+			t.Errorf("r: unexpected %s request", r.Method)
+		}
+	})
+}
+
+func TestCloudSQLExportObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotStarted": {
+			mg: cloudsqlExport(),
+			want: want{
+				mg: cloudsqlExport(),
+			},
+		},
+		"OperationForgotten": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: cloudsqlExport(withExportOperation(exportOp)),
+			want: want{
+				mg:  cloudsqlExport(withExportOperation(exportOp)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OperationForgottenSpecChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: cloudsqlExport(withExportOperation(exportOp), withExportQuery("SELECT 1")),
+			want: want{
+				mg:  cloudsqlExport(withExportOperation(exportOp), withExportQuery("SELECT 1")),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"Running": {
+			handler: exportServer(t, "RUNNING", query),
+			mg:      cloudsqlExport(withExportOperation(exportOp), withExportQuery("SELECT 1")),
+			want: want{
+				mg: cloudsqlExport(
+					withExportOperation(exportOp),
+					withExportQuery("SELECT 1"),
+					withExportObservation(v1beta1.CloudSQLExportObservation{URI: exportURI, Status: "RUNNING"}),
+					withExportConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Done": {
+			handler: exportServer(t, cloudsql.OperationDone, query),
+			mg:      cloudsqlExport(withExportOperation(exportOp)),
+			want: want{
+				mg: cloudsqlExport(
+					withExportOperation(exportOp),
+					withExportObservation(v1beta1.CloudSQLExportObservation{URI: exportURI, Status: cloudsql.OperationDone}),
+					withExportConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SpecChanged": {
+			handler: exportServer(t, cloudsql.OperationDone, query),
+			mg:      cloudsqlExport(withExportOperation(exportOp), withExportQuery("SELECT 1")),
+			want: want{
+				mg: cloudsqlExport(
+					withExportOperation(exportOp),
+					withExportQuery("SELECT 1"),
+					withExportObservation(v1beta1.CloudSQLExportObservation{URI: exportURI, Status: cloudsql.OperationDone}),
+					withExportConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlExportExternal{projectID: projectID, db: s.Instances, operations: s.Operations}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLExportCreate(t *testing.T) {
+	server := httptest.NewServer(exportServer(t, "", query))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlExportExternal{projectID: projectID, db: s.Instances, operations: s.Operations}
+	mg := cloudsqlExport()
+	cre, err := e.Create(context.Background(), mg)
+	if err != nil {
+		t.Errorf("Create(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(managed.ExternalCreation{ExternalNameAssigned: true}, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(cloudsqlExport(withExportOperation(newOp), withExportConditions(xpv1.Creating())), mg); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCloudSQLExportUpdate(t *testing.T) {
+	cases := map[string]struct {
+		kube client.Client
+		want *v1beta1.CloudSQLExport
+		err  error
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: cloudsqlExport(withExportQuery("SELECT 1"), withExportOperation(newOp)),
+		},
+		"RecordOperationFails": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: cloudsqlExport(withExportQuery("SELECT 1"), withExportOperation(newOp)),
+			err:  errors.Wrap(errBoom, errManagedUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(exportServer(t, "", "SELECT 1"))
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlExportExternal{kube: tc.kube, projectID: projectID, db: s.Instances, operations: s.Operations}
+			mg := cloudsqlExport(withExportOperation(exportOp), withExportQuery("SELECT 1"))
+			_, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,403 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqlimport"
+)
+
+const (
+	importName = "test-import"
+	importOp   = "import-operation"
+	newOp      = "new-operation"
+	dumpURI    = "gs://" + bucket + "/dumps/prod.sql.gz"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlImportConnector{}
+var _ managed.ExternalClient = &cloudsqlImportExternal{}
+
+type importModifier func(*v1beta1.CloudSQLImport)
+
+func withImportConditions(c ...xpv1.Condition) importModifier {
+	return func(i *v1beta1.CloudSQLImport) { i.Status.SetConditions(c...) }
+}
+
+// withImportOperation records the supplied operation as started with the
+// parameters set by the modifiers applied before it.
+func withImportOperation(op string) importModifier {
+	return func(i *v1beta1.CloudSQLImport) {
+		meta.SetExternalName(i, op)
+		meta.AddAnnotations(i, map[string]string{v1beta1.AnnotationKeyParametersHash: cloudsqlimport.Hash(i.Spec.ForProvider)})
+	}
+}
+
+func withImportObservation(o v1beta1.CloudSQLImportObservation) importModifier {
+	return func(i *v1beta1.CloudSQLImport) { i.Status.AtProvider = o }
+}
+
+func withImportObject(o string) importModifier {
+	return func(i *v1beta1.CloudSQLImport) { i.Spec.ForProvider.Object = o }
+}
+
+var deletedAt = metav1.NewTime(time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC))
+
+func withImportDeleted() importModifier {
+	return func(i *v1beta1.CloudSQLImport) { i.SetDeletionTimestamp(&deletedAt) }
+}
+
+func cloudsqlImport(m ...importModifier) *v1beta1.CloudSQLImport {
+	i := &v1beta1.CloudSQLImport{
+		ObjectMeta: metav1.ObjectMeta{Name: importName},
+		Spec: v1beta1.CloudSQLImportSpec{
+			ForProvider: v1beta1.CloudSQLImportParameters{
+				Instance: gcp.StringPtr(name),
+				Bucket:   gcp.StringPtr(bucket),
+				Object:   "dumps/prod.sql.gz",
+				Database: gcp.StringPtr("db"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func importOperation(status string, m ...func(*sqladmin.Operation)) *sqladmin.Operation {
+	op := &sqladmin.Operation{
+		Name:     importOp,
+		TargetId: name,
+		Status:   status,
+		ImportContext: &sqladmin.ImportContext{
+			Kind:     "sql#importContext",
+			Uri:      dumpURI,
+			FileType: v1beta1.FileTypeSQL,
+			Database: "db",
+		},
+	}
+	for _, f := range m {
+		f(op)
+	}
+	return op
+}
+
+// importServer serves the supplied import operation and starts new imports of
+// the supplied URI.
+func importServer(t *testing.T, op *sqladmin.Operation, uri string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = r.Body.Close()
+			if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/operations/"+importOp, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(op)
+		case http.MethodPost:
+			if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/import", r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			req := &sqladmin.InstancesImportRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			want := &sqladmin.InstancesImportRequest{ImportContext: &sqladmin.ImportContext{
+				Uri:      uri,
+				FileType: v1beta1.FileTypeSQL,
+				Database: "db",
+			}}
+			if diff := cmp.Diff(want, req); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{Name: newOp})
+		default:
+			t.Errorf("r: unexpected %s request", r.Method)
+		}
+	})
+}
+
+func TestCloudSQLImportObserve(t *testing.T) {
+	failed := func(op *sqladmin.Operation) {
+		op.Error = &sqladmin.OperationErrors{Errors: []*sqladmin.OperationError{{Message: "boom"}}}
+	}
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"Deleted": {
+			mg: cloudsqlImport(withImportOperation(importOp), withImportDeleted()),
+			want: want{
+				mg: cloudsqlImport(withImportOperation(importOp), withImportDeleted()),
+			},
+		},
+		"NoInstance": {
+			mg: cloudsqlImport(func(i *v1beta1.CloudSQLImport) { i.Spec.ForProvider.Instance = nil }),
+			want: want{
+				mg:  cloudsqlImport(func(i *v1beta1.CloudSQLImport) { i.Spec.ForProvider.Instance = nil }),
+				err: errors.New(errImportNoInstance),
+			},
+		},
+		"NotStarted": {
+			mg: cloudsqlImport(),
+			want: want{
+				mg: cloudsqlImport(),
+			},
+		},
+		"OperationForgotten": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: cloudsqlImport(withImportOperation(importOp)),
+			want: want{
+				mg:  cloudsqlImport(withImportOperation(importOp)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"OperationForgottenSpecChanged": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: cloudsqlImport(withImportOperation(importOp), withImportObject("dumps/other.sql.gz")),
+			want: want{
+				mg:  cloudsqlImport(withImportOperation(importOp), withImportObject("dumps/other.sql.gz")),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: cloudsqlImport(withImportOperation(importOp)),
+			want: want{
+				mg:  cloudsqlImport(withImportOperation(importOp)),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errGetImport),
+			},
+		},
+		"Running": {
+			handler: importServer(t, importOperation("RUNNING"), dumpURI),
+			mg:      cloudsqlImport(withImportOperation(importOp), withImportObject("dumps/other.sql.gz")),
+			want: want{
+				mg: cloudsqlImport(
+					withImportOperation(importOp),
+					withImportObject("dumps/other.sql.gz"),
+					withImportObservation(v1beta1.CloudSQLImportObservation{URI: dumpURI, Status: "RUNNING"}),
+					withImportConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Done": {
+			handler: importServer(t, importOperation(cloudsql.OperationDone), dumpURI),
+			mg:      cloudsqlImport(withImportOperation(importOp)),
+			want: want{
+				mg: cloudsqlImport(
+					withImportOperation(importOp),
+					withImportObservation(v1beta1.CloudSQLImportObservation{URI: dumpURI, Status: cloudsql.OperationDone}),
+					withImportConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"ContextNormalised": {
+			handler: importServer(t, importOperation(cloudsql.OperationDone, func(op *sqladmin.Operation) {
+				op.ImportContext.FileType = ""
+			}), dumpURI),
+			mg: cloudsqlImport(withImportOperation(importOp)),
+			want: want{
+				mg: cloudsqlImport(
+					withImportOperation(importOp),
+					withImportObservation(v1beta1.CloudSQLImportObservation{URI: dumpURI, Status: cloudsql.OperationDone}),
+					withImportConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			handler: importServer(t, importOperation(cloudsql.OperationDone, failed), dumpURI),
+			mg:      cloudsqlImport(withImportOperation(importOp)),
+			want: want{
+				mg: cloudsqlImport(
+					withImportOperation(importOp),
+					withImportObservation(v1beta1.CloudSQLImportObservation{URI: dumpURI, Status: cloudsql.OperationDone, Error: "boom"}),
+					withImportConditions(xpv1.Unavailable())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"SpecChanged": {
+			handler: importServer(t, importOperation(cloudsql.OperationDone), dumpURI),
+			mg:      cloudsqlImport(withImportOperation(importOp), withImportObject("dumps/other.sql.gz")),
+			want: want{
+				mg: cloudsqlImport(
+					withImportOperation(importOp),
+					withImportObject("dumps/other.sql.gz"),
+					withImportObservation(v1beta1.CloudSQLImportObservation{URI: dumpURI, Status: cloudsql.OperationDone}),
+					withImportConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlImportExternal{projectID: projectID, db: s.Instances, operations: s.Operations}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if tc.want.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLImportCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    *v1beta1.CloudSQLImport
+		cre     managed.ExternalCreation
+		err     error
+	}{
+		"Successful": {
+			handler: importServer(t, nil, dumpURI),
+			want:    cloudsqlImport(withImportOperation(newOp), withImportConditions(xpv1.Creating())),
+			cre:     managed.ExternalCreation{ExternalNameAssigned: true},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: cloudsqlImport(withImportConditions(xpv1.Creating())),
+			err:  errors.Wrap(gError(http.StatusBadRequest, ""), errCreateImport),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlImportExternal{projectID: projectID, db: s.Instances, operations: s.Operations}
+			mg := cloudsqlImport()
+			cre, err := e.Create(context.Background(), mg)
+			if tc.err != nil && err != nil {
+				// the case where our mock server returns error.
+				if diff := cmp.Diff(tc.err.Error(), err.Error()); diff != "" {
+					t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+				}
+			} else if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLImportUpdate(t *testing.T) {
+	otherURI := "gs://" + bucket + "/dumps/other.sql.gz"
+	cases := map[string]struct {
+		kube client.Client
+		want *v1beta1.CloudSQLImport
+		err  error
+	}{
+		"Successful": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: cloudsqlImport(withImportObject("dumps/other.sql.gz"), withImportOperation(newOp)),
+		},
+		"RecordOperationFails": {
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: cloudsqlImport(withImportObject("dumps/other.sql.gz"), withImportOperation(newOp)),
+			err:  errors.Wrap(errBoom, errManagedUpdateFailed),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(importServer(t, nil, otherURI))
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlImportExternal{kube: tc.kube, projectID: projectID, db: s.Instances, operations: s.Operations}
+			mg := cloudsqlImport(withImportOperation(importOp), withImportObject("dumps/other.sql.gz"))
+			_, err := e.Update(context.Background(), mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLImportDelete(t *testing.T) {
+	e := cloudsqlImportExternal{projectID: projectID}
+	mg := cloudsqlImport(withImportOperation(importOp))
+	if err := e.Delete(context.Background(), mg); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(cloudsqlImport(withImportOperation(importOp), withImportConditions(xpv1.Deleting())), mg); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqlsslcert"
+)
+
+const (
+	errNotCloudSQLSSLCert = "managed resource is not a CloudSQLSSLCert custom resource"
+	errSSLCertNoInstance  = "CloudSQLSSLCert does not specify an instance"
+	errGetSSLCert         = "cannot get the CloudSQL SSL certificate"
+	errCreateSSLCert      = "cannot create the CloudSQL SSL certificate"
+	errDeleteSSLCert      = "cannot delete the CloudSQL SSL certificate"
+	errRenewSSLCert       = "cannot renew the CloudSQL SSL certificate"
+	errSSLCertUpdate      = "cannot update CloudSQLSSLCert custom resource"
+	errSSLCertNoInfo      = "the inserted CloudSQL SSL certificate has no fingerprint"
+	errDeleteReplacedCert = "cannot delete the replaced CloudSQL SSL certificate"
+)
+
+// SetupCloudSQLSSLCert adds a controller that reconciles CloudSQLSSLCert
+// managed resources.
+func SetupCloudSQLSSLCert(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.CloudSQLSSLCertGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.CloudSQLSSLCertGroupVersionKind),
+		managed.WithExternalConnecter(&cloudsqlSSLCertConnector{kube: mgr.GetClient()}),
+		// The external name is the SHA1 fingerprint assigned by GCP.
+		managed.WithInitializers(),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.CloudSQLSSLCert{}).
+		Complete(r)
+}
+
+type cloudsqlSSLCertConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlSSLCertConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlSSLCertExternal{kube: c.kube, certs: s.SslCerts, projectID: projectID}, nil
+}
+
+type cloudsqlSSLCertExternal struct {
+	kube      client.Client
+	certs     *sqladmin.SslCertsService
+	projectID string
+}
+
+func (c *cloudsqlSSLCertExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLSSLCert)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLSSLCert)
+	}
+	if cr.Spec.ForProvider.Instance == nil {
+		return managed.ExternalObservation{}, errors.New(errSSLCertNoInstance)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	cert, err := c.certs.Get(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetSSLCert)
+	}
+	cr.Status.AtProvider = cloudsqlsslcert.GenerateObservation(*cert)
+	cr.Status.SetConditions(xpv1.Available())
+
+	// The replaced certificate is kept until its replacement has been
+	// published, so that clients can switch to the new one in the meantime.
+	if fp, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyReplacedCertificate]; ok {
+		if err := c.delete(ctx, cr, fp); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errDeleteReplacedCert)
+		}
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyReplacedCertificate)
+		if err := c.kube.Update(ctx, cr); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errSSLCertUpdate)
+		}
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// The certificate is immutable, but it is renewed ahead of its
+		// expiration.
+		ResourceUpToDate: !cloudsqlsslcert.NeedsRenewal(cr.Spec.ForProvider, *cert, time.Now()),
+		ConnectionDetails: managed.ConnectionDetails{
+			v1beta1.CloudSQLSecretClientCertificateKey: []byte(cert.Cert),
+		},
+	}, nil
+}
+
+func (c *cloudsqlSSLCertExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLSSLCert)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLSSLCert)
+	}
+	cr.SetConditions(xpv1.Creating())
+	rsp, err := c.insert(ctx, cr, cloudsqlsslcert.GenerateInsertRequest(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateSSLCert)
+	}
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    cloudsqlsslcert.GetConnectionDetails(*rsp),
+	}, nil
+}
+
+// Update renews the certificate. The replacement is inserted and published
+// before the expiring certificate is deleted, which happens the next time the
+// certificate is observed.
+func (c *cloudsqlSSLCertExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLSSLCert)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLSSLCert)
+	}
+	old := meta.GetExternalName(cr)
+	rsp, err := c.insert(ctx, cr, cloudsqlsslcert.GenerateRenewalRequest(cr.Spec.ForProvider, time.Now()))
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errRenewSSLCert)
+	}
+	meta.AddAnnotations(cr, map[string]string{v1beta1.AnnotationKeyReplacedCertificate: old})
+	if err := c.kube.Update(ctx, cr); err != nil {
+		// The private key of the replacement cannot be read again, so the
+		// replacement is deleted and the certificate is renewed again. This
+		// is best effort; the update error is the one that is returned.
+		_ = c.delete(ctx, cr, meta.GetExternalName(cr))
+		meta.SetExternalName(cr, old)
+		meta.RemoveAnnotations(cr, v1beta1.AnnotationKeyReplacedCertificate)
+		return managed.ExternalUpdate{}, errors.Wrap(err, errSSLCertUpdate)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: cloudsqlsslcert.GetConnectionDetails(*rsp)}, nil
+}
+
+func (c *cloudsqlSSLCertExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.CloudSQLSSLCert)
+	if !ok {
+		return errors.New(errNotCloudSQLSSLCert)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if fp, ok := cr.GetAnnotations()[v1beta1.AnnotationKeyReplacedCertificate]; ok {
+		if err := c.delete(ctx, cr, fp); err != nil {
+			return errors.Wrap(err, errDeleteReplacedCert)
+		}
+	}
+	return errors.Wrap(c.delete(ctx, cr, meta.GetExternalName(cr)), errDeleteSSLCert)
+}
+
+// insert inserts a new certificate and sets the external name of the supplied
+// CloudSQLSSLCert to its fingerprint.
+func (c *cloudsqlSSLCertExternal) insert(ctx context.Context, cr *v1beta1.CloudSQLSSLCert, req *sqladmin.SslCertsInsertRequest) (*sqladmin.SslCertsInsertResponse, error) {
+	rsp, err := c.certs.Insert(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), req).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if rsp.ClientCert == nil || rsp.ClientCert.CertInfo == nil {
+		return nil, errors.New(errSSLCertNoInfo)
+	}
+	meta.SetExternalName(cr, rsp.ClientCert.CertInfo.Sha1Fingerprint)
+	return rsp, nil
+}
+
+// delete deletes the certificate with the supplied fingerprint.
+func (c *cloudsqlSSLCertExternal) delete(ctx context.Context, cr *v1beta1.CloudSQLSSLCert, fingerprint string) error {
+	_, err := c.certs.Delete(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance), fingerprint).Context(ctx).Do()
+	return resource.Ignore(gcp.IsErrorNotFound, err)
+}
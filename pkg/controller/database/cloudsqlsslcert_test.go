@@ -0,0 +1,399 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	certName       = "test-cert"
+	oldFingerprint = "old-fingerprint"
+	newFingerprint = "new-fingerprint"
+	notExpiring    = "2099-01-01T00:00:00Z"
+	expiring       = "2000-01-01T00:00:00Z"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlSSLCertConnector{}
+var _ managed.ExternalClient = &cloudsqlSSLCertExternal{}
+
+type sslCertModifier func(*v1beta1.CloudSQLSSLCert)
+
+func withSSLCertConditions(c ...xpv1.Condition) sslCertModifier {
+	return func(s *v1beta1.CloudSQLSSLCert) { s.Status.SetConditions(c...) }
+}
+
+func withFingerprint(fp string) sslCertModifier {
+	return func(s *v1beta1.CloudSQLSSLCert) { meta.SetExternalName(s, fp) }
+}
+
+func withReplacedCert(fp string) sslCertModifier {
+	return func(s *v1beta1.CloudSQLSSLCert) {
+		meta.AddAnnotations(s, map[string]string{v1beta1.AnnotationKeyReplacedCertificate: fp})
+	}
+}
+
+func withSSLCertObservation(o v1beta1.CloudSQLSSLCertObservation) sslCertModifier {
+	return func(s *v1beta1.CloudSQLSSLCert) { s.Status.AtProvider = o }
+}
+
+func sslCert(m ...sslCertModifier) *v1beta1.CloudSQLSSLCert {
+	s := &v1beta1.CloudSQLSSLCert{
+		ObjectMeta: metav1.ObjectMeta{Name: certName},
+		Spec: v1beta1.CloudSQLSSLCertSpec{
+			ForProvider: v1beta1.CloudSQLSSLCertParameters{
+				Instance:   gcp.StringPtr(name),
+				CommonName: certName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+// sslCertServer serves a certificate that expires at the supplied time,
+// inserts certificates with a new fingerprint and deletes the certificate with
+// the old fingerprint.
+func sslCertServer(t *testing.T, exp string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			_ = r.Body.Close()
+			_ = json.NewEncoder(w).Encode(&sqladmin.SslCert{Cert: "cert", Sha1Fingerprint: oldFingerprint, ExpirationTime: exp})
+		case http.MethodPost:
+			req := &sqladmin.SslCertsInsertRequest{}
+			_ = json.NewDecoder(r.Body).Decode(req)
+			// Renewed certificates are suffixed with the time of renewal.
+			if req.CommonName != certName && !strings.HasPrefix(req.CommonName, certName+"-") {
+				t.Errorf("r: unexpected common name %q", req.CommonName)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.SslCertsInsertResponse{
+				ClientCert: &sqladmin.SslCertDetail{
+					CertInfo:       &sqladmin.SslCert{Cert: "new-cert", Sha1Fingerprint: newFingerprint},
+					CertPrivateKey: "new-key",
+				},
+			})
+		case http.MethodDelete:
+			_ = r.Body.Close()
+			if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/sslCerts/"+oldFingerprint, r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+		}
+	})
+}
+
+func TestCloudSQLSSLCertObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NoExternalName": {
+			mg: sslCert(),
+			want: want{
+				mg: sslCert(),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.SslCert{})
+			}),
+			mg: sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg: sslCert(withFingerprint(oldFingerprint)),
+			},
+		},
+		"UpToDate": {
+			handler: sslCertServer(t, notExpiring),
+			mg:      sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg: sslCert(
+					withFingerprint(oldFingerprint),
+					withSSLCertObservation(v1beta1.CloudSQLSSLCertObservation{Sha1Fingerprint: oldFingerprint, ExpirationTime: notExpiring}),
+					withSSLCertConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{v1beta1.CloudSQLSecretClientCertificateKey: []byte("cert")},
+				},
+			},
+		},
+		"NeedsRenewal": {
+			handler: sslCertServer(t, expiring),
+			mg:      sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg: sslCert(
+					withFingerprint(oldFingerprint),
+					withSSLCertObservation(v1beta1.CloudSQLSSLCertObservation{Sha1Fingerprint: oldFingerprint, ExpirationTime: expiring}),
+					withSSLCertConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{v1beta1.CloudSQLSecretClientCertificateKey: []byte("cert")},
+				},
+			},
+		},
+		"DeletesReplacedCert": {
+			handler: sslCertServer(t, notExpiring),
+			kube:    &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:      sslCert(withFingerprint(newFingerprint), withReplacedCert(oldFingerprint)),
+			want: want{
+				mg: sslCert(
+					withFingerprint(newFingerprint),
+					withSSLCertObservation(v1beta1.CloudSQLSSLCertObservation{Sha1Fingerprint: oldFingerprint, ExpirationTime: notExpiring}),
+					withSSLCertConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{v1beta1.CloudSQLSecretClientCertificateKey: []byte("cert")},
+				},
+			},
+		},
+		"DeleteReplacedCertFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(http.StatusBadRequest)
+					_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.SslCert{Cert: "new-cert", Sha1Fingerprint: newFingerprint, ExpirationTime: notExpiring})
+			}),
+			mg: sslCert(withFingerprint(newFingerprint), withReplacedCert(oldFingerprint)),
+			want: want{
+				mg: sslCert(
+					withFingerprint(newFingerprint),
+					withReplacedCert(oldFingerprint),
+					withSSLCertObservation(v1beta1.CloudSQLSSLCertObservation{Sha1Fingerprint: newFingerprint, ExpirationTime: notExpiring}),
+					withSSLCertConditions(xpv1.Available())),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteReplacedCert),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlSSLCertExternal{kube: tc.kube, projectID: projectID, certs: s.SslCerts}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLSSLCertCreate(t *testing.T) {
+	server := httptest.NewServer(sslCertServer(t, notExpiring))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlSSLCertExternal{projectID: projectID, certs: s.SslCerts}
+	cr := sslCert()
+	cre, err := e.Create(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	want := managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails: managed.ConnectionDetails{
+			v1beta1.CloudSQLSecretClientCertificateKey: []byte("new-cert"),
+			v1beta1.CloudSQLSecretClientPrivateKeyKey:  []byte("new-key"),
+		},
+	}
+	if diff := cmp.Diff(want, cre); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(sslCert(withFingerprint(newFingerprint), withSSLCertConditions(xpv1.Creating())), cr); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCloudSQLSSLCertUpdate(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		upd managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"Renewed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("r: the expiring certificate must not be deleted before its replacement is published, got %s", r.Method)
+				}
+				sslCertServer(t, expiring).ServeHTTP(w, r)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			mg:   sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg: sslCert(withFingerprint(newFingerprint), withReplacedCert(oldFingerprint)),
+				upd: managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+					v1beta1.CloudSQLSecretClientCertificateKey: []byte("new-cert"),
+					v1beta1.CloudSQLSecretClientPrivateKeyKey:  []byte("new-key"),
+				}},
+			},
+		},
+		"InsertFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			mg: sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg:  sslCert(withFingerprint(oldFingerprint)),
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errRenewSSLCert),
+			},
+		},
+		"NoCertInfo": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&sqladmin.SslCertsInsertResponse{})
+			}),
+			mg: sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg:  sslCert(withFingerprint(oldFingerprint)),
+				err: errors.Wrap(errors.New(errSSLCertNoInfo), errRenewSSLCert),
+			},
+		},
+		"UpdateFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					// The replacement is deleted rather than the expiring
+					// certificate.
+					if diff := cmp.Diff("/sql/v1beta4/projects/"+projectID+"/instances/"+name+"/sslCerts/"+newFingerprint, r.URL.Path); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = r.Body.Close()
+					_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+					return
+				}
+				sslCertServer(t, expiring).ServeHTTP(w, r)
+			}),
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			mg:   sslCert(withFingerprint(oldFingerprint)),
+			want: want{
+				mg:  sslCert(withFingerprint(oldFingerprint)),
+				err: errors.Wrap(errBoom, errSSLCertUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlSSLCertExternal{kube: tc.kube, projectID: projectID, certs: s.SslCerts}
+			upd, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upd, upd); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLSSLCertDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    error
+	}{
+		"Successful": {
+			handler: sslCertServer(t, notExpiring),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteSSLCert),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlSSLCertExternal{projectID: projectID, certs: s.SslCerts}
+			err := e.Delete(context.Background(), sslCert(withFingerprint(oldFingerprint)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsqluser"
+)
+
+const (
+	errNotCloudSQLUser      = "managed resource is not a CloudSQLUser custom resource"
+	errUserNoInstance       = "CloudSQLUser does not specify an instance"
+	errGetUser              = "cannot get the CloudSQL user"
+	errCreateUser           = "cannot create the CloudSQL user"
+	errUpdateUser           = "cannot update the CloudSQL user"
+	errDeleteUser           = "cannot delete the CloudSQL user"
+	errGetUserPassword      = "cannot get the password of the CloudSQL user"
+	errGenerateUserPassword = "cannot generate the password of the CloudSQL user"
+)
+
+// SetupCloudSQLUser adds a controller that reconciles CloudSQLUser managed
+// resources.
+func SetupCloudSQLUser(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1beta1.CloudSQLUserGroupKind)
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1beta1.CloudSQLUserGroupVersionKind),
+		managed.WithExternalConnecter(&cloudsqlUserConnector{kube: mgr.GetClient()}),
+		managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		managed.WithLogger(l.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1beta1.CloudSQLUser{}).
+		Complete(r)
+}
+
+type cloudsqlUserConnector struct {
+	kube client.Client
+}
+
+func (c *cloudsqlUserConnector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.kube, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := sqladmin.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &cloudsqlUserExternal{kube: c.kube, users: s.Users, instances: s.Instances, projectID: projectID}, nil
+}
+
+type cloudsqlUserExternal struct {
+	kube      client.Client
+	users     *sqladmin.UsersService
+	instances *sqladmin.InstancesService
+	projectID string
+}
+
+func (c *cloudsqlUserExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCloudSQLUser)
+	}
+	if cr.Spec.ForProvider.Instance == nil {
+		return managed.ExternalObservation{}, errors.New(errUserNoInstance)
+	}
+	instanceName := gcp.StringValue(cr.Spec.ForProvider.Instance)
+	user, err := c.users.Get(c.projectID, instanceName, meta.GetExternalName(cr)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGetUser)
+	}
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	cloudsqluser.LateInitializeSpec(&cr.Spec.ForProvider, *user)
+
+	_, changed, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetUserPassword)
+	}
+
+	instance, err := c.instances.Get(c.projectID, instanceName).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetFailed)
+	}
+	// The password is omitted here; it is published only when it is set.
+	cd := instanceConnectionDetails(cr.Spec.ForProvider.ConnectionEndpoint, cloudsql.GenerateObservation(*instance).IPAddresses, instance)
+	cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(meta.GetExternalName(cr))
+
+	cr.Status.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        !changed,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ConnectionDetails:       cd,
+	}, nil
+}
+
+func (c *cloudsqlUserExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCloudSQLUser)
+	}
+	cr.SetConditions(xpv1.Creating())
+	pw, _, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef, nil)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetUserPassword)
+	}
+	if pw == "" {
+		if pw, err = password.Generate(); err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGenerateUserPassword)
+		}
+	}
+	user := &sqladmin.User{}
+	cloudsqluser.GenerateUser(meta.GetExternalName(cr), pw, cr.Spec.ForProvider, user)
+	if _, err := c.users.Insert(c.projectID, user.Instance, user).Context(ctx).Do(); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateUser)
+	}
+	return managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}}, nil
+}
+
+func (c *cloudsqlUserExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1beta1.CloudSQLUser)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCloudSQLUser)
+	}
+	// The password is the only field of a user that can be updated.
+	pw, _, err := cloudsql.GetPassword(ctx, c.kube, cr.Spec.ForProvider.PasswordSecretRef, nil)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetUserPassword)
+	}
+	if pw == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	user := &sqladmin.User{}
+	cloudsqluser.GenerateUser(meta.GetExternalName(cr), pw, cr.Spec.ForProvider, user)
+	call := c.users.Update(c.projectID, user.Instance, user).Name(user.Name)
+	if user.Host != "" {
+		call = call.Host(user.Host)
+	}
+	if _, err := call.Context(ctx).Do(); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateUser)
+	}
+	cloudsql.SetPasswordHash(cr, pw)
+	if err := c.kube.Update(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errManagedUpdateFailed)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	}}, nil
+}
+
+func (c *cloudsqlUserExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.CloudSQLUser)
+	if !ok {
+		return errors.New(errNotCloudSQLUser)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	call := c.users.Delete(c.projectID, gcp.StringValue(cr.Spec.ForProvider.Instance)).Name(meta.GetExternalName(cr))
+	if cr.Spec.ForProvider.Host != nil {
+		call = call.Host(*cr.Spec.ForProvider.Host)
+	}
+	_, err := call.Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDeleteUser)
+}
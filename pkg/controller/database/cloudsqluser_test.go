@@ -0,0 +1,423 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/api/option"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/database/v1beta1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/cloudsql"
+)
+
+const (
+	userName     = "test-user"
+	userHost     = "%"
+	userPassword = "sup3rs3cr3t"
+	publicIP     = "10.0.0.1"
+)
+
+var _ managed.ExternalConnecter = &cloudsqlUserConnector{}
+var _ managed.ExternalClient = &cloudsqlUserExternal{}
+
+type userModifier func(*v1beta1.CloudSQLUser)
+
+func withUserConditions(c ...xpv1.Condition) userModifier {
+	return func(u *v1beta1.CloudSQLUser) { u.Status.SetConditions(c...) }
+}
+
+func withHost(h string) userModifier {
+	return func(u *v1beta1.CloudSQLUser) { u.Spec.ForProvider.Host = gcp.StringPtr(h) }
+}
+
+func withPasswordSecretRef() userModifier {
+	return func(u *v1beta1.CloudSQLUser) {
+		u.Spec.ForProvider.PasswordSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "password", Namespace: "default"},
+			Key:             "password",
+		}
+	}
+}
+
+func withConnectionSecretRef() userModifier {
+	return func(u *v1beta1.CloudSQLUser) {
+		u.SetWriteConnectionSecretToReference(&xpv1.SecretReference{Name: "connection", Namespace: "default"})
+	}
+}
+
+func withUserPasswordHash(pw string) userModifier {
+	return func(u *v1beta1.CloudSQLUser) { cloudsql.SetPasswordHash(u, pw) }
+}
+
+func user(m ...userModifier) *v1beta1.CloudSQLUser {
+	u := &v1beta1.CloudSQLUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: userName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: userName,
+			},
+		},
+		Spec: v1beta1.CloudSQLUserSpec{
+			ForProvider: v1beta1.CloudSQLUserParameters{
+				Instance: gcp.StringPtr(name),
+			},
+		},
+	}
+	for _, f := range m {
+		f(u)
+	}
+	return u
+}
+
+// userServer serves the supplied user and a running instance with a public IP.
+func userServer(t *testing.T, u *sqladmin.User) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/users/"+userName):
+			if u == nil {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.User{})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(u)
+		case strings.HasSuffix(r.URL.Path, "/instances/"+name):
+			_ = json.NewEncoder(w).Encode(&sqladmin.DatabaseInstance{
+				ConnectionName: connectionName,
+				Settings:       &sqladmin.Settings{},
+				IpAddresses:    []*sqladmin.IpMapping{{IpAddress: publicIP, Type: v1beta1.PublicIPType}},
+			})
+		default:
+			t.Errorf("unexpected request path %s", r.URL.Path)
+		}
+	})
+}
+
+func userConnDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(userName),
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(publicIP),
+		v1beta1.PublicIPKey:                       []byte(publicIP),
+		v1beta1.CloudSQLSecretConnectionName:      []byte(connectionName),
+		v1beta1.CloudSQLSecretAuthProxyCommandKey: []byte("cloud-sql-proxy " + connectionName),
+	}
+}
+
+func TestCloudSQLUserObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"NoInstance": {
+			mg: &v1beta1.CloudSQLUser{},
+			want: want{
+				mg:  &v1beta1.CloudSQLUser{},
+				err: errors.New(errUserNoInstance),
+			},
+		},
+		"NotFound": {
+			handler: userServer(t, nil),
+			mg:      user(),
+			want: want{
+				mg: user(),
+			},
+		},
+		"LateInitialized": {
+			handler: userServer(t, &sqladmin.User{Name: userName, Host: userHost}),
+			mg:      user(),
+			want: want{
+				mg: user(withHost(userHost), withUserConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails:       userConnDetails(),
+				},
+			},
+		},
+		"GetPasswordFailed": {
+			handler: userServer(t, &sqladmin.User{Name: userName}),
+			kube:    &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:      user(withPasswordSecretRef()),
+			want: want{
+				mg:  user(withPasswordSecretRef()),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get password secret"), errGetUserPassword),
+			},
+		},
+		"PasswordChanged": {
+			handler: userServer(t, &sqladmin.User{Name: userName}),
+			kube:    &test.MockClient{MockGet: passwordSecret("new")},
+			mg:      user(withPasswordSecretRef(), withConnectionSecretRef(), withUserPasswordHash(userPassword)),
+			want: want{
+				mg: user(withPasswordSecretRef(), withConnectionSecretRef(), withUserPasswordHash(userPassword), withUserConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: userConnDetails(),
+				},
+			},
+		},
+		"PasswordChangedNoConnectionSecret": {
+			handler: userServer(t, &sqladmin.User{Name: userName}),
+			kube:    &test.MockClient{MockGet: passwordSecret("new")},
+			mg:      user(withPasswordSecretRef(), withUserPasswordHash(userPassword)),
+			want: want{
+				mg: user(withPasswordSecretRef(), withUserPasswordHash(userPassword), withUserConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: userConnDetails(),
+				},
+			},
+		},
+		"PasswordUnchanged": {
+			handler: userServer(t, &sqladmin.User{Name: userName}),
+			kube:    &test.MockClient{MockGet: passwordSecret(userPassword)},
+			mg:      user(withPasswordSecretRef(), withUserPasswordHash(userPassword)),
+			want: want{
+				mg: user(withPasswordSecretRef(), withUserPasswordHash(userPassword), withUserConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: userConnDetails(),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlUserExternal{kube: tc.kube, projectID: projectID, users: s.Users, instances: s.Instances}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLUserCreate(t *testing.T) {
+	type want struct {
+		cre managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		kube    client.Client
+		mg      resource.Managed
+		want    want
+	}{
+		"SuppliedPassword": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				got := &sqladmin.User{}
+				_ = json.NewDecoder(r.Body).Decode(got)
+				want := &sqladmin.User{Name: userName, Instance: name, Host: userHost, Password: userPassword}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			kube: &test.MockClient{MockGet: passwordSecret(userPassword)},
+			mg:   user(withHost(userHost), withPasswordSecretRef()),
+			want: want{
+				cre: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(userPassword),
+				}},
+			},
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			kube: &test.MockClient{MockGet: passwordSecret(userPassword)},
+			mg:   user(withPasswordSecretRef()),
+			want: want{
+				err: errors.Wrap(gError(http.StatusBadRequest, ""), errCreateUser),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlUserExternal{kube: tc.kube, projectID: projectID, users: s.Users, instances: s.Instances}
+			cre, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCloudSQLUserCreateGeneratedPassword(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := &sqladmin.User{}
+		_ = json.NewDecoder(r.Body).Decode(got)
+		sent = got.Password
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	}))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlUserExternal{projectID: projectID, users: s.Users, instances: s.Instances}
+	cre, err := e.Create(context.Background(), user())
+	if err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	if sent == "" || sent != string(cre.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey]) {
+		t.Errorf("Create(...): published password %q does not match sent password %q", cre.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey], sent)
+	}
+}
+
+func TestCloudSQLUserUpdate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if diff := cmp.Diff(http.MethodPut, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(userName, r.URL.Query().Get("name")); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff(userHost, r.URL.Query().Get("host")); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		got := &sqladmin.User{}
+		_ = json.NewDecoder(r.Body).Decode(got)
+		if diff := cmp.Diff("new", got.Password); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+	}))
+	defer server.Close()
+	s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	e := cloudsqlUserExternal{
+		kube:      &test.MockClient{MockGet: passwordSecret("new"), MockUpdate: test.NewMockUpdateFn(nil)},
+		projectID: projectID,
+		users:     s.Users,
+		instances: s.Instances,
+	}
+	cr := user(withHost(userHost), withPasswordSecretRef(), withUserPasswordHash(userPassword))
+	upd, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff(user(withHost(userHost), withPasswordSecretRef(), withUserPasswordHash("new")), cr); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+	want := managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
+	}}
+	if diff := cmp.Diff(want, upd); diff != "" {
+		t.Errorf("Update(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestCloudSQLUserDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		want    error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(userName, r.URL.Query().Get("name")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+				_ = json.NewEncoder(w).Encode(&sqladmin.Operation{})
+			}),
+			want: errors.Wrap(gError(http.StatusBadRequest, ""), errDeleteUser),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(tc.handler)
+			defer server.Close()
+			s, _ := sqladmin.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+			e := cloudsqlUserExternal{projectID: projectID, users: s.Users, instances: s.Instances}
+			err := e.Delete(context.Background(), user())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
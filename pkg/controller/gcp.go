@@ -27,6 +27,7 @@ import (
 	"github.com/crossplane/provider-gcp/pkg/controller/config"
 	"github.com/crossplane/provider-gcp/pkg/controller/container"
 	"github.com/crossplane/provider-gcp/pkg/controller/database"
+	"github.com/crossplane/provider-gcp/pkg/controller/gkehub"
 	"github.com/crossplane/provider-gcp/pkg/controller/iam"
 	"github.com/crossplane/provider-gcp/pkg/controller/kms"
 	"github.com/crossplane/provider-gcp/pkg/controller/pubsub"
@@ -40,15 +41,39 @@ func Setup(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
 	for _, setup := range []func(ctrl.Manager, logging.Logger, workqueue.RateLimiter) error{
 		config.Setup,
 		cache.SetupCloudMemorystoreInstance,
+		compute.SetupBackendService,
+		compute.SetupDisk,
 		compute.SetupGlobalAddress,
+		compute.SetupGlobalForwardingRule,
+		compute.SetupHealthCheck,
 		compute.SetupNetwork,
+		compute.SetupNetworkPeering,
+		compute.SetupResourcePolicy,
+		compute.SetupSSLCertificate,
+		compute.SetupSecurityPolicy,
+		compute.SetupSharedVPCHostProject,
+		compute.SetupSharedVPCServiceProject,
+		compute.SetupSnapshot,
 		compute.SetupSubnetwork,
+		compute.SetupSubnetworkIAMMember,
+		compute.SetupTargetHTTPSProxy,
+		compute.SetupURLMap,
 		container.SetupCluster,
 		container.SetupNodePool,
 		database.SetupCloudSQLInstance,
+		database.SetupCloudSQLDatabase,
+		database.SetupCloudSQLUser,
+		database.SetupCloudSQLSSLCert,
+		database.SetupCloudSQLBackupRun,
+		database.SetupCloudSQLImport,
+		database.SetupCloudSQLExport,
+		gkehub.SetupFeature,
+		gkehub.SetupFeatureMembership,
+		gkehub.SetupMembership,
 		iam.SetupServiceAccount,
 		iam.SetupServiceAccountKey,
 		iam.SetupServiceAccountPolicy,
+		iam.SetupWorkloadIdentityBinding,
 		kms.SetupKeyRing,
 		kms.SetupCryptoKey,
 		kms.SetupCryptoKeyPolicy,
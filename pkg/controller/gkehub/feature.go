@@ -0,0 +1,156 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/feature"
+)
+
+const errNotFeature = "managed resource is not a GKE Hub Feature"
+
+// SetupFeature adds a controller that reconciles Features.
+func SetupFeature(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.FeatureGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Feature{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FeatureGroupVersionKind),
+			managed.WithExternalConnecter(&featureConnecter{client: mgr.GetClient()}),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type featureConnecter struct {
+	client client.Client
+}
+
+// Connect sets up GKE Hub client using credentials from the provider
+func (c *featureConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := gkehub.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &featureExternal{kube: c.client, projectID: projectID, features: gkehub.NewProjectsLocationsFeaturesService(s)}, nil
+}
+
+type featureExternal struct {
+	kube      client.Client
+	projectID string
+	features  feature.Client
+}
+
+func (e *featureExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFeature)
+	}
+
+	instance, err := e.features.Get(feature.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGet)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	feature.LateInitializeSpec(&cr.Spec.ForProvider, *instance)
+
+	cr.Status.AtProvider = feature.GenerateObservation(*instance)
+	switch cr.Status.AtProvider.ResourceState {
+	case v1alpha1.FeatureResourceStateActive, v1alpha1.FeatureResourceStateUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.FeatureResourceStateEnabling:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.FeatureResourceStateDisabling:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        feature.IsUpToDate(&cr.Spec.ForProvider, instance),
+	}, nil
+}
+
+func (e *featureExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFeature)
+	}
+	cr.SetConditions(xpv1.Creating())
+	instance := &gkehub.Feature{}
+	feature.GenerateFeature(cr.Spec.ForProvider, instance)
+
+	_, err := e.features.Create(feature.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), instance).
+		FeatureId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *featureExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFeature)
+	}
+	instance := &gkehub.Feature{}
+	feature.GenerateFeature(cr.Spec.ForProvider, instance)
+
+	_, err := e.features.Patch(feature.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr)), instance).
+		UpdateMask("labels").Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *featureExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Feature)
+	if !ok {
+		return errors.New(errNotFeature)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.ResourceState == v1alpha1.FeatureResourceStateDisabling {
+		return nil
+	}
+	_, err := e.features.Delete(feature.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDelete)
+}
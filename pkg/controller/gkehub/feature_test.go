@@ -0,0 +1,213 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+)
+
+type featureModifier func(*v1alpha1.Feature)
+
+func fWithConditions(c ...xpv1.Condition) featureModifier {
+	return func(f *v1alpha1.Feature) { f.Status.SetConditions(c...) }
+}
+
+func fWithResourceState(s string) featureModifier {
+	return func(f *v1alpha1.Feature) { f.Status.AtProvider.ResourceState = s }
+}
+
+func hubFeature(im ...featureModifier) *v1alpha1.Feature {
+	f := &v1alpha1.Feature{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "configmanagement",
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: "configmanagement",
+			},
+		},
+		Spec: v1alpha1.FeatureSpec{
+			ForProvider: v1alpha1.FeatureParameters{Location: "global"},
+		},
+	}
+	for _, m := range im {
+		m(f)
+	}
+	return f
+}
+
+func newFeatureExternal(t *testing.T, handler http.Handler) (*featureExternal, func()) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	s, err := gkehub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &featureExternal{projectID: project, features: gkehub.NewProjectsLocationsFeaturesService(s)}, server.Close
+}
+
+func TestFeatureObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFeature": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			mg:      &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotFeature),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: hubFeature(),
+			want: want{
+				mg: hubFeature(),
+			},
+		},
+		"Active": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(featurePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Feature{
+					ResourceState: &gkehub.FeatureResourceState{State: v1alpha1.FeatureResourceStateActive},
+				})
+			}),
+			mg: hubFeature(),
+			want: want{
+				mg: hubFeature(fWithResourceState(v1alpha1.FeatureResourceStateActive), fWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Enabling": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&gkehub.Feature{
+					ResourceState: &gkehub.FeatureResourceState{State: v1alpha1.FeatureResourceStateEnabling},
+				})
+			}),
+			mg: hubFeature(),
+			want: want{
+				mg: hubFeature(fWithResourceState(v1alpha1.FeatureResourceStateEnabling), fWithConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newFeatureExternal(t, tc.handler)
+			defer done()
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeatureCreate(t *testing.T) {
+	e, done := newFeatureExternal(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.Body.Close()
+		if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		if diff := cmp.Diff("configmanagement", r.URL.Query().Get("featureId")); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+	}))
+	defer done()
+	if _, err := e.Create(context.Background(), hubFeature()); err != nil {
+		t.Errorf("Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestFeatureDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+			}),
+			mg: hubFeature(),
+		},
+		"AlreadyDisabling": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}),
+			mg: hubFeature(fWithResourceState(v1alpha1.FeatureResourceStateDisabling)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newFeatureExternal(t, tc.handler)
+			defer done()
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Errorf("Delete(...): unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	fm "github.com/crossplane/provider-gcp/pkg/clients/featuremembership"
+)
+
+const errNotFeatureMembership = "managed resource is not a GKE Hub FeatureMembership"
+
+// SetupFeatureMembership adds a controller that reconciles
+// FeatureMemberships.
+func SetupFeatureMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.FeatureMembershipGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.FeatureMembership{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.FeatureMembershipGroupVersionKind),
+			managed.WithExternalConnecter(&featureMembershipConnecter{client: mgr.GetClient()}),
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type featureMembershipConnecter struct {
+	client client.Client
+}
+
+// Connect sets up GKE Hub client using credentials from the provider
+func (c *featureMembershipConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := gkehub.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &featureMembershipExternal{kube: c.client, projectID: projectID, features: gkehub.NewProjectsLocationsFeaturesService(s)}, nil
+}
+
+// featureMembershipExternal configures a Feature for a single Membership by
+// patching the membership specs of the Feature. A FeatureMembership exists
+// if the Feature has a non-empty spec for its Membership.
+type featureMembershipExternal struct {
+	kube      client.Client
+	projectID string
+	features  fm.Client
+}
+
+func (e *featureMembershipExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.FeatureMembership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotFeatureMembership)
+	}
+
+	f, err := e.features.Get(fm.GetFullyQualifiedFeatureName(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGet)
+	}
+	spec := fm.FindMembershipSpec(f, cr.Spec.ForProvider)
+	if spec == nil {
+		return managed.ExternalObservation{}, nil
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	fm.LateInitializeSpec(&cr.Spec.ForProvider, *spec)
+
+	cr.Status.AtProvider = fm.GenerateObservation(fm.FindMembershipState(f, cr.Spec.ForProvider))
+	cr.Status.SetConditions(xpv1.Available())
+
+	upToDate, err := fm.IsUpToDate(&cr.Spec.ForProvider, spec)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        upToDate,
+	}, nil
+}
+
+func (e *featureMembershipExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.FeatureMembership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotFeatureMembership)
+	}
+	cr.SetConditions(xpv1.Creating())
+	spec := gkehub.MembershipFeatureSpec{}
+	fm.GenerateMembershipSpec(cr.Spec.ForProvider, &spec)
+
+	_, err := e.features.Patch(fm.GetFullyQualifiedFeatureName(e.projectID, cr.Spec.ForProvider), fm.GenerateFeatureUpdate(e.projectID, cr.Spec.ForProvider, spec)).
+		UpdateMask(fm.UpdateMask).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *featureMembershipExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.FeatureMembership)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotFeatureMembership)
+	}
+	// We have to get the feature again here so that we only change the
+	// configuration we manage.
+	f, err := e.features.Get(fm.GetFullyQualifiedFeatureName(e.projectID, cr.Spec.ForProvider)).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+	spec := gkehub.MembershipFeatureSpec{}
+	if observed := fm.FindMembershipSpec(f, cr.Spec.ForProvider); observed != nil {
+		spec = *observed
+	}
+	fm.GenerateMembershipSpec(cr.Spec.ForProvider, &spec)
+
+	_, err = e.features.Patch(fm.GetFullyQualifiedFeatureName(e.projectID, cr.Spec.ForProvider), fm.GenerateFeatureUpdate(e.projectID, cr.Spec.ForProvider, spec)).
+		UpdateMask(fm.UpdateMask).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *featureMembershipExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.FeatureMembership)
+	if !ok {
+		return errors.New(errNotFeatureMembership)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// An empty spec removes the configuration of the Feature for the
+	// Membership.
+	_, err := e.features.Patch(fm.GetFullyQualifiedFeatureName(e.projectID, cr.Spec.ForProvider), fm.GenerateFeatureUpdate(e.projectID, cr.Spec.ForProvider, gkehub.MembershipFeatureSpec{})).
+		UpdateMask(fm.UpdateMask).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDelete)
+}
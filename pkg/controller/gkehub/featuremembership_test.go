@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+)
+
+const (
+	featurePath = "/v1/projects/cool-project/locations/global/features/configmanagement"
+
+	// The API returns membership keys with the project number.
+	observedMembershipKey = "projects/123456789/locations/global/memberships/cool-membership"
+	membershipKey         = "projects/cool-project/locations/global/memberships/cool-membership"
+)
+
+type featureMembershipModifier func(*v1alpha1.FeatureMembership)
+
+func fmWithConditions(c ...xpv1.Condition) featureMembershipModifier {
+	return func(f *v1alpha1.FeatureMembership) { f.Status.SetConditions(c...) }
+}
+
+func fmWithObservation(o v1alpha1.FeatureMembershipObservation) featureMembershipModifier {
+	return func(f *v1alpha1.FeatureMembership) { f.Status.AtProvider = o }
+}
+
+func fmWithSyncWaitSecs(s int64) featureMembershipModifier {
+	return func(f *v1alpha1.FeatureMembership) {
+		f.Spec.ForProvider.ConfigManagement.ConfigSync.Git.SyncWaitSecs = &s
+	}
+}
+
+func featureMembership(im ...featureMembershipModifier) *v1alpha1.FeatureMembership {
+	f := &v1alpha1.FeatureMembership{
+		ObjectMeta: metav1.ObjectMeta{Name: "cool-feature-membership"},
+		Spec: v1alpha1.FeatureMembershipSpec{
+			ForProvider: v1alpha1.FeatureMembershipParameters{
+				Location:   "global",
+				Feature:    gcp.StringPtr("configmanagement"),
+				Membership: gcp.StringPtr(membershipName),
+				ConfigManagement: &v1alpha1.ConfigManagement{
+					ConfigSync: &v1alpha1.ConfigSync{
+						Git: &v1alpha1.GitConfig{SyncRepo: "https://github.com/cool/repo"},
+					},
+				},
+			},
+		},
+	}
+	for _, m := range im {
+		m(f)
+	}
+	return f
+}
+
+func configManagementSpec(syncWaitSecs int64) gkehub.MembershipFeatureSpec {
+	return gkehub.MembershipFeatureSpec{
+		Configmanagement: &gkehub.ConfigManagementMembershipSpec{
+			ConfigSync: &gkehub.ConfigManagementConfigSync{
+				Git: &gkehub.ConfigManagementGitConfig{SyncRepo: "https://github.com/cool/repo", SyncWaitSecs: syncWaitSecs},
+			},
+		},
+	}
+}
+
+func newFeatureMembershipExternal(t *testing.T, handler http.Handler) (*featureMembershipExternal, func()) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	s, err := gkehub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &featureMembershipExternal{projectID: project, features: gkehub.NewProjectsLocationsFeaturesService(s)}, server.Close
+}
+
+// decodeMembershipSpecs decodes the membership specs of a patched feature and
+// checks the update mask of the request.
+func decodeMembershipSpecs(t *testing.T, r *http.Request) map[string]gkehub.MembershipFeatureSpec {
+	t.Helper()
+	if diff := cmp.Diff(http.MethodPatch, r.Method); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("membershipSpecs", r.URL.Query().Get("updateMask")); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	f := &gkehub.Feature{}
+	_ = json.NewDecoder(r.Body).Decode(f)
+	return f.MembershipSpecs
+}
+
+func TestFeatureMembershipObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotFeatureMembership": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			mg:      &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotFeatureMembership),
+			},
+		},
+		"FeatureNotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: featureMembership(),
+			want: want{
+				mg: featureMembership(),
+			},
+		},
+		"MembershipNotConfigured": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&gkehub.Feature{
+					MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{observedMembershipKey: {}},
+				})
+			}),
+			mg: featureMembership(),
+			want: want{
+				mg: featureMembership(),
+			},
+		},
+		"Available": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(featurePath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Feature{
+					MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{observedMembershipKey: configManagementSpec(15)},
+					MembershipStates: map[string]gkehub.MembershipFeatureState{observedMembershipKey: {
+						State: &gkehub.FeatureState{Code: "OK"},
+					}},
+				})
+			}),
+			mg: featureMembership(),
+			want: want{
+				mg: featureMembership(
+					fmWithSyncWaitSecs(15),
+					fmWithObservation(v1alpha1.FeatureMembershipObservation{State: &v1alpha1.FeatureState{Code: "OK"}}),
+					fmWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newFeatureMembershipExternal(t, tc.handler)
+			defer done()
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFeatureMembershipCreate(t *testing.T) {
+	e, done := newFeatureMembershipExternal(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]gkehub.MembershipFeatureSpec{membershipKey: configManagementSpec(0)}
+		if diff := cmp.Diff(want, decodeMembershipSpecs(t, r)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+	}))
+	defer done()
+	if _, err := e.Create(context.Background(), featureMembership()); err != nil {
+		t.Errorf("Create(...): unexpected error: %v", err)
+	}
+}
+
+func TestFeatureMembershipUpdate(t *testing.T) {
+	e, done := newFeatureMembershipExternal(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		observed := configManagementSpec(15)
+		observed.Configmanagement.HierarchyController = &gkehub.ConfigManagementHierarchyControllerConfig{Enabled: true}
+		if r.Method == http.MethodGet {
+			_ = r.Body.Close()
+			_ = json.NewEncoder(w).Encode(&gkehub.Feature{
+				MembershipSpecs: map[string]gkehub.MembershipFeatureSpec{observedMembershipKey: observed},
+			})
+			return
+		}
+		// Configuration that is not managed by the FeatureMembership is kept.
+		want := configManagementSpec(30)
+		want.Configmanagement.HierarchyController = observed.Configmanagement.HierarchyController
+		if diff := cmp.Diff(map[string]gkehub.MembershipFeatureSpec{membershipKey: want}, decodeMembershipSpecs(t, r)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+	}))
+	defer done()
+	if _, err := e.Update(context.Background(), featureMembership(fmWithSyncWaitSecs(30))); err != nil {
+		t.Errorf("Update(...): unexpected error: %v", err)
+	}
+}
+
+func TestFeatureMembershipDelete(t *testing.T) {
+	e, done := newFeatureMembershipExternal(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		want := map[string]gkehub.MembershipFeatureSpec{membershipKey: {}}
+		if diff := cmp.Diff(want, decodeMembershipSpecs(t, r)); diff != "" {
+			t.Errorf("r: -want, +got:\n%s", diff)
+		}
+		_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+	}))
+	defer done()
+	if err := e.Delete(context.Background(), featureMembership()); err != nil {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+}
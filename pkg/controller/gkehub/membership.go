@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/membership"
+)
+
+// Error strings.
+const (
+	errNewClient     = "cannot create new GKE Hub API client"
+	errNotMembership = "managed resource is not a GKE Hub Membership"
+	errGet           = "cannot get GCP object via GKE Hub API"
+	errCreate        = "cannot create GCP object via GKE Hub API"
+	errUpdate        = "cannot update GCP object via GKE Hub API"
+	errDelete        = "cannot delete GCP object via GKE Hub API"
+	errCheckUpToDate = "cannot determine if GKE Hub object is up to date"
+)
+
+// SetupMembership adds a controller that reconciles Memberships.
+func SetupMembership(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.MembershipGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.Membership{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.MembershipGroupVersionKind),
+			managed.WithExternalConnecter(&membershipConnecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type membershipConnecter struct {
+	client client.Client
+}
+
+// Connect sets up GKE Hub client using credentials from the provider
+func (c *membershipConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	projectID, opts, err := gcp.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := gkehub.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &membershipExternal{kube: c.client, projectID: projectID, memberships: gkehub.NewProjectsLocationsMembershipsService(s)}, nil
+}
+
+type membershipExternal struct {
+	kube        client.Client
+	projectID   string
+	memberships membership.Client
+}
+
+func (e *membershipExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Membership)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMembership)
+	}
+
+	instance, err := e.memberships.Get(membership.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errGet)
+	}
+
+	currentSpec := cr.Spec.ForProvider.DeepCopy()
+	membership.LateInitializeSpec(&cr.Spec.ForProvider, *instance)
+
+	cr.Status.AtProvider = membership.GenerateObservation(*instance)
+	switch cr.Status.AtProvider.State {
+	case v1alpha1.MembershipStateReady, v1alpha1.MembershipStateUpdating, v1alpha1.MembershipStateServiceUpdating:
+		cr.Status.SetConditions(xpv1.Available())
+	case v1alpha1.MembershipStateCreating:
+		cr.Status.SetConditions(xpv1.Creating())
+	case v1alpha1.MembershipStateDeleting:
+		cr.Status.SetConditions(xpv1.Deleting())
+	default:
+		cr.Status.SetConditions(xpv1.Unavailable())
+	}
+
+	upToDate, _, err := membership.IsUpToDate(&cr.Spec.ForProvider, instance)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errCheckUpToDate)
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceLateInitialized: !cmp.Equal(currentSpec, &cr.Spec.ForProvider),
+		ResourceUpToDate:        upToDate,
+	}, nil
+}
+
+func (e *membershipExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Membership)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMembership)
+	}
+	cr.SetConditions(xpv1.Creating())
+	instance := &gkehub.Membership{}
+	membership.GenerateMembership(cr.Spec.ForProvider, instance)
+
+	_, err := e.memberships.Create(membership.GetFullyQualifiedParent(e.projectID, cr.Spec.ForProvider), instance).
+		MembershipId(meta.GetExternalName(cr)).Context(ctx).Do()
+	return managed.ExternalCreation{}, errors.Wrap(err, errCreate)
+}
+
+func (e *membershipExternal) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Membership)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMembership)
+	}
+	name := membership.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))
+	// We have to get the membership again here to calculate the update mask.
+	instance, err := e.memberships.Get(name).Context(ctx).Do()
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGet)
+	}
+
+	u, um, err := membership.IsUpToDate(&cr.Spec.ForProvider, instance)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errCheckUpToDate)
+	}
+	if u {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	update := &gkehub.Membership{}
+	membership.GenerateMembership(cr.Spec.ForProvider, update)
+	_, err = e.memberships.Patch(name, update).UpdateMask(um).Context(ctx).Do()
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+}
+
+func (e *membershipExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Membership)
+	if !ok {
+		return errors.New(errNotMembership)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == v1alpha1.MembershipStateDeleting {
+		return nil
+	}
+	_, err := e.memberships.Delete(membership.GetFullyQualifiedName(e.projectID, cr.Spec.ForProvider, meta.GetExternalName(cr))).Context(ctx).Do()
+	return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, err), errDelete)
+}
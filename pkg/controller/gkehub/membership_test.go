@@ -0,0 +1,355 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gkehub
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	gkehub "google.golang.org/api/gkehub/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/gkehub/v1alpha1"
+)
+
+const (
+	project        = "cool-project"
+	membershipName = "cool-membership"
+	gkeCluster     = "//container.googleapis.com/projects/cool-project/locations/us-central1/clusters/cool-cluster"
+
+	membershipPath = "/v1/projects/cool-project/locations/global/memberships/cool-membership"
+)
+
+type strange struct {
+	resource.Managed
+}
+
+type membershipModifier func(*v1alpha1.Membership)
+
+func mWithCluster(c string) membershipModifier {
+	return func(m *v1alpha1.Membership) { m.Spec.ForProvider.Cluster = &c }
+}
+
+func mWithLabels(l map[string]string) membershipModifier {
+	return func(m *v1alpha1.Membership) { m.Spec.ForProvider.Labels = l }
+}
+
+func mWithState(s string) membershipModifier {
+	return func(m *v1alpha1.Membership) { m.Status.AtProvider.State = s }
+}
+
+func mWithConditions(c ...xpv1.Condition) membershipModifier {
+	return func(m *v1alpha1.Membership) { m.Status.SetConditions(c...) }
+}
+
+func hubMembership(im ...membershipModifier) *v1alpha1.Membership {
+	m := &v1alpha1.Membership{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: membershipName,
+			Annotations: map[string]string{
+				meta.AnnotationKeyExternalName: membershipName,
+			},
+		},
+		Spec: v1alpha1.MembershipSpec{
+			ForProvider: v1alpha1.MembershipParameters{
+				Location: "global",
+			},
+		},
+	}
+	for _, f := range im {
+		f(m)
+	}
+	return m
+}
+
+func newMembershipExternal(t *testing.T, handler http.Handler) (*membershipExternal, func()) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	s, err := gkehub.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &membershipExternal{projectID: project, memberships: gkehub.NewProjectsLocationsMembershipsService(s)}, server.Close
+}
+
+func TestMembershipObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		want    want
+	}{
+		"NotMembership": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}),
+			mg:      &strange{},
+			want: want{
+				mg:  &strange{},
+				err: errors.New(errNotMembership),
+			},
+		},
+		"NotFound": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: hubMembership(),
+			want: want{
+				mg: hubMembership(),
+			},
+		},
+		"GetFailed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg: hubMembership(),
+			want: want{
+				mg:  hubMembership(),
+				err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest}, errGet),
+			},
+		},
+		"ReadyLateInitialized": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(membershipPath, r.URL.Path); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Membership{
+					Endpoint: &gkehub.MembershipEndpoint{GkeCluster: &gkehub.GkeCluster{ResourceLink: gkeCluster}},
+					State:    &gkehub.MembershipState{Code: v1alpha1.MembershipStateReady},
+				})
+			}),
+			mg: hubMembership(),
+			want: want{
+				mg: hubMembership(
+					mWithCluster(gkeCluster),
+					mWithState(v1alpha1.MembershipStateReady),
+					mWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"CreatingNotUpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				_ = json.NewEncoder(w).Encode(&gkehub.Membership{
+					Endpoint: &gkehub.MembershipEndpoint{GkeCluster: &gkehub.GkeCluster{ResourceLink: gkeCluster}},
+					State:    &gkehub.MembershipState{Code: v1alpha1.MembershipStateCreating},
+				})
+			}),
+			mg: hubMembership(mWithCluster(gkeCluster), mWithLabels(map[string]string{"cool": "label"})),
+			want: want{
+				mg: hubMembership(
+					mWithCluster(gkeCluster),
+					mWithLabels(map[string]string{"cool": "label"}),
+					mWithState(v1alpha1.MembershipStateCreating),
+					mWithConditions(xpv1.Creating())),
+				obs: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newMembershipExternal(t, tc.handler)
+			defer done()
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembershipCreate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if diff := cmp.Diff(http.MethodPost, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				if diff := cmp.Diff(membershipName, r.URL.Query().Get("membershipId")); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				m := &gkehub.Membership{}
+				_ = json.NewDecoder(r.Body).Decode(m)
+				want := &gkehub.Membership{
+					Endpoint:  &gkehub.MembershipEndpoint{GkeCluster: &gkehub.GkeCluster{ResourceLink: gkeCluster}},
+					Authority: &gkehub.Authority{Issuer: "https://container.googleapis.com/v1/projects/cool-project/locations/us-central1/clusters/cool-cluster"},
+				}
+				if diff := cmp.Diff(want, m); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+			}),
+			mg: hubMembership(mWithCluster(gkeCluster), func(m *v1alpha1.Membership) {
+				m.Spec.ForProvider.Authority = &v1alpha1.Authority{}
+			}),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:  hubMembership(),
+			err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest}, errCreate),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newMembershipExternal(t, tc.handler)
+			defer done()
+			_, err := e.Create(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembershipUpdate(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"PatchLabels": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				switch r.Method {
+				case http.MethodGet:
+					_ = json.NewEncoder(w).Encode(&gkehub.Membership{})
+				case http.MethodPatch:
+					if diff := cmp.Diff("labels", r.URL.Query().Get("updateMask")); diff != "" {
+						t.Errorf("r: -want, +got:\n%s", diff)
+					}
+					_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+				default:
+					t.Errorf("unexpected request method %s", r.Method)
+				}
+			}),
+			mg: hubMembership(mWithLabels(map[string]string{"cool": "label"})),
+		},
+		"UpToDate": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodGet, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Membership{})
+			}),
+			mg: hubMembership(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newMembershipExternal(t, tc.handler)
+			defer done()
+			_, err := e.Update(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMembershipDelete(t *testing.T) {
+	cases := map[string]struct {
+		handler http.Handler
+		mg      resource.Managed
+		err     error
+	}{
+		"Successful": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				if diff := cmp.Diff(http.MethodDelete, r.Method); diff != "" {
+					t.Errorf("r: -want, +got:\n%s", diff)
+				}
+				_ = json.NewEncoder(w).Encode(&gkehub.Operation{})
+			}),
+			mg: hubMembership(),
+		},
+		"AlreadyGone": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusNotFound)
+			}),
+			mg: hubMembership(),
+		},
+		"AlreadyDeleting": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}),
+			mg: hubMembership(mWithState(v1alpha1.MembershipStateDeleting)),
+		},
+		"Failed": {
+			handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.Body.Close()
+				w.WriteHeader(http.StatusBadRequest)
+			}),
+			mg:  hubMembership(),
+			err: errors.Wrap(&googleapi.Error{Code: http.StatusBadRequest}, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, done := newMembershipExternal(t, tc.handler)
+			defer done()
+			err := e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
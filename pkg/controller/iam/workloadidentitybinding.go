@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+	gcp "github.com/crossplane/provider-gcp/pkg/clients"
+	"github.com/crossplane/provider-gcp/pkg/clients/workloadidentitybinding"
+)
+
+// Error strings.
+const (
+	errNotWorkloadIdentityBinding = "managed resource is not a GCP WorkloadIdentityBinding"
+	errWIBNoServiceAccount        = "serviceAccount of WorkloadIdentityBinding must be specified"
+	errWIBNoWorkloadPool          = "workloadPool of WorkloadIdentityBinding must be specified, or the referenced Cluster must have Workload Identity enabled"
+	errGetSAPolicy                = "cannot get IAM policy of ServiceAccount"
+	errSetSAPolicy                = "cannot set IAM policy of ServiceAccount"
+)
+
+// SetupWorkloadIdentityBinding adds a controller that reconciles
+// WorkloadIdentityBindings.
+func SetupWorkloadIdentityBinding(mgr ctrl.Manager, l logging.Logger, rl workqueue.RateLimiter) error {
+	name := managed.ControllerName(v1alpha1.WorkloadIdentityBindingGroupKind)
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(controller.Options{
+			RateLimiter: ratelimiter.NewDefaultManagedRateLimiter(rl),
+		}).
+		For(&v1alpha1.WorkloadIdentityBinding{}).
+		Complete(managed.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WorkloadIdentityBindingGroupVersionKind),
+			managed.WithExternalConnecter(&workloadIdentityBindingConnecter{client: mgr.GetClient()}),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithConnectionPublishers(),
+			managed.WithLogger(l.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
+}
+
+type workloadIdentityBindingConnecter struct {
+	client client.Client
+}
+
+// Connect sets up iam client using credentials from the provider
+func (c *workloadIdentityBindingConnecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	_, opts, err := gcp.GetAuthInfo(ctx, c.client, mg)
+	if err != nil {
+		return nil, err
+	}
+	s, err := iamv1.NewService(ctx, opts)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+	return &workloadIdentityBindingExternal{serviceAccounts: iamv1.NewProjectsServiceAccountsService(s)}, nil
+}
+
+type workloadIdentityBindingExternal struct {
+	serviceAccounts workloadidentitybinding.Client
+}
+
+func (e *workloadIdentityBindingExternal) getPolicy(ctx context.Context, cr *v1alpha1.WorkloadIdentityBinding) (*iamv1.Policy, error) {
+	if cr.Spec.ForProvider.ServiceAccount == nil {
+		return nil, errors.New(errWIBNoServiceAccount)
+	}
+	if gcp.StringValue(cr.Spec.ForProvider.WorkloadPool) == "" {
+		return nil, errors.New(errWIBNoWorkloadPool)
+	}
+	p, err := e.serviceAccounts.GetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount)).
+		OptionsRequestedPolicyVersion(v1alpha1.PolicyVersion).Context(ctx).Do()
+	return p, errors.Wrap(err, errGetSAPolicy)
+}
+
+func (e *workloadIdentityBindingExternal) setPolicy(ctx context.Context, cr *v1alpha1.WorkloadIdentityBinding, p *iamv1.Policy) error {
+	// The etag of the policy guards against concurrent modifications.
+	_, err := e.serviceAccounts.SetIamPolicy(gcp.StringValue(cr.Spec.ForProvider.ServiceAccount), &iamv1.SetIamPolicyRequest{Policy: p}).
+		Context(ctx).Do()
+	return errors.Wrap(err, errSetSAPolicy)
+}
+
+// Only the member of this resource is managed; other members and bindings of
+// the ServiceAccount's IAM policy are left untouched.
+func (e *workloadIdentityBindingExternal) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WorkloadIdentityBinding)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkloadIdentityBinding)
+	}
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.Member = workloadidentitybinding.Member(cr.Spec.ForProvider)
+	if !workloadidentitybinding.IsMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *workloadIdentityBindingExternal) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WorkloadIdentityBinding)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWorkloadIdentityBinding)
+	}
+	cr.SetConditions(xpv1.Creating())
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if !workloadidentitybinding.AddMember(cr.Spec.ForProvider, p) {
+		return managed.ExternalCreation{}, nil
+	}
+	return managed.ExternalCreation{}, e.setPolicy(ctx, cr, p)
+}
+
+// All fields of a WorkloadIdentityBinding are immutable.
+func (e *workloadIdentityBindingExternal) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *workloadIdentityBindingExternal) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WorkloadIdentityBinding)
+	if !ok {
+		return errors.New(errNotWorkloadIdentityBinding)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	p, err := e.getPolicy(ctx, cr)
+	if err != nil {
+		return errors.Wrap(resource.Ignore(gcp.IsErrorNotFound, errors.Cause(err)), errGetSAPolicy)
+	}
+	if !workloadidentitybinding.RemoveMember(cr.Spec.ForProvider, p) {
+		return nil
+	}
+	return e.setPolicy(ctx, cr, p)
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	iamv1 "google.golang.org/api/iam/v1"
+	"google.golang.org/api/option"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-gcp/apis/iam/v1alpha1"
+)
+
+const (
+	testWorkloadPool = "wesaas-playground.svc.id.goog"
+	testKSAMember    = "serviceAccount:wesaas-playground.svc.id.goog[cool-namespace/cool-ksa]"
+	testOtherMember  = "serviceAccount:wesaas-playground.svc.id.goog[other-namespace/cool-ksa]"
+)
+
+var _ managed.ExternalConnecter = &workloadIdentityBindingConnecter{}
+var _ managed.ExternalClient = &workloadIdentityBindingExternal{}
+
+type wibModifier func(*v1alpha1.WorkloadIdentityBinding)
+
+func wibWithConditions(c ...xpv1.Condition) wibModifier {
+	return func(i *v1alpha1.WorkloadIdentityBinding) { i.Status.SetConditions(c...) }
+}
+
+func wibWithMember(m string) wibModifier {
+	return func(i *v1alpha1.WorkloadIdentityBinding) { i.Status.AtProvider.Member = m }
+}
+
+func wibWithoutWorkloadPool() wibModifier {
+	return func(i *v1alpha1.WorkloadIdentityBinding) { i.Spec.ForProvider.WorkloadPool = nil }
+}
+
+func workloadIdentityBinding(im ...wibModifier) *v1alpha1.WorkloadIdentityBinding {
+	pool := testWorkloadPool
+	i := &v1alpha1.WorkloadIdentityBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-workload-identity-binding",
+			Finalizers: []string{},
+		},
+		Spec: v1alpha1.WorkloadIdentityBindingSpec{
+			ForProvider: v1alpha1.WorkloadIdentityBindingParameters{
+				ServiceAccountReferer: v1alpha1.ServiceAccountReferer{
+					ServiceAccount: &testServiceAccountRRN,
+				},
+				WorkloadPool:             &pool,
+				Namespace:                "cool-namespace",
+				KubernetesServiceAccount: "cool-ksa",
+			},
+		},
+	}
+
+	for _, m := range im {
+		m(i)
+	}
+
+	return i
+}
+
+// saIAMPolicyServer serves the supplied IAM policy and records the policy
+// that is set.
+func saIAMPolicyServer(t *testing.T, p *iamv1.Policy, set **iamv1.Policy) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, ":getIamPolicy"):
+			if diff := cmp.Diff("/v1/"+testServiceAccountRRN+":getIamPolicy", r.URL.Path); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			_ = r.Body.Close()
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(p)
+		case strings.HasSuffix(r.URL.Path, ":setIamPolicy"):
+			rq := &iamv1.SetIamPolicyRequest{}
+			_ = json.NewDecoder(r.Body).Decode(rq)
+			_ = r.Body.Close()
+			*set = rq.Policy
+			w.WriteHeader(http.StatusOK)
+			_ = json.NewEncoder(w).Encode(rq.Policy)
+		}
+	}))
+}
+
+func newWorkloadIdentityBindingExternal(t *testing.T, p *iamv1.Policy, set **iamv1.Policy) (*workloadIdentityBindingExternal, func()) {
+	t.Helper()
+	server := saIAMPolicyServer(t, p, set)
+	s, err := iamv1.NewService(context.Background(), option.WithEndpoint(server.URL), option.WithoutAuthentication())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &workloadIdentityBindingExternal{serviceAccounts: iamv1.NewProjectsServiceAccountsService(s)}, server.Close
+}
+
+func TestWorkloadIdentityBindingObserve(t *testing.T) {
+	type want struct {
+		mg  resource.Managed
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		policy *iamv1.Policy
+		mg     resource.Managed
+		want   want
+	}{
+		"NotWorkloadIdentityBinding": {
+			mg: &v1alpha1.ServiceAccount{},
+			want: want{
+				mg:  &v1alpha1.ServiceAccount{},
+				err: errors.New(errNotWorkloadIdentityBinding),
+			},
+		},
+		"NoWorkloadPool": {
+			mg: workloadIdentityBinding(wibWithoutWorkloadPool()),
+			want: want{
+				mg:  workloadIdentityBinding(wibWithoutWorkloadPool()),
+				err: errors.New(errWIBNoWorkloadPool),
+			},
+		},
+		"NotMember": {
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testOtherMember}}}},
+			mg:     workloadIdentityBinding(),
+			want: want{
+				mg: workloadIdentityBinding(wibWithMember(testKSAMember)),
+			},
+		},
+		"Member": {
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testOtherMember, testKSAMember}}}},
+			mg:     workloadIdentityBinding(),
+			want: want{
+				mg:  workloadIdentityBinding(wibWithMember(testKSAMember), wibWithConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *iamv1.Policy
+			e, done := newWorkloadIdentityBindingExternal(t, tc.policy, &set)
+			defer done()
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("Observe(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityBindingCreate(t *testing.T) {
+	cases := map[string]struct {
+		policy *iamv1.Policy
+		want   *iamv1.Policy
+	}{
+		"KeepOtherBindings": {
+			policy: &iamv1.Policy{
+				Etag:     "etag",
+				Bindings: []*iamv1.Binding{{Role: testRole, Members: []string{testMember}}},
+			},
+			want: &iamv1.Policy{
+				Etag:    "etag",
+				Version: v1alpha1.PolicyVersion,
+				Bindings: []*iamv1.Binding{
+					{Role: testRole, Members: []string{testMember}},
+					{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testKSAMember}},
+				},
+			},
+		},
+		"AlreadyMember": {
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testKSAMember}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *iamv1.Policy
+			e, done := newWorkloadIdentityBindingExternal(t, tc.policy, &set)
+			defer done()
+			mg := workloadIdentityBinding()
+			_, err := e.Create(context.Background(), mg)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Create(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(workloadIdentityBinding(wibWithConditions(xpv1.Creating())), mg); diff != "" {
+				t.Errorf("Create(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityBindingDelete(t *testing.T) {
+	cases := map[string]struct {
+		policy *iamv1.Policy
+		want   *iamv1.Policy
+	}{
+		"RemoveFromBinding": {
+			policy: &iamv1.Policy{
+				Etag:     "etag",
+				Bindings: []*iamv1.Binding{{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testOtherMember, testKSAMember}}},
+			},
+			want: &iamv1.Policy{
+				Etag:     "etag",
+				Version:  v1alpha1.PolicyVersion,
+				Bindings: []*iamv1.Binding{{Role: v1alpha1.WorkloadIdentityUserRole, Members: []string{testOtherMember}}},
+			},
+		},
+		"AlreadyRemoved": {
+			policy: &iamv1.Policy{Bindings: []*iamv1.Binding{{Role: testRole, Members: []string{testMember}}}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var set *iamv1.Policy
+			e, done := newWorkloadIdentityBindingExternal(t, tc.policy, &set)
+			defer done()
+			mg := workloadIdentityBinding()
+			err := e.Delete(context.Background(), mg)
+			if diff := cmp.Diff(nil, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, set); diff != "" {
+				t.Errorf("Delete(...): -want policy, +got policy:\n%s", diff)
+			}
+			if diff := cmp.Diff(workloadIdentityBinding(wibWithConditions(xpv1.Deleting())), mg); diff != "" {
+				t.Errorf("Delete(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}